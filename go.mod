@@ -3,9 +3,12 @@ module github.com/redhat-developer/odo
 go 1.16
 
 require (
+	github.com/Masterminds/sprig/v3 v3.2.2
 	github.com/Netflix/go-expect v0.0.0-20201125194554-85d881c3777e
 	github.com/Xuanwo/go-locale v1.0.0
 	github.com/blang/semver v3.5.1+incompatible
+	github.com/containerd/containerd v1.4.3
+	github.com/deislabs/oras v0.8.1
 	github.com/devfile/api/v2 v2.0.0-20211118170330-959f3c8007c3
 	github.com/devfile/library v1.2.1-0.20211207205254-de570f015d84
 	github.com/devfile/registry-support/index/generator v0.0.0-20211012185733-0a73f866043f
@@ -15,6 +18,7 @@ require (
 	github.com/fsnotify/fsnotify v1.4.9
 	github.com/ghodss/yaml v1.0.1-0.20190212211648-25d852aebe32
 	github.com/go-git/go-git/v5 v5.3.0
+	github.com/go-openapi/errors v0.19.2
 	github.com/go-openapi/spec v0.19.5
 	github.com/go-openapi/strfmt v0.19.3
 	github.com/go-openapi/validate v0.19.5
@@ -22,10 +26,12 @@ require (
 	github.com/golang/mock v1.5.0
 	github.com/gregjones/httpcache v0.0.0-20190611155906-901d90724c79
 	github.com/hinshun/vt10x v0.0.0-20180809195222-d55458df857c
+	github.com/huandu/xstrings v1.3.2 // indirect
 	github.com/kubernetes-sigs/service-catalog v0.3.1
 	github.com/kylelemons/godebug v1.1.0
 	github.com/mattn/go-colorable v0.1.8
 	github.com/mgutz/ansi v0.0.0-20200706080929-d51e80ef957d // indirect
+	github.com/mitchellh/copystructure v1.1.1 // indirect
 	github.com/olekukonko/tablewriter v0.0.5
 	github.com/onsi/ginkgo v4.7.0-origin.0+incompatible
 	github.com/onsi/gomega v1.15.0
@@ -42,6 +48,7 @@ require (
 	github.com/securego/gosec/v2 v2.8.0
 	github.com/segmentio/backo-go v0.0.0-20200129164019-23eae7c10bd3 // indirect
 	github.com/spf13/afero v1.2.2
+	github.com/spf13/cast v1.4.1 // indirect
 	github.com/spf13/cobra v1.1.3
 	github.com/spf13/pflag v1.0.5
 	github.com/stretchr/testify v1.7.0
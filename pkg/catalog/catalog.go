@@ -6,11 +6,11 @@ import (
 	"net/url"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/redhat-developer/odo/pkg/segment"
 
 	"github.com/redhat-developer/odo/pkg/preference"
-	"github.com/zalando/go-keyring"
 
 	"github.com/redhat-developer/odo/pkg/kclient"
 	"github.com/redhat-developer/odo/pkg/log"
@@ -21,6 +21,7 @@ import (
 	"github.com/pkg/errors"
 	registryUtil "github.com/redhat-developer/odo/pkg/odo/cli/registry/util"
 	"github.com/redhat-developer/odo/pkg/util"
+	"k8s.io/klog"
 )
 
 // GetDevfileRegistries gets devfile registries from preference file,
@@ -42,18 +43,20 @@ func GetDevfileRegistries(registryName string) ([]Registry, error) {
 			if hasName {
 				if registryName == registry.Name {
 					reg := Registry{
-						Name:   registry.Name,
-						URL:    registry.URL,
-						Secure: registry.Secure,
+						Name:      registry.Name,
+						URL:       registry.URL,
+						Secure:    registry.Secure,
+						PublicKey: registry.PublicKey,
 					}
 					devfileRegistries = append(devfileRegistries, reg)
 					return devfileRegistries, nil
 				}
 			} else {
 				reg := Registry{
-					Name:   registry.Name,
-					URL:    registry.URL,
-					Secure: registry.Secure,
+					Name:      registry.Name,
+					URL:       registry.URL,
+					Secure:    registry.Secure,
+					PublicKey: registry.PublicKey,
 				}
 				devfileRegistries = append(devfileRegistries, reg)
 			}
@@ -97,50 +100,75 @@ func convertURL(URL string) (string, error) {
 	return URL, nil
 }
 
-const indexPath = "/devfiles/index.json"
+const (
+	indexPath = "/devfiles/index.json"
+	// ociIndexPath is the path of the index of OCI-based registries listing the default versions of the stacks
+	ociIndexPath = "/index"
+	// ociIndexV2Path is the path of the index of OCI-based registries listing all the versions of the stacks
+	ociIndexV2Path = "/v2index"
+	// ociIndexCacheKeyPrefix is the prefix of the keys of the cached indexes of OCI-based registries
+	ociIndexCacheKeyPrefix = "registry-index:"
+)
+
+// registryTimeoutError is returned when the index of a registry is not retrieved in time
+type registryTimeoutError struct {
+	timeout time.Duration
+}
+
+func (e registryTimeoutError) Error() string {
+	return fmt.Sprintf("the registry did not respond within %s", e.timeout)
+}
 
 // getRegistryDevfiles retrieves the registry's index devfile entries
 func getRegistryDevfiles(registry Registry) ([]DevfileComponentType, error) {
+	devfileIndex, registry, err := getRegistryIndex(registry)
+	if err != nil {
+		return nil, err
+	}
+	return createRegistryDevfiles(registry, devfileIndex)
+}
+
+// getRegistryIndex retrieves the registry's index, and returns it along with the registry, whose URL is
+// converted to the GitHub raw URL for GitHub-based registries
+func getRegistryIndex(registry Registry) ([]registryIndexEntry, Registry, error) {
+	if registryUtil.IsFileBasedRegistry(registry.URL) {
+		devfileIndex, err := getFileRegistryIndex(registry)
+		return devfileIndex, registry, err
+	}
 	if !strings.Contains(registry.URL, "github") {
 		// OCI-based registry
-		devfileIndex, err := registryLibrary.GetRegistryIndex(registry.URL, segment.GetRegistryOptions(), indexSchema.StackDevfileType)
-		if err != nil {
-			return nil, err
-		}
-		return createRegistryDevfiles(registry, devfileIndex)
+		devfileIndex, err := getOCIRegistryIndex(registry)
+		return devfileIndex, registry, err
 	}
 	// Github-based registry
 	URL, err := convertURL(registry.URL)
 	if err != nil {
-		return nil, errors.Wrapf(err, "unable to convert URL %s", registry.URL)
+		return nil, registry, errors.Wrapf(err, "unable to convert URL %s", registry.URL)
 	}
 	registry.URL = URL
 	indexLink := registry.URL + indexPath
-	request := util.HTTPRequestParams{
-		URL: indexLink,
-	}
 
 	// TODO(feloy) Get from DI
 	cfg, err := preference.NewClient()
 	if err != nil {
-		return nil, err
+		return nil, registry, err
 	}
 
-	secure := registryUtil.IsSecure(cfg, registry.Name)
-	if secure {
-		token, e := keyring.Get(fmt.Sprintf("%s%s", util.CredentialPrefix, registry.Name), registryUtil.RegistryUser)
-		if e != nil {
-			return nil, errors.Wrap(e, "unable to get secure registry credential from keyring")
-		}
-		request.Token = token
+	credentials, err := registryUtil.GetRegistryCredentials(cfg, registry.Name, registry.URL)
+	if err != nil {
+		return nil, registry, err
+	}
+	request := util.HTTPRequestParams{
+		URL:             indexLink,
+		HTTPCredentials: credentials,
 	}
 
 	jsonBytes, err := util.HTTPGetRequest(request, cfg.GetRegistryCacheTime())
 	if err != nil {
-		return nil, errors.Wrapf(err, "unable to download the devfile index.json from %s", indexLink)
+		return nil, registry, errors.Wrapf(err, "unable to download the devfile index.json from %s", indexLink)
 	}
 
-	var devfileIndex []indexSchema.Schema
+	var devfileIndex []registryIndexEntry
 	err = json.Unmarshal(jsonBytes, &devfileIndex)
 	if err != nil {
 		if err := util.CleanDefaultHTTPCacheDir(); err != nil {
@@ -149,28 +177,118 @@ func getRegistryDevfiles(registry Registry) ([]DevfileComponentType, error) {
 		// we try once again
 		jsonBytes, err := util.HTTPGetRequest(request, cfg.GetRegistryCacheTime())
 		if err != nil {
-			return nil, errors.Wrapf(err, "unable to download the devfile index.json from %s", indexLink)
+			return nil, registry, errors.Wrapf(err, "unable to download the devfile index.json from %s", indexLink)
 		}
 
 		err = json.Unmarshal(jsonBytes, &devfileIndex)
 		if err != nil {
-			return nil, errors.Wrapf(err, "unable to unmarshal the devfile index.json from %s", indexLink)
+			return nil, registry, errors.Wrapf(err, "unable to unmarshal the devfile index.json from %s", indexLink)
 		}
 	}
-	return createRegistryDevfiles(registry, devfileIndex)
+	return devfileIndex, registry, nil
 }
 
-func createRegistryDevfiles(registry Registry, devfileIndex []indexSchema.Schema) ([]DevfileComponentType, error) {
+// getOCIRegistryIndex retrieves the index of an OCI-based registry, listing all the versions of the stacks
+// when the registry supports multiple versions of stacks; the index is cached for RegistryCacheTime minutes
+func getOCIRegistryIndex(registry Registry) ([]registryIndexEntry, error) {
+	cfg, err := preference.NewClient()
+	if err != nil {
+		return nil, err
+	}
+	cacheKey := ociIndexCacheKeyPrefix + registry.URL
+	if jsonBytes, ok := util.GetCachedResponse(cacheKey, cfg.GetRegistryCacheTime()); ok {
+		var devfileIndex []registryIndexEntry
+		if err = json.Unmarshal(jsonBytes, &devfileIndex); err == nil {
+			return devfileIndex, nil
+		}
+		klog.V(4).Infof("unable to unmarshal the cached index of registry %s: %v", registry.Name, err)
+	}
+
+	devfileIndex, err := fetchOCIRegistryIndex(registry)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.GetRegistryCacheTime() > 0 {
+		jsonBytes, err := json.Marshal(devfileIndex)
+		if err == nil {
+			err = util.CacheResponse(cacheKey, jsonBytes)
+		}
+		if err != nil {
+			klog.V(4).Infof("unable to cache the index of registry %s: %v", registry.Name, err)
+		}
+	}
+	return devfileIndex, nil
+}
+
+// fetchOCIRegistryIndex downloads the index of an OCI-based registry, from the index listing all the versions of the stacks
+// when the registry supports it, or from the index listing the default versions of the stacks otherwise
+func fetchOCIRegistryIndex(registry Registry) ([]registryIndexEntry, error) {
+	credentials, err := getRegistryCredentials(registry)
+	if err != nil {
+		return nil, err
+	}
+	jsonBytes, err := util.HTTPGetRequest(util.HTTPRequestParams{URL: strings.TrimSuffix(registry.URL, "/") + ociIndexV2Path, HTTPCredentials: credentials}, 0)
+	if err == nil {
+		var devfileIndex []registryIndexEntry
+		if err = json.Unmarshal(jsonBytes, &devfileIndex); err == nil {
+			return devfileIndex, nil
+		}
+	}
+	klog.V(4).Infof("unable to get the index listing the versions of the stacks of registry %s: %v", registry.Name, err)
+
+	var devfileIndex []indexSchema.Schema
+	if credentials.IsEmpty() {
+		devfileIndex, err = registryLibrary.GetRegistryIndex(registry.URL, segment.GetRegistryOptions(), indexSchema.StackDevfileType)
+		if err != nil {
+			return nil, err
+		}
+		return newRegistryIndex(devfileIndex), nil
+	}
+
+	// the library does not support the authentication to the registries
+	indexLink := strings.TrimSuffix(registry.URL, "/") + ociIndexPath
+	jsonBytes, err = util.HTTPGetRequest(util.HTTPRequestParams{URL: indexLink, HTTPCredentials: credentials}, 0)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to download the index from %s", indexLink)
+	}
+	err = json.Unmarshal(jsonBytes, &devfileIndex)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to unmarshal the index from %s", indexLink)
+	}
+	return newRegistryIndex(devfileIndex), nil
+}
+
+// getRegistryCredentials returns the credentials and the TLS configuration used to access the registry
+func getRegistryCredentials(registry Registry) (util.HTTPCredentials, error) {
+	cfg, err := preference.NewClient()
+	if err != nil {
+		return util.HTTPCredentials{}, err
+	}
+	return registryUtil.GetRegistryCredentials(cfg, registry.Name, registry.URL)
+}
+
+func createRegistryDevfiles(registry Registry, devfileIndex []registryIndexEntry) ([]DevfileComponentType, error) {
 	registryDevfiles := make([]DevfileComponentType, 0, len(devfileIndex))
 	for _, devfileIndexEntry := range devfileIndex {
+		versions, defaultVersion := getStackVersions(devfileIndexEntry)
+		link := devfileIndexEntry.Links["self"]
+		if link == "" {
+			link = defaultVersion.Link
+		}
 		stackDevfile := DevfileComponentType{
 			Name:        devfileIndexEntry.Name,
 			DisplayName: devfileIndexEntry.DisplayName,
 			Description: devfileIndexEntry.Description,
-			Link:        devfileIndexEntry.Links["self"],
+			Link:        link,
 			Registry:    registry,
 			Language:    devfileIndexEntry.Language,
+			ProjectType: devfileIndexEntry.ProjectType,
 			Tags:        devfileIndexEntry.Tags,
+			Version:     defaultVersion.Version,
+			Versions:    versions,
+		}
+		if len(versions) == 0 {
+			stackDevfile.verification = devfileIndexEntry.registryIndexVerification
 		}
 		registryDevfiles = append(registryDevfiles, stackDevfile)
 	}
@@ -178,12 +296,12 @@ func createRegistryDevfiles(registry Registry, devfileIndex []indexSchema.Schema
 	return registryDevfiles, nil
 }
 
-// ListDevfileComponents lists all the available devfile components
+// ListDevfileComponents lists all the available devfile components; the registries are queried concurrently,
+// and the components of the registries that can't be reached within RegistryTimeout are not listed
 func ListDevfileComponents(registryName string) (DevfileComponentTypeList, error) {
 	catalogDevfileList := &DevfileComponentTypeList{}
 	var err error
 
-	// TODO: consider caching registry information for better performance since it should be fairly stable over time
 	// Get devfile registries
 	catalogDevfileList.DevfileRegistries, err = GetDevfileRegistries(registryName)
 	if err != nil {
@@ -193,6 +311,12 @@ func ListDevfileComponents(registryName string) (DevfileComponentTypeList, error
 		return *catalogDevfileList, nil
 	}
 
+	cfg, err := preference.NewClient()
+	if err != nil {
+		return *catalogDevfileList, err
+	}
+	timeout := time.Duration(cfg.GetRegistryTimeout()) * time.Second
+
 	// first retrieve the indices for each registry, concurrently
 	devfileIndicesMutex := &sync.Mutex{}
 	retrieveRegistryIndices := util.NewConcurrentTasks(len(catalogDevfileList.DevfileRegistries))
@@ -205,9 +329,13 @@ func ListDevfileComponents(registryName string) (DevfileComponentTypeList, error
 		registry := reg                 // Needed to prevent the lambda from capturing the value
 		registryPriority := regPriority // Needed to prevent the lambda from capturing the value
 		retrieveRegistryIndices.Add(util.ConcurrentTask{ToRun: func(errChannel chan error) {
-			registryDevfiles, err := getRegistryDevfiles(registry)
+			registryDevfiles, err := getRegistryDevfilesWithTimeout(registry, timeout)
 			if err != nil {
-				log.Warningf("Registry %s is not set up properly with error: %v, please check the registry URL and credential (refer `odo registry update --help`)\n", registry.Name, err)
+				if _, ok := err.(registryTimeoutError); ok {
+					log.Warningf("Registry %s is not listed: %v, the timeout can be changed with `odo preference set RegistryTimeout <seconds>`\n", registry.Name, err)
+				} else {
+					log.Warningf("Registry %s is not set up properly with error: %v, please check the registry URL and credential (refer `odo registry update --help`)\n", registry.Name, err)
+				}
 				return
 			}
 
@@ -227,25 +355,28 @@ func ListDevfileComponents(registryName string) (DevfileComponentTypeList, error
 	return *catalogDevfileList, nil
 }
 
-// SearchComponent searches for the component
-//TODO: Fix this to return devfile components
-func SearchComponent(client kclient.ClientInterface, name string) ([]string, error) {
-	//var result []string
-	//componentList, err := ListDevfileComponents(client)
-	//if err != nil {
-	//	return nil, errors.Wrap(err, "unable to list components")
-	//}
-	//
-	//// do a partial search in all the components
-	//for _, component := range componentList.Items {
-	//	// we only show components that contain the search term and that have at least non-hidden tag
-	//	// since a component with all hidden tags is not shown in the odo catalog list components either
-	//	if strings.Contains(component.ObjectMeta.Name, name) && len(component.Spec.NonHiddenTags) > 0 {
-	//		result = append(result, component.ObjectMeta.Name)
-	//	}
-	//}
-
-	return []string{}, nil
+// getRegistryDevfilesWithTimeout retrieves the registry's index devfile entries, returning a registryTimeoutError
+// when they are not retrieved within the timeout; there is no timeout when it is 0
+func getRegistryDevfilesWithTimeout(registry Registry, timeout time.Duration) ([]DevfileComponentType, error) {
+	if timeout <= 0 {
+		return getRegistryDevfiles(registry)
+	}
+	type result struct {
+		devfiles []DevfileComponentType
+		err      error
+	}
+	// the channel is buffered so the go-routine ends when the registry responds after the timeout
+	results := make(chan result, 1)
+	go func() {
+		devfiles, err := getRegistryDevfiles(registry)
+		results <- result{devfiles: devfiles, err: err}
+	}()
+	select {
+	case r := <-results:
+		return r.devfiles, r.err
+	case <-time.After(timeout):
+		return nil, registryTimeoutError{timeout: timeout}
+	}
 }
 
 // ListOperatorServices fetches a list of Operators from the cluster and
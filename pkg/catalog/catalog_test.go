@@ -6,6 +6,7 @@ import (
 	"net/http/httptest"
 	"os"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -13,6 +14,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/redhat-developer/odo/pkg/kclient"
 	"github.com/redhat-developer/odo/pkg/preference"
+	"github.com/redhat-developer/odo/pkg/util"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -88,7 +90,28 @@ OdoSettings:
 	}
 }
 
+// setPreference sets the preference file used by the tests, and returns a function restoring the default preference file
+func setPreference(t *testing.T, content string) func() {
+	tempConfigFile, err := ioutil.TempFile("", "odoconfig")
+	if err != nil {
+		t.Fatal("Fail to create temporary config file")
+	}
+	defer tempConfigFile.Close()
+	_, err = tempConfigFile.Write([]byte("kind: Preference\napiversion: odo.openshift.io/v1alpha1\n" + content))
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.Setenv(preference.GlobalConfigEnvName, tempConfigFile.Name())
+	return func() {
+		os.Unsetenv(preference.GlobalConfigEnvName)
+		os.Remove(tempConfigFile.Name())
+	}
+}
+
 func TestGetRegistryDevfiles(t *testing.T) {
+	// the index of the registry must not be cached by the test
+	defer setPreference(t, "OdoSettings:\n  RegistryCacheTime: 0\n")()
+
 	// Start a local HTTP server
 	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
 		// Send response to be tested
@@ -159,6 +182,77 @@ func TestGetRegistryDevfiles(t *testing.T) {
 	}
 }
 
+func TestGetOCIRegistryIndexCache(t *testing.T) {
+	defer setPreference(t, "OdoSettings:\n  RegistryCacheTime: 15\n")()
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if !strings.HasSuffix(req.URL.Path, ociIndexV2Path) {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		requests++
+		_, err := rw.Write([]byte(`[{"name": "go", "language": "go", "versions": [{"version": "1.0.0", "default": true}]}]`))
+		if err != nil {
+			t.Error(err)
+		}
+	}))
+	defer server.Close()
+
+	// a unique URL, so the index is not cached by a previous run of the test
+	registry := Registry{Name: "cached", URL: server.URL + "/" + util.GenerateRandomString(10)}
+	for i := 0; i < 2; i++ {
+		devfileIndex, err := getOCIRegistryIndex(registry)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(devfileIndex) != 1 || devfileIndex[0].Name != "go" || len(devfileIndex[0].Versions) != 1 {
+			t.Errorf("got index %+v", devfileIndex)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("got %d requests to the registry, want 1", requests)
+	}
+}
+
+func TestListDevfileComponentsWithUnreachableRegistry(t *testing.T) {
+	release := make(chan struct{})
+	slowServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		<-release
+	}))
+	defer slowServer.Close()
+	defer close(release)
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		_, err := rw.Write([]byte(`[{"name": "go", "language": "go"}]`))
+		if err != nil {
+			t.Error(err)
+		}
+	}))
+	defer server.Close()
+
+	defer setPreference(t, `OdoSettings:
+  RegistryCacheTime: 0
+  RegistryTimeout: 1
+  RegistryList:
+  - Name: slow
+    URL: `+slowServer.URL+`
+  - Name: fast
+    URL: `+server.URL+`
+`)()
+
+	list, err := ListDevfileComponents("")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(list.DevfileRegistries) != 2 {
+		t.Errorf("got registries %+v, want 2 registries", list.DevfileRegistries)
+	}
+	if len(list.Items) != 1 || list.Items[0].Name != "go" || list.Items[0].Registry.Name != "fast" {
+		t.Errorf("got components %+v, want the components of the fast registry", list.Items)
+	}
+}
+
 func TestConvertURL(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -0,0 +1,248 @@
+package catalog
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+// SourceDetection describes the source code of a project, as detected from the files of the project
+type SourceDetection struct {
+	// Languages are the languages of the project
+	Languages []string
+	// ProjectTypes are the frameworks and build tools of the project, the most specific first
+	ProjectTypes []string
+}
+
+// DetectedStack is a stack matching the source code of a project, along with the score of the match
+type DetectedStack struct {
+	DevfileComponentType
+	// Score is the relevance of the stack for the project, the most relevant stacks having the highest scores
+	Score int
+}
+
+// projectFile is a file identifying the language and the build tool of a project
+type projectFile struct {
+	// name is the name of the file, or its extension when starting with a dot
+	name        string
+	language    string
+	projectType string
+	// frameworks are the project types of the frameworks, indexed by the strings identifying them in the file
+	frameworks map[string]string
+}
+
+var javaFrameworks = map[string]string{
+	"spring-boot":          "springboot",
+	"quarkus":              "quarkus",
+	"vertx":                "vertx",
+	"vert.x":               "vertx",
+	"liberty-maven-plugin": "openliberty",
+	"openliberty":          "openliberty",
+	"wildfly":              "wildfly",
+	"micronaut":            "micronaut",
+}
+
+var pythonFrameworks = map[string]string{
+	"django":  "django",
+	"flask":   "flask",
+	"fastapi": "fastapi",
+}
+
+// projectFiles are the files inspected to detect the source code of a project, in the root directory of the project
+var projectFiles = []projectFile{
+	{name: "pom.xml", language: "java", projectType: "maven", frameworks: javaFrameworks},
+	{name: "build.gradle", language: "java", projectType: "gradle", frameworks: javaFrameworks},
+	{name: "build.gradle.kts", language: "java", projectType: "gradle", frameworks: javaFrameworks},
+	{name: "package.json", language: "javascript", projectType: "nodejs"},
+	{name: "go.mod", language: "go", projectType: "go"},
+	{name: "requirements.txt", language: "python", projectType: "python", frameworks: pythonFrameworks},
+	{name: "Pipfile", language: "python", projectType: "python", frameworks: pythonFrameworks},
+	{name: "pyproject.toml", language: "python", projectType: "python", frameworks: pythonFrameworks},
+	{name: "setup.py", language: "python", projectType: "python", frameworks: pythonFrameworks},
+	{name: "manage.py", language: "python", projectType: "python", frameworks: pythonFrameworks},
+	{name: ".csproj", language: "dotnet", projectType: "dotnet"},
+	{name: "composer.json", language: "php", projectType: "php"},
+	{name: "Gemfile", language: "ruby", projectType: "ruby"},
+	{name: "Cargo.toml", language: "rust", projectType: "rust"},
+}
+
+// nodeFrameworks are the project types of the frameworks, indexed by the dependencies identifying them in package.json
+var nodeFrameworks = map[string]string{
+	"@angular/core": "angular",
+	"react":         "react",
+	"vue":           "vue",
+	"next":          "nextjs",
+	"nuxt":          "nuxtjs",
+	"svelte":        "svelte",
+	"express":       "express",
+}
+
+// aliases are the names used by the registries for the same languages and project types
+var aliases = map[string]string{
+	"nodejs":     "javascript",
+	"node":       "javascript",
+	"typescript": "javascript",
+	"golang":     "go",
+	"csharp":     "dotnet",
+	"c#":         "dotnet",
+	".net":       "dotnet",
+	"spring":     "springboot",
+}
+
+// weights of the matches of a stack with the source code of a project
+const (
+	languageWeight    = 10
+	projectTypeWeight = 20
+)
+
+// DetectSource inspects the files of the root directory of a project to detect its languages and project types
+func DetectSource(dir string) (SourceDetection, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return SourceDetection{}, errors.Wrapf(err, "unable to read the directory %s", dir)
+	}
+
+	var detection SourceDetection
+	var buildTools []string
+	for _, pf := range projectFiles {
+		for _, file := range files {
+			if file.IsDir() || (file.Name() != pf.name && !(strings.HasPrefix(pf.name, ".") && filepath.Ext(file.Name()) == pf.name)) {
+				continue
+			}
+			detection.Languages = appendUnique(detection.Languages, pf.language)
+			buildTools = appendUnique(buildTools, pf.projectType)
+
+			frameworks, err := detectFrameworks(filepath.Join(dir, file.Name()), pf)
+			if err != nil {
+				return SourceDetection{}, err
+			}
+			for _, framework := range frameworks {
+				detection.ProjectTypes = appendUnique(detection.ProjectTypes, framework)
+			}
+			break
+		}
+	}
+	// the frameworks are more specific than the build tools
+	for _, buildTool := range buildTools {
+		detection.ProjectTypes = appendUnique(detection.ProjectTypes, buildTool)
+	}
+	return detection, nil
+}
+
+// detectFrameworks returns the project types of the frameworks referenced by the project file, sorted by name
+func detectFrameworks(path string, pf projectFile) ([]string, error) {
+	if pf.frameworks == nil && pf.name != "package.json" {
+		return nil, nil
+	}
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read %s", path)
+	}
+
+	var frameworks []string
+	if pf.name == "package.json" {
+		var packageJSON struct {
+			Dependencies    map[string]string `json:"dependencies"`
+			DevDependencies map[string]string `json:"devDependencies"`
+		}
+		// an invalid package.json identifies a Node.js project all the same
+		if json.Unmarshal(content, &packageJSON) == nil {
+			for dependency, framework := range nodeFrameworks {
+				_, dep := packageJSON.Dependencies[dependency]
+				_, devDep := packageJSON.DevDependencies[dependency]
+				if dep || devDep {
+					frameworks = appendUnique(frameworks, framework)
+				}
+			}
+		}
+	} else {
+		lowerContent := strings.ToLower(string(content))
+		for reference, framework := range pf.frameworks {
+			if strings.Contains(lowerContent, reference) {
+				frameworks = appendUnique(frameworks, framework)
+			}
+		}
+	}
+	sort.Strings(frameworks)
+	return frameworks, nil
+}
+
+// DetectStacks returns the stacks matching the source code of the project in dir, sorted from the most relevant
+// to the least relevant; a stack matches a project when its language or its project type matches the project
+func DetectStacks(dir string, components []DevfileComponentType) (SourceDetection, []DetectedStack, error) {
+	detection, err := DetectSource(dir)
+	if err != nil {
+		return SourceDetection{}, nil, err
+	}
+	return detection, matchStacks(detection, components), nil
+}
+
+// matchStacks returns the stacks matching the detected source code, sorted by relevance
+func matchStacks(detection SourceDetection, components []DevfileComponentType) []DetectedStack {
+	stacks := []DetectedStack{}
+	for _, component := range components {
+		if score := scoreStack(detection, component); score > 0 {
+			stacks = append(stacks, DetectedStack{DevfileComponentType: component, Score: score})
+		}
+	}
+	sort.SliceStable(stacks, func(i, j int) bool {
+		if stacks[i].Score != stacks[j].Score {
+			return stacks[i].Score > stacks[j].Score
+		}
+		return stacks[i].Name < stacks[j].Name
+	})
+	return stacks
+}
+
+// scoreStack returns the score of the stack for the detected source code, 0 when the stack does not match it
+func scoreStack(detection SourceDetection, component DevfileComponentType) int {
+	score := 0
+	for _, language := range detection.Languages {
+		if normalize(component.Language) == language {
+			score += languageWeight
+			break
+		}
+	}
+
+	// the project type of the stacks of older registries is missing, the name of the stack is used instead
+	stackTypes := []string{normalize(component.ProjectType)}
+	if component.ProjectType == "" {
+		stackTypes = nil
+		for _, part := range strings.Split(component.Name, "-") {
+			stackTypes = append(stackTypes, normalize(part))
+		}
+	}
+	for i, projectType := range detection.ProjectTypes {
+		if util.In(stackTypes, normalize(projectType)) {
+			// the most specific project types have the highest scores
+			score += projectTypeWeight + len(detection.ProjectTypes) - i
+			break
+		}
+	}
+	return score
+}
+
+// normalize returns the lowercase name of a language or project type, without its punctuation, resolving aliases
+func normalize(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if alias, ok := aliases[name]; ok {
+		return alias
+	}
+	name = strings.NewReplacer("-", "", "_", "", " ", "", ".", "").Replace(name)
+	if alias, ok := aliases[name]; ok {
+		return alias
+	}
+	return name
+}
+
+func appendUnique(list []string, value string) []string {
+	if util.In(list, value) {
+		return list
+	}
+	return append(list, value)
+}
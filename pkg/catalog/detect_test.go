@@ -0,0 +1,98 @@
+package catalog
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestDetectStacks(t *testing.T) {
+	components := []DevfileComponentType{
+		{Name: "go", Language: "go", ProjectType: "go"},
+		{Name: "java-maven", Language: "java", ProjectType: "maven"},
+		{Name: "java-quarkus", Language: "java", ProjectType: "quarkus"},
+		{Name: "java-springboot", Language: "java", ProjectType: "spring"},
+		{Name: "nodejs", Language: "javascript", ProjectType: "nodejs"},
+		{Name: "nodejs-angular", Language: "TypeScript", ProjectType: "Angular"},
+		{Name: "python", Language: "python", ProjectType: "python"},
+		// the project type of the stacks of older registries is missing
+		{Name: "python-django", Language: "python"},
+	}
+
+	tests := []struct {
+		name          string
+		files         map[string]string
+		wantDetection SourceDetection
+		wantStacks    []string
+	}{
+		{
+			name:          "maven project",
+			files:         map[string]string{"pom.xml": "<project><artifactId>app</artifactId></project>"},
+			wantDetection: SourceDetection{Languages: []string{"java"}, ProjectTypes: []string{"maven"}},
+			wantStacks:    []string{"java-maven", "java-quarkus", "java-springboot"},
+		},
+		{
+			name:          "spring boot project",
+			files:         map[string]string{"pom.xml": "<parent><artifactId>spring-boot-starter-parent</artifactId></parent>"},
+			wantDetection: SourceDetection{Languages: []string{"java"}, ProjectTypes: []string{"springboot", "maven"}},
+			wantStacks:    []string{"java-springboot", "java-maven", "java-quarkus"},
+		},
+		{
+			name:          "angular project",
+			files:         map[string]string{"package.json": `{"dependencies": {"@angular/core": "^12.0.0"}}`},
+			wantDetection: SourceDetection{Languages: []string{"javascript"}, ProjectTypes: []string{"angular", "nodejs"}},
+			wantStacks:    []string{"nodejs-angular", "nodejs"},
+		},
+		{
+			name:          "django project",
+			files:         map[string]string{"requirements.txt": "Django==3.2\n", "manage.py": ""},
+			wantDetection: SourceDetection{Languages: []string{"python"}, ProjectTypes: []string{"django", "python"}},
+			wantStacks:    []string{"python-django", "python"},
+		},
+		{
+			name:          "go project",
+			files:         map[string]string{"go.mod": "module example.com/app\n", "README.md": ""},
+			wantDetection: SourceDetection{Languages: []string{"go"}, ProjectTypes: []string{"go"}},
+			wantStacks:    []string{"go"},
+		},
+		{
+			name:          "unknown project",
+			files:         map[string]string{"README.md": ""},
+			wantDetection: SourceDetection{},
+			wantStacks:    []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "odo-detect")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+			for name, content := range tt.files {
+				err = ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0600)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			detection, stacks, err := DetectStacks(dir, components)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(detection, tt.wantDetection) {
+				t.Errorf("got detection %+v, want %+v", detection, tt.wantDetection)
+			}
+			got := []string{}
+			for _, stack := range stacks {
+				got = append(got, stack.Name)
+			}
+			if !reflect.DeepEqual(got, tt.wantStacks) {
+				t.Errorf("got stacks %v, want %v", got, tt.wantStacks)
+			}
+		})
+	}
+}
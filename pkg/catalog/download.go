@@ -0,0 +1,62 @@
+package catalog
+
+import (
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	registryUtil "github.com/redhat-developer/odo/pkg/odo/cli/registry/util"
+	"github.com/redhat-developer/odo/pkg/preference"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+// GetDevfileComponent returns the stack of the registry
+func GetDevfileComponent(registryName, stackName string) (DevfileComponentType, error) {
+	registries, err := GetDevfileRegistries(registryName)
+	if err != nil {
+		return DevfileComponentType{}, err
+	}
+	if len(registries) == 0 {
+		return DevfileComponentType{}, errors.Errorf("registry %s doesn't exist, please run `odo registry list` for the list of registries", registryName)
+	}
+	devfileComponents, err := getRegistryDevfiles(registries[0])
+	if err != nil {
+		return DevfileComponentType{}, errors.Wrapf(err, "unable to get the stacks of registry %s", registryName)
+	}
+	for _, devfileComponent := range devfileComponents {
+		if devfileComponent.Name == stackName {
+			return devfileComponent, nil
+		}
+	}
+	return DevfileComponentType{}, errors.Errorf("stack %s not found in registry %s", stackName, registryName)
+}
+
+// DownloadStack downloads the devfile and the resources of the version of the stack into destDir
+func DownloadStack(registry Registry, stackName string, version StackVersion, destDir string) error {
+	switch {
+	case registryUtil.IsFileBasedRegistry(registry.URL):
+		// For registries stored in a local directory, the stack directory contains the devfile and its resources
+		return util.CopyDirWithFS(GetFileRegistryStackDir(registry, stackName, version.Version), destDir)
+	case registryUtil.IsGitBasedRegistry(registry.URL):
+		// GitHub-based registries only contain the devfiles of the stacks
+		prefClient, err := preference.NewClient()
+		if err != nil {
+			return err
+		}
+		credentials, err := registryUtil.GetRegistryCredentials(prefClient, registry.Name, registry.URL)
+		if err != nil {
+			return err
+		}
+		params := util.HTTPRequestParams{
+			URL:             registry.URL + version.Link,
+			HTTPCredentials: credentials,
+		}
+		devfileData, err := util.DownloadFileInMemoryWithCache(params, prefClient.GetRegistryCacheTime())
+		if err != nil {
+			return err
+		}
+		return ioutil.WriteFile(filepath.Join(destDir, "devfile.yaml"), devfileData, 0644) // #nosec G306
+	default:
+		return PullStack(registry, stackName, version, destDir)
+	}
+}
@@ -0,0 +1,235 @@
+package catalog
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path"
+	"path/filepath"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	devfilepkg "github.com/devfile/api/v2/pkg/devfile"
+	indexSchema "github.com/devfile/registry-support/index/generator/schema"
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+	registryUtil "github.com/redhat-developer/odo/pkg/odo/cli/registry/util"
+	"github.com/redhat-developer/odo/pkg/odo/util/validation"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+// fileRegistryStartersDir is the directory of a file based registry containing a directory for each stack,
+// with an archive for each starter project of the stack
+const fileRegistryStartersDir = "starters"
+
+// fileRegistryStackFile is the file of a stack directory listing the versions of the stack, each version being
+// stored in a sub directory named after the version
+const fileRegistryStackFile = "stack.yaml"
+
+// getFileRegistryIndex reads the index of a registry stored in a local directory, or generates it from the devfiles
+// of the stacks directory when the registry has no index file
+func getFileRegistryIndex(registry Registry) ([]registryIndexEntry, error) {
+	registryDir := registryUtil.GetFileRegistryDir(registry.URL)
+	indexFile := filepath.Join(registryDir, registryUtil.FileRegistryIndex)
+	if !util.CheckPathExists(indexFile) {
+		return generateFileRegistryIndex(registryDir)
+	}
+	jsonBytes, err := ioutil.ReadFile(indexFile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read the devfile index of registry %s", registry.Name)
+	}
+	var devfileIndex []registryIndexEntry
+	err = json.Unmarshal(jsonBytes, &devfileIndex)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to unmarshal the devfile index %s", indexFile)
+	}
+	return devfileIndex, nil
+}
+
+// generateFileRegistryIndex generates the index of a registry stored in a local directory, with an entry for each
+// directory of the stacks directory containing a devfile, or sub directories containing the devfile of each version
+// of the stack; the entry is named after the directory and filled in with the metadata of the devfile
+func generateFileRegistryIndex(registryDir string) ([]registryIndexEntry, error) {
+	stacksDir := filepath.Join(registryDir, registryUtil.FileRegistryStacksDir)
+	dirs, err := ioutil.ReadDir(stacksDir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read the stacks of registry %s", registryDir)
+	}
+
+	devfileIndex := []registryIndexEntry{}
+	for _, dir := range dirs {
+		if !dir.IsDir() {
+			continue
+		}
+		stackDir := filepath.Join(stacksDir, dir.Name())
+		if util.CheckPathExists(filepath.Join(stackDir, "devfile.yaml")) {
+			entry, err := getFileRegistryStackEntry(stackDir, path.Join(registryUtil.FileRegistryStacksDir, dir.Name()))
+			if err != nil {
+				return nil, err
+			}
+			entry.Name = dir.Name()
+			devfileIndex = append(devfileIndex, entry)
+			continue
+		}
+
+		entry, err := getFileRegistryMultiVersionEntry(stackDir, dir.Name())
+		if err != nil {
+			return nil, err
+		}
+		if entry != nil {
+			devfileIndex = append(devfileIndex, *entry)
+		}
+	}
+	return devfileIndex, nil
+}
+
+// getFileRegistryMultiVersionEntry returns the index entry of a stack whose versions are stored in sub directories
+// of the stack directory, or nil when the directory contains no version of a stack
+func getFileRegistryMultiVersionEntry(stackDir, stackName string) (*registryIndexEntry, error) {
+	versionDirs, err := ioutil.ReadDir(stackDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var stackFile struct {
+		Versions []struct {
+			Version string `json:"version"`
+			Default bool   `json:"default,omitempty"`
+		} `json:"versions,omitempty"`
+	}
+	stackFilePath := filepath.Join(stackDir, fileRegistryStackFile)
+	if util.CheckPathExists(stackFilePath) {
+		stackFileData, err := ioutil.ReadFile(stackFilePath)
+		if err != nil {
+			return nil, err
+		}
+		err = yaml.Unmarshal(stackFileData, &stackFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to read the versions of %s", stackFilePath)
+		}
+	}
+	isDefault := func(version string) bool {
+		for _, v := range stackFile.Versions {
+			if v.Version == version {
+				return v.Default
+			}
+		}
+		return false
+	}
+
+	var entries []registryIndexEntry
+	var stackEntry *registryIndexEntry
+	for _, versionDir := range versionDirs {
+		if !versionDir.IsDir() || !util.CheckPathExists(filepath.Join(stackDir, versionDir.Name(), "devfile.yaml")) {
+			continue
+		}
+		entry, err := getFileRegistryStackEntry(filepath.Join(stackDir, versionDir.Name()), path.Join(registryUtil.FileRegistryStacksDir, stackName, versionDir.Name()))
+		if err != nil {
+			return nil, err
+		}
+		if entry.Version == "" {
+			entry.Version = versionDir.Name()
+		}
+		entries = append(entries, entry)
+	}
+	if len(entries) == 0 {
+		return nil, nil
+	}
+
+	var versions []registryIndexVersion
+	for i := range entries {
+		version := registryIndexVersion{
+			Version:         entries[i].Version,
+			Default:         isDefault(entries[i].Version),
+			Links:           entries[i].Links,
+			StarterProjects: entries[i].StarterProjects,
+		}
+		versions = append(versions, version)
+		if version.Default {
+			stackEntry = &entries[i]
+		}
+	}
+	if stackEntry == nil {
+		// without version marked as default, the stack is described by its latest version
+		_, defaultVersion := getStackVersions(registryIndexEntry{Versions: versions})
+		for i := range entries {
+			if entries[i].Version == defaultVersion.Version {
+				stackEntry = &entries[i]
+			}
+		}
+	}
+	stackEntry.Name = stackName
+	stackEntry.Versions = versions
+	return stackEntry, nil
+}
+
+// getFileRegistryStackEntry returns the index entry of the stack whose devfile is stored in stackDir,
+// with a link to the devfile relative to the registry directory
+func getFileRegistryStackEntry(stackDir, link string) (registryIndexEntry, error) {
+	devfilePath := filepath.Join(stackDir, "devfile.yaml")
+	devfileData, err := ioutil.ReadFile(devfilePath)
+	if err != nil {
+		return registryIndexEntry{}, err
+	}
+	var devfile struct {
+		Metadata        devfilepkg.DevfileMetadata `json:"metadata,omitempty"`
+		StarterProjects []struct {
+			Name string `json:"name"`
+		} `json:"starterProjects,omitempty"`
+	}
+	err = yaml.Unmarshal(devfileData, &devfile)
+	if err != nil {
+		return registryIndexEntry{}, errors.Wrapf(err, "unable to read the metadata of %s", devfilePath)
+	}
+
+	entry := registryIndexEntry{Schema: indexSchema.Schema{
+		Version:     devfile.Metadata.Version,
+		DisplayName: devfile.Metadata.DisplayName,
+		Description: devfile.Metadata.Description,
+		Type:        indexSchema.StackDevfileType,
+		Tags:        devfile.Metadata.Tags,
+		Icon:        devfile.Metadata.Icon,
+		ProjectType: devfile.Metadata.ProjectType,
+		Language:    devfile.Metadata.Language,
+		Links:       map[string]string{"self": path.Join(link, "devfile.yaml")},
+	}}
+	for _, starterProject := range devfile.StarterProjects {
+		entry.StarterProjects = append(entry.StarterProjects, starterProject.Name)
+	}
+	return entry, nil
+}
+
+// GetFileRegistryStackDir returns the directory containing the devfile and the resources of the version of the stack
+// in a registry stored in a local directory; the versions of a stack are stored in sub directories of the stack directory
+func GetFileRegistryStackDir(registry Registry, stackName, version string) string {
+	stackDir := filepath.Join(registryUtil.GetFileRegistryDir(registry.URL), registryUtil.FileRegistryStacksDir, stackName)
+	if version != "" && util.CheckPathExists(filepath.Join(stackDir, version, "devfile.yaml")) {
+		return filepath.Join(stackDir, version)
+	}
+	return stackDir
+}
+
+// getFileRegistryStarterArchive returns the path of the archive of the starter project of the stack
+// in a registry stored in a local directory
+func getFileRegistryStarterArchive(registryDir, stackName, starterName string) string {
+	return filepath.Join(registryDir, fileRegistryStartersDir, stackName, starterName+".zip")
+}
+
+// UseMirroredStarterProject returns the starter project of the stack, downloaded from the archive of the project
+// when the registry is stored in a local directory containing this archive
+func UseMirroredStarterProject(registry Registry, stackName string, starterProject *devfilev1.StarterProject) *devfilev1.StarterProject {
+	if !registryUtil.IsFileBasedRegistry(registry.URL) {
+		return starterProject
+	}
+	if validation.ValidateName(stackName) != nil || validation.ValidateName(starterProject.Name) != nil {
+		// the names are part of the path of the archive, the archives of invalid names are never mirrored
+		return starterProject
+	}
+	archive := getFileRegistryStarterArchive(registryUtil.GetFileRegistryDir(registry.URL), stackName, starterProject.Name)
+	if !util.CheckPathExists(archive) {
+		return starterProject
+	}
+	mirrored := starterProject.DeepCopy()
+	mirrored.ProjectSource = devfilev1.ProjectSource{
+		Zip: &devfilev1.ZipProjectSource{Location: registryUtil.FileRegistryPrefix + filepath.ToSlash(archive)},
+	}
+	return mirrored
+}
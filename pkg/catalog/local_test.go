@@ -0,0 +1,99 @@
+package catalog
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	registryUtil "github.com/redhat-developer/odo/pkg/odo/cli/registry/util"
+)
+
+func TestGetFileRegistryDevfilesWithoutIndex(t *testing.T) {
+	registryDir, err := ioutil.TempDir("", "odo-registry")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(registryDir)
+
+	stacks := map[string]string{
+		"java-maven": `schemaVersion: 2.1.0
+metadata:
+  name: java-maven-stack
+  displayName: Maven Java
+  description: Upstream Maven and OpenJDK 11
+  language: java
+  projectType: maven
+  tags: ["Java", "Maven"]
+  version: 1.1.0
+starterProjects:
+- name: springbootproject
+  git:
+    remotes:
+      origin: "https://github.com/odo-devfiles/springboot-ex.git"
+`,
+		"nodejs": `schemaVersion: 2.0.0
+metadata:
+  name: nodejs
+  language: nodejs
+`,
+	}
+	for name, devfile := range stacks {
+		dir := filepath.Join(registryDir, "stacks", name)
+		if err = os.MkdirAll(dir, 0750); err != nil {
+			t.Fatal(err)
+		}
+		if err = ioutil.WriteFile(filepath.Join(dir, "devfile.yaml"), []byte(devfile), 0640); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// directories without devfile are not stacks
+	if err = os.MkdirAll(filepath.Join(registryDir, "stacks", "README"), 0750); err != nil {
+		t.Fatal(err)
+	}
+
+	registry := Registry{Name: "local", URL: registryUtil.FileRegistryPrefix + filepath.ToSlash(registryDir)}
+	devfileIndex, _, err := getRegistryIndex(registry)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(devfileIndex) != 2 {
+		t.Fatalf("got index %+v, want 2 stacks", devfileIndex)
+	}
+	javaStack := devfileIndex[0]
+	if javaStack.Name != "java-maven" || javaStack.Version != "1.1.0" || javaStack.ProjectType != "maven" ||
+		!reflect.DeepEqual(javaStack.StarterProjects, []string{"springbootproject"}) || javaStack.Links["self"] != "stacks/java-maven/devfile.yaml" {
+		t.Errorf("got index entry %+v", javaStack)
+	}
+
+	devfiles, err := getRegistryDevfiles(registry)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []DevfileComponentType{
+		{
+			Name:        "java-maven",
+			DisplayName: "Maven Java",
+			Description: "Upstream Maven and OpenJDK 11",
+			Link:        "stacks/java-maven/devfile.yaml",
+			Registry:    registry,
+			Language:    "java",
+			ProjectType: "maven",
+			Tags:        []string{"Java", "Maven"},
+			Version:     "1.1.0",
+		},
+		{
+			Name:     "nodejs",
+			Link:     "stacks/nodejs/devfile.yaml",
+			Registry: registry,
+			Language: "nodejs",
+		},
+	}
+	if !reflect.DeepEqual(devfiles, want) {
+		t.Errorf("got devfiles %+v, want %+v", devfiles, want)
+	}
+	if dir := GetFileRegistryStackDir(registry, "nodejs", ""); dir != filepath.Join(registryDir, "stacks", "nodejs") {
+		t.Errorf("got stack directory %s", dir)
+	}
+}
@@ -0,0 +1,201 @@
+package catalog
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+	"github.com/redhat-developer/odo/pkg/component"
+	"github.com/redhat-developer/odo/pkg/log"
+	registryUtil "github.com/redhat-developer/odo/pkg/odo/cli/registry/util"
+	"github.com/redhat-developer/odo/pkg/odo/util/validation"
+	"github.com/redhat-developer/odo/pkg/preference"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+// MirrorResult describes the content of a registry mirrored in a local directory
+type MirrorResult struct {
+	// Stacks is the number of mirrored stacks
+	Stacks int
+	// StarterProjects is the number of mirrored starter projects
+	StarterProjects int
+	// Warnings are the stacks and the starter projects which cannot be mirrored
+	Warnings []string
+}
+
+// MirrorRegistry downloads the index of the registry, the devfile and resources of each of its stacks, and the archives
+// of their starter projects into dir, so that dir can be used as a file based registry; the stacks and the starter
+// projects failing their verification are not mirrored, unless skipVerify is true
+func MirrorRegistry(registry Registry, dir string, skipVerify bool) (MirrorResult, error) {
+	var result MirrorResult
+
+	devfileIndex, registry, err := getRegistryIndex(registry)
+	if err != nil {
+		return result, errors.Wrapf(err, "unable to get the index of registry %s", registry.Name)
+	}
+
+	credentials, err := getRegistryCredentials(registry)
+	if err != nil {
+		return result, err
+	}
+
+	mirroredIndex := make([]registryIndexEntry, 0, len(devfileIndex))
+	for _, stack := range devfileIndex {
+		// the names of the stacks and of the starter projects are part of the paths of the mirror
+		if err = validation.ValidateName(stack.Name); err != nil {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("unable to mirror stack %q: %v", stack.Name, err))
+			continue
+		}
+		// only the default version of the stacks is mirrored
+		_, defaultVersion := getStackVersions(stack)
+		entry := getDefaultVersionEntry(stack, defaultVersion)
+
+		spinner := log.Spinnerf("Mirroring stack %q", stack.Name)
+		stackDir := filepath.Join(dir, registryUtil.FileRegistryStacksDir, stack.Name)
+		err = os.RemoveAll(stackDir)
+		if err == nil {
+			err = os.MkdirAll(stackDir, 0750)
+		}
+		if err == nil {
+			err = mirrorStack(registry, stack.Name, defaultVersion, credentials, stackDir)
+		}
+		if err != nil {
+			spinner.End(false)
+			return result, errors.Wrapf(err, "unable to mirror stack %s", stack.Name)
+		}
+		if !skipVerify {
+			if err = VerifyStack(registry, stack.Name, defaultVersion, stackDir); err != nil {
+				spinner.End(false)
+				result.Warnings = append(result.Warnings, fmt.Sprintf("unable to mirror stack %s: %v", stack.Name, err))
+				if err = os.RemoveAll(stackDir); err != nil {
+					return result, err
+				}
+				continue
+			}
+		}
+		spinner.End(true)
+		result.Stacks++
+
+		starterProjects, err := getStarterProjects(filepath.Join(stackDir, "devfile.yaml"))
+		if err != nil {
+			return result, err
+		}
+		for j := range starterProjects {
+			name := starterProjects[j].Name
+			if err = validation.ValidateName(name); err != nil {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("unable to mirror starter project %q of stack %s: %v", name, stack.Name, err))
+				continue
+			}
+			checksum := defaultVersion.StarterProjectChecksums[name]
+			if skipVerify {
+				checksum = ""
+			}
+			archive := getFileRegistryStarterArchive(dir, stack.Name, name)
+			err = mirrorStarterProject(&starterProjects[j], credentials, checksum, archive)
+			if err != nil {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("unable to mirror starter project %s of stack %s: %v", name, stack.Name, err))
+				continue
+			}
+			// the mirrored archive differs from the original one, its checksum is listed in the index of the mirror
+			content, err := ioutil.ReadFile(archive)
+			if err != nil {
+				return result, err
+			}
+			if entry.StarterProjectChecksums == nil {
+				entry.StarterProjectChecksums = map[string]string{}
+			}
+			entry.StarterProjectChecksums[name] = util.GetChecksum(content)
+			result.StarterProjects++
+		}
+		mirroredIndex = append(mirroredIndex, entry)
+	}
+
+	jsonBytes, err := json.MarshalIndent(mirroredIndex, "", "  ")
+	if err != nil {
+		return result, err
+	}
+	err = ioutil.WriteFile(filepath.Join(dir, registryUtil.FileRegistryIndex), jsonBytes, 0640)
+	return result, err
+}
+
+// getDefaultVersionEntry returns the index entry describing only the default version of the stack
+func getDefaultVersionEntry(entry registryIndexEntry, defaultVersion StackVersion) registryIndexEntry {
+	if len(entry.Versions) == 0 {
+		return entry
+	}
+	entry.Versions = nil
+	entry.Version = defaultVersion.Version
+	entry.Links = map[string]string{"self": defaultVersion.Link}
+	entry.StarterProjects = defaultVersion.StarterProjects
+	entry.registryIndexVerification = registryIndexVerification{
+		Checksums:               defaultVersion.Checksums,
+		StarterProjectChecksums: defaultVersion.StarterProjectChecksums,
+		Signatures:              defaultVersion.Signatures,
+	}
+	return entry
+}
+
+// mirrorStack downloads the devfile and the resources of the version of the stack into stackDir
+func mirrorStack(registry Registry, stackName string, version StackVersion, credentials util.HTTPCredentials, stackDir string) error {
+	switch {
+	case registryUtil.IsFileBasedRegistry(registry.URL):
+		return util.CopyDirWithFS(GetFileRegistryStackDir(registry, stackName, version.Version), stackDir)
+	case registryUtil.IsGitBasedRegistry(registry.URL):
+		// GitHub-based registries only contain the devfiles of the stacks
+		prefClient, err := preference.NewClient()
+		if err != nil {
+			return err
+		}
+		devfileData, err := util.DownloadFileInMemoryWithCache(util.HTTPRequestParams{URL: registry.URL + version.Link, HTTPCredentials: credentials}, prefClient.GetRegistryCacheTime())
+		if err != nil {
+			return err
+		}
+		return ioutil.WriteFile(filepath.Join(stackDir, "devfile.yaml"), devfileData, 0640)
+	default:
+		return PullStack(registry, stackName, version, stackDir)
+	}
+}
+
+// getStarterProjects returns the starter projects declared in the devfile
+func getStarterProjects(devfilePath string) ([]devfilev1.StarterProject, error) {
+	devfileData, err := ioutil.ReadFile(devfilePath)
+	if err != nil {
+		return nil, err
+	}
+	var devfile struct {
+		StarterProjects []devfilev1.StarterProject `json:"starterProjects,omitempty"`
+	}
+	err = yaml.Unmarshal(devfileData, &devfile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read the starter projects of %s", devfilePath)
+	}
+	return devfile.StarterProjects, nil
+}
+
+// mirrorStarterProject downloads the whole starter project, whatever its sub directory, into the archive,
+// verifying its checksum when not empty
+func mirrorStarterProject(starterProject *devfilev1.StarterProject, credentials util.HTTPCredentials, checksum string, archive string) error {
+	tmpDir, err := ioutil.TempDir("", "odo-mirror")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	project := starterProject.DeepCopy()
+	project.SubDir = ""
+	err = component.DownloadStarterProject(project, credentials, checksum, tmpDir)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(archive), 0750)
+	if err != nil {
+		return err
+	}
+	return util.Zip(tmpDir, archive, starterProject.Name)
+}
@@ -0,0 +1,130 @@
+package catalog
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	registryUtil "github.com/redhat-developer/odo/pkg/odo/cli/registry/util"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+func TestMirrorRegistry(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "odo-mirror-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// the starter project of the source registry is an archive of a directory containing main.go
+	projectDir := filepath.Join(tmpDir, "project")
+	starterArchive := filepath.Join(tmpDir, "starter.zip")
+	if err = os.MkdirAll(projectDir, 0750); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(filepath.Join(projectDir, "main.go"), []byte("package main\n"), 0640); err != nil {
+		t.Fatal(err)
+	}
+	if err = util.Zip(projectDir, starterArchive, "go-starter"); err != nil {
+		t.Fatal(err)
+	}
+
+	sourceDir := filepath.Join(tmpDir, "source")
+	stackDir := filepath.Join(sourceDir, "stacks", "go")
+	if err = os.MkdirAll(stackDir, 0750); err != nil {
+		t.Fatal(err)
+	}
+	invalidStackDir := filepath.Join(sourceDir, "stacks", "invalid")
+	if err = os.MkdirAll(invalidStackDir, 0750); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(filepath.Join(invalidStackDir, "devfile.yaml"), []byte("schemaVersion: 2.0.0\n"), 0640); err != nil {
+		t.Fatal(err)
+	}
+	// the entry whose name is not a valid path element is skipped, instead of being written outside of the mirror,
+	// and the stack whose checksum does not match is skipped
+	index := `[{"name": "go", "displayName": "Go Runtime", "language": "go", "links": {"self": "devfile-catalog/go:latest"}},
+		{"name": "../escape", "links": {"self": "devfile-catalog/escape:latest"}},
+		{"name": "invalid", "links": {"self": "devfile-catalog/invalid:latest"}, "checksums": {"devfile.yaml": "` + util.GetChecksum([]byte("other")) + `"}}]`
+	if err = ioutil.WriteFile(filepath.Join(sourceDir, "index.json"), []byte(index), 0640); err != nil {
+		t.Fatal(err)
+	}
+	devfile := `schemaVersion: 2.0.0
+metadata:
+  name: go
+starterProjects:
+- name: go-starter
+  zip:
+    location: file://` + filepath.ToSlash(starterArchive) + `
+`
+	if err = ioutil.WriteFile(filepath.Join(stackDir, "devfile.yaml"), []byte(devfile), 0640); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(filepath.Join(stackDir, "kubernetes.yaml"), []byte("kind: Deployment\n"), 0640); err != nil {
+		t.Fatal(err)
+	}
+
+	mirrorDir := filepath.Join(tmpDir, "mirror")
+	source := Registry{Name: "source", URL: registryUtil.FileRegistryPrefix + filepath.ToSlash(sourceDir)}
+	result, err := MirrorRegistry(source, mirrorDir, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Stacks != 1 || result.StarterProjects != 1 || len(result.Warnings) != 2 {
+		t.Errorf("got result %+v, want 1 stack, 1 starter project and 2 warnings", result)
+	}
+	if util.CheckPathExists(filepath.Join(tmpDir, "escape")) {
+		t.Errorf("the invalid stack is mirrored outside of the mirror")
+	}
+	if util.CheckPathExists(filepath.Join(mirrorDir, "stacks", "invalid")) {
+		t.Errorf("the stack failing its verification is mirrored")
+	}
+	for _, file := range []string{"index.json", "stacks/go/devfile.yaml", "stacks/go/kubernetes.yaml", "starters/go/go-starter.zip"} {
+		if !util.CheckPathExists(filepath.Join(mirrorDir, filepath.FromSlash(file))) {
+			t.Errorf("the file %s is not mirrored", file)
+		}
+	}
+
+	// the stack failing its verification is mirrored when the verification is skipped
+	skipDir := filepath.Join(tmpDir, "skip")
+	result, err = MirrorRegistry(source, skipDir, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Stacks != 2 || len(result.Warnings) != 1 {
+		t.Errorf("got result %+v, want 2 stacks and 1 warning", result)
+	}
+
+	mirror := Registry{Name: "mirror", URL: registryUtil.FileRegistryPrefix + filepath.ToSlash(mirrorDir)}
+	devfiles, err := getRegistryDevfiles(mirror)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(devfiles) != 1 || devfiles[0].Name != "go" || devfiles[0].Language != "go" {
+		t.Errorf("got devfiles %+v, want the go stack", devfiles)
+	}
+
+	// the starter project is downloaded from the mirrored archive
+	starterProject := &devfilev1.StarterProject{
+		Name:          "go-starter",
+		ProjectSource: devfilev1.ProjectSource{Git: &devfilev1.GitProjectSource{}},
+	}
+	mirrored := UseMirroredStarterProject(mirror, "go", starterProject)
+	if mirrored.Git != nil || mirrored.Zip == nil {
+		t.Fatalf("got starter project %+v, want a zip starter project", mirrored)
+	}
+	extractDir := filepath.Join(tmpDir, "extract")
+	if err = util.GetAndExtractZip(mirrored.Zip.Location, extractDir, "/", util.HTTPCredentials{}, ""); err != nil {
+		t.Fatal(err)
+	}
+	if !util.CheckPathExists(filepath.Join(extractDir, "main.go")) {
+		t.Errorf("the mirrored starter project does not contain main.go")
+	}
+
+	// the starter projects of the other registries are not modified
+	if got := UseMirroredStarterProject(Registry{URL: "https://registry.devfile.io"}, "go", starterProject); got != starterProject {
+		t.Errorf("got starter project %+v, want the original one", got)
+	}
+}
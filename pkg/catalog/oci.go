@@ -0,0 +1,145 @@
+package catalog
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/tls"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/containerd/containerd/remotes/docker"
+	"github.com/deislabs/oras/pkg/content"
+	orasctx "github.com/deislabs/oras/pkg/context"
+	"github.com/deislabs/oras/pkg/oras"
+	registryLibrary "github.com/devfile/registry-support/registry-library/library"
+	"github.com/pkg/errors"
+	"github.com/redhat-developer/odo/pkg/segment"
+)
+
+// stackArchive is the archive of the resources of a stack pulled from an OCI-based registry
+const stackArchive = "archive.tar"
+
+// PullStack pulls the devfile and the resources of the version of the stack from the OCI-based registry into destDir,
+// authenticating to the registry with its credentials
+func PullStack(registry Registry, stackName string, version StackVersion, destDir string) error {
+	options := segment.GetRegistryOptions()
+	credentials, err := getRegistryCredentials(registry)
+	if err != nil {
+		return err
+	}
+	if version.Link == "" || (version.Default && credentials.IsEmpty()) {
+		return registryLibrary.PullStackFromRegistry(registry.URL, stackName, destDir, options)
+	}
+
+	// the library only pulls the default version of the stacks without authentication,
+	// the other versions and the stacks of the registries with authentication are pulled from their link
+	urlObj, err := url.Parse(registry.URL)
+	if err != nil {
+		return err
+	}
+	headers := make(http.Header)
+	for name, value := range map[string]string{"User": options.Telemetry.User, "Client": options.Telemetry.Client, "Locale": options.Telemetry.Locale} {
+		if value != "" {
+			headers.Add(name, value)
+		}
+	}
+	if credentials.Username == "" && credentials.Token != "" {
+		headers.Set("Authorization", "Bearer "+credentials.Token)
+	}
+	tlsConfig := credentials.TLSConfig
+	if tlsConfig == nil {
+		tlsConfig = &tls.Config{InsecureSkipVerify: options.SkipTLSVerify}
+	}
+	resolverOptions := docker.ResolverOptions{
+		Headers:   headers,
+		PlainHTTP: urlObj.Scheme != "https",
+		Client: &http.Client{
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		},
+	}
+	if credentials.Username != "" {
+		resolverOptions.Credentials = func(string) (string, string, error) {
+			return credentials.Username, credentials.Password, nil
+		}
+	}
+	resolver := docker.NewResolver(resolverOptions)
+	ref := path.Join(urlObj.Host, version.Link)
+	fileStore := content.NewFileStore(destDir)
+	defer fileStore.Close()
+
+	_, _, err = oras.Pull(orasctx.Background(), resolver, ref, fileStore, oras.WithAllowedMediaTypes(registryLibrary.DevfileAllMediaTypesList))
+	if err != nil {
+		return errors.Wrapf(err, "failed to pull version %s of stack %s from %s", version.Version, stackName, ref)
+	}
+
+	archivePath := filepath.Join(destDir, stackArchive)
+	if _, err = os.Stat(archivePath); err != nil {
+		return nil
+	}
+	err = extractStackArchive(archivePath, destDir)
+	if err != nil {
+		return err
+	}
+	return os.Remove(archivePath)
+}
+
+// extractStackArchive extracts the gzipped tar archive of the resources of a stack into destDir
+func extractStackArchive(archivePath, destDir string) error {
+	reader, err := os.Open(archivePath)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	gzReader, err := gzip.NewReader(reader)
+	if err != nil {
+		return errors.Wrapf(err, "unable to read the archive %s", archivePath)
+	}
+	defer gzReader.Close()
+
+	tarReader := tar.NewReader(gzReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrapf(err, "unable to read the archive %s", archivePath)
+		}
+
+		name := path.Clean(strings.TrimPrefix(header.Name, "/"))
+		if name == ".." || strings.HasPrefix(name, "../") {
+			return errors.Errorf("invalid path %s in the archive %s", header.Name, archivePath)
+		}
+		target := filepath.Join(destDir, filepath.FromSlash(name))
+		switch header.Typeflag {
+		case tar.TypeDir:
+			err = os.MkdirAll(target, os.FileMode(header.Mode))
+		case tar.TypeReg:
+			err = extractFile(tarReader, target, os.FileMode(header.Mode))
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// extractFile writes the content of the current file of the archive into target
+func extractFile(tarReader *tar.Reader, target string, mode os.FileMode) error {
+	err := os.MkdirAll(filepath.Dir(target), 0750)
+	if err != nil {
+		return err
+	}
+	w, err := os.OpenFile(target, os.O_CREATE|os.O_RDWR|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+	defer w.Close()
+	_, err = io.Copy(w, tarReader) // #nosec G110
+	return err
+}
@@ -0,0 +1,223 @@
+package catalog
+
+import (
+	"sort"
+	"strings"
+)
+
+// ComponentSearchFilter restricts the components searched by SearchComponent
+type ComponentSearchFilter struct {
+	// Registry is the name of the registry of the components, all the registries are searched when empty
+	Registry string
+	// Language is the language of the components
+	Language string
+	// Tags are tags the components must all have
+	Tags []string
+	// ProjectType is the project type of the components
+	ProjectType string
+}
+
+// ComponentSearchResult is a component matching a search, along with the score of the match
+type ComponentSearchResult struct {
+	DevfileComponentType
+	// Score is the relevance of the component for the search term, the most relevant components having the highest scores
+	Score int
+}
+
+// weights of the fields of the components when computing the score of a match
+const (
+	nameWeight        = 10
+	displayNameWeight = 6
+	tagWeight         = 4
+	descriptionWeight = 2
+)
+
+// scores of the ways a word of the search term matches a word of a field
+const (
+	exactMatch       = 100
+	prefixMatch      = 75
+	substringMatch   = 50
+	subsequenceMatch = 25
+	typoMatch        = 15
+)
+
+// SearchComponent searches the components of the registries matching the filter for the search term; every word
+// of the search term must match the name, display name, tags or description of a component, exactly or approximately.
+// All the components matching the filter are returned when the search term is empty.
+// The results are sorted from the most relevant to the least relevant.
+func SearchComponent(term string, filter ComponentSearchFilter) ([]ComponentSearchResult, error) {
+	catalogDevfileList, err := ListDevfileComponents(filter.Registry)
+	if err != nil {
+		return nil, err
+	}
+	return searchDevfileComponents(catalogDevfileList.Items, term, filter), nil
+}
+
+// searchDevfileComponents returns the components matching the search term and the filter, sorted by relevance
+func searchDevfileComponents(components []DevfileComponentType, term string, filter ComponentSearchFilter) []ComponentSearchResult {
+	words := strings.Fields(strings.ToLower(term))
+	results := []ComponentSearchResult{}
+	for _, component := range components {
+		if !matchFilter(component, filter) {
+			continue
+		}
+		score, ok := scoreComponent(component, words)
+		if !ok {
+			continue
+		}
+		results = append(results, ComponentSearchResult{DevfileComponentType: component, Score: score})
+	}
+	// the order of the registries is kept for the components with the same score and name
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].Name < results[j].Name
+	})
+	return results
+}
+
+// matchFilter returns true when the component matches all the criteria of the filter
+func matchFilter(component DevfileComponentType, filter ComponentSearchFilter) bool {
+	if filter.Language != "" && !strings.EqualFold(component.Language, filter.Language) {
+		return false
+	}
+	if filter.ProjectType != "" && !strings.EqualFold(component.ProjectType, filter.ProjectType) {
+		return false
+	}
+	for _, tag := range filter.Tags {
+		found := false
+		for _, componentTag := range component.Tags {
+			if strings.EqualFold(componentTag, tag) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// scoreComponent returns the score of the component for the words of the search term,
+// and false when a word matches none of the fields of the component
+func scoreComponent(component DevfileComponentType, words []string) (int, bool) {
+	if len(words) == 0 {
+		return 0, true
+	}
+	fields := []struct {
+		text   string
+		weight int
+	}{
+		{component.Name, nameWeight},
+		{component.DisplayName, displayNameWeight},
+		{strings.Join(component.Tags, " "), tagWeight},
+		{component.Description, descriptionWeight},
+	}
+
+	total := 0
+	for _, word := range words {
+		best := 0
+		for _, field := range fields {
+			if score := field.weight * matchWord(word, field.text); score > best {
+				best = score
+			}
+		}
+		if best == 0 {
+			return 0, false
+		}
+		total += best
+	}
+	return total, true
+}
+
+// matchWord returns the score of the best match of the word in the text, 0 when the word does not match the text
+func matchWord(word, text string) int {
+	text = strings.ToLower(text)
+	if text == "" {
+		return 0
+	}
+	if text == word {
+		return exactMatch
+	}
+
+	best := 0
+	for _, textWord := range strings.FieldsFunc(text, isSeparator) {
+		score := 0
+		switch {
+		case textWord == word:
+			score = exactMatch
+		case strings.HasPrefix(textWord, word):
+			score = prefixMatch
+		case strings.Contains(textWord, word):
+			score = substringMatch
+		case len(word) > 3 && editDistance(word, textWord) <= len(word)/4:
+			// typos are tolerated in words long enough not to match any short word
+			score = typoMatch
+		}
+		if score > best {
+			best = score
+		}
+	}
+	if best == 0 && strings.Contains(text, word) {
+		best = substringMatch
+	}
+	if best == 0 && len(word) > 1 && isSubsequence(word, text) && len(text) <= 3*len(word) {
+		// the letters of the word in the same order, as an abbreviation of a short text
+		best = subsequenceMatch
+	}
+	return best
+}
+
+// isSeparator returns true for the characters separating the words of the fields of the components
+func isSeparator(r rune) bool {
+	return !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '.' || r == '+' || r == '#')
+}
+
+// isSubsequence returns true when all the characters of word are found in text in the same order
+func isSubsequence(word, text string) bool {
+	i := 0
+	for _, r := range text {
+		if i < len(word) && rune(word[i]) == r {
+			i++
+		}
+	}
+	return i == len(word)
+}
+
+// editDistance returns the number of single character edits (insertions, deletions, substitutions
+// and transpositions of adjacent characters) needed to change a into b
+func editDistance(a, b string) int {
+	d := make([][]int, len(a)+1)
+	for i := range d {
+		d[i] = make([]int, len(b)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			d[i][j] = min(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+	return d[len(a)][len(b)]
+}
+
+func min(values ...int) int {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
@@ -0,0 +1,140 @@
+package catalog
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSearchDevfileComponents(t *testing.T) {
+	components := []DevfileComponentType{
+		{
+			Name:        "java-maven",
+			DisplayName: "Maven Java",
+			Description: "Upstream Maven and OpenJDK 11",
+			Language:    "java",
+			ProjectType: "maven",
+			Tags:        []string{"Java", "Maven"},
+		},
+		{
+			Name:        "java-springboot",
+			DisplayName: "Spring Boot",
+			Description: "Spring Boot using Java",
+			Language:    "java",
+			ProjectType: "springboot",
+			Tags:        []string{"Java", "Spring"},
+		},
+		{
+			Name:        "nodejs",
+			DisplayName: "NodeJS Runtime",
+			Description: "Stack with NodeJS 12",
+			Language:    "nodejs",
+			ProjectType: "nodejs",
+			Tags:        []string{"NodeJS", "Express"},
+		},
+		{
+			Name:        "python",
+			DisplayName: "Python",
+			Description: "Python Stack with Python 3.7",
+			Language:    "python",
+			ProjectType: "python",
+			Tags:        []string{"Python", "pip"},
+		},
+		{
+			Name:        "python-django",
+			DisplayName: "Django",
+			Description: "Python3.7 with Django",
+			Language:    "python",
+			ProjectType: "django",
+			Tags:        []string{"Python", "pip", "Django"},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		term   string
+		filter ComponentSearchFilter
+		want   []string
+	}{
+		{
+			name: "exact name ranked first",
+			term: "python",
+			want: []string{"python", "python-django"},
+		},
+		{
+			name: "prefix of a name",
+			term: "spring",
+			want: []string{"java-springboot"},
+		},
+		{
+			name: "match in the tags and the description",
+			term: "java",
+			want: []string{"java-maven", "java-springboot"},
+		},
+		{
+			name: "typo",
+			term: "djnago",
+			want: []string{"python-django"},
+		},
+		{
+			name: "abbreviation",
+			term: "njs",
+			want: []string{"nodejs"},
+		},
+		{
+			name: "all the words must match",
+			term: "python django",
+			want: []string{"python-django"},
+		},
+		{
+			name: "no match",
+			term: "golang",
+			want: []string{},
+		},
+		{
+			name:   "filter without term",
+			filter: ComponentSearchFilter{Language: "Java"},
+			want:   []string{"java-maven", "java-springboot"},
+		},
+		{
+			name:   "filter on the tags",
+			term:   "python",
+			filter: ComponentSearchFilter{Tags: []string{"pip", "django"}},
+			want:   []string{"python-django"},
+		},
+		{
+			name:   "filter on the project type",
+			filter: ComponentSearchFilter{ProjectType: "maven"},
+			want:   []string{"java-maven"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results := searchDevfileComponents(components, tt.term, tt.filter)
+			got := []string{}
+			for _, result := range results {
+				got = append(got, result.Name)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"django", "django", 0},
+		{"djnago", "django", 1},
+		{"pyton", "python", 1},
+		{"", "go", 2},
+	}
+	for _, tt := range tests {
+		if got := editDistance(tt.a, tt.b); got != tt.want {
+			t.Errorf("editDistance(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,252 @@
+package catalog
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+	"k8s.io/klog"
+
+	registryUtil "github.com/redhat-developer/odo/pkg/odo/cli/registry/util"
+	"github.com/redhat-developer/odo/pkg/preference"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+const (
+	// serviceTemplatesDirName is the directory of the odo configuration directory containing the local service templates,
+	// stored as <source>/<name>.yaml
+	serviceTemplatesDirName = "servicetemplates"
+	// serviceTemplatesIndexPath is the path of the index of the service templates provided by a registry
+	serviceTemplatesIndexPath = "/service-templates/index.json"
+)
+
+// ServiceTemplateParameter describes a parameter of a service template which is meant to be overridden
+type ServiceTemplateParameter struct {
+	// Name is the key of the parameter, as expressed with the "-p" flag of "odo service create"
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// ServiceTemplate is a named definition of an Operator backed service, shared across a team
+type ServiceTemplate struct {
+	// Name is the name of the template in its source
+	Name string `json:"name"`
+	// Source is the name of the directory or of the registry providing the template
+	Source      string                     `json:"source"`
+	Description string                     `json:"description,omitempty"`
+	Parameters  []ServiceTemplateParameter `json:"parameters,omitempty"`
+	// Service is the definition of the custom resource of the service
+	Service map[string]interface{} `json:"service"`
+}
+
+// FullName returns the name of the template used to reference it, of the form <source>/<name>
+func (t ServiceTemplate) FullName() string {
+	return t.Source + "/" + t.Name
+}
+
+// Kind returns the kind of the service defined by the template
+func (t ServiceTemplate) Kind() string {
+	kind, _ := t.Service["kind"].(string)
+	return kind
+}
+
+// ListServiceTemplates returns the service templates stored locally and provided by the registries,
+// the local templates hiding the templates of the registries with the same name
+func ListServiceTemplates() ([]ServiceTemplate, error) {
+	dir, err := getServiceTemplatesDir()
+	if err != nil {
+		return nil, err
+	}
+	templates, err := listLocalServiceTemplates(dir)
+	if err != nil {
+		return nil, err
+	}
+	found := map[string]bool{}
+	for _, t := range templates {
+		found[t.FullName()] = true
+	}
+
+	registries, err := GetDevfileRegistries("")
+	if err != nil {
+		return nil, err
+	}
+	for _, registry := range registries {
+		registryTemplates, err := getRegistryServiceTemplates(registry)
+		if err != nil {
+			// most registries don't provide service templates
+			klog.V(4).Infof("unable to get the service templates of registry %q: %v", registry.Name, err)
+			continue
+		}
+		for _, t := range registryTemplates {
+			if !found[t.FullName()] {
+				templates = append(templates, t)
+				found[t.FullName()] = true
+			}
+		}
+	}
+
+	sort.Slice(templates, func(i, j int) bool {
+		return templates[i].FullName() < templates[j].FullName()
+	})
+	return templates, nil
+}
+
+// GetServiceTemplate returns the service template with the given name, of the form <source>/<name>,
+// looking for it in the local templates first, then in the registry named as its source
+func GetServiceTemplate(fullName string) (ServiceTemplate, error) {
+	source, name, err := splitServiceTemplateName(fullName)
+	if err != nil {
+		return ServiceTemplate{}, err
+	}
+
+	dir, err := getServiceTemplatesDir()
+	if err != nil {
+		return ServiceTemplate{}, err
+	}
+	filename := filepath.Join(dir, source, name+".yaml")
+	if _, err = os.Stat(filename); err == nil {
+		return readServiceTemplate(filename, source)
+	}
+
+	registries, err := GetDevfileRegistries(source)
+	if err != nil {
+		return ServiceTemplate{}, err
+	}
+	if len(registries) > 0 {
+		templates, err := getRegistryServiceTemplates(registries[0])
+		if err != nil {
+			return ServiceTemplate{}, errors.Wrapf(err, "unable to get the service templates of registry %q", source)
+		}
+		for _, t := range templates {
+			if t.Name == name {
+				return t, nil
+			}
+		}
+	}
+	return ServiceTemplate{}, fmt.Errorf("service template %q not found; use %q to list the available templates", fullName, "odo catalog list services --templates")
+}
+
+// splitServiceTemplateName splits the name of a template of the form <source>/<name>; as the source and the name
+// designate a local directory and file, they can't contain a path separator nor designate a parent directory
+func splitServiceTemplateName(fullName string) (string, string, error) {
+	parts := strings.Split(fullName, "/")
+	if len(parts) != 2 || !isValidServiceTemplatePathElement(parts[0]) || !isValidServiceTemplatePathElement(parts[1]) {
+		return "", "", fmt.Errorf("invalid service template name %q, use the format <source>/<name>", fullName)
+	}
+	return parts[0], parts[1], nil
+}
+
+func isValidServiceTemplatePathElement(element string) bool {
+	return element != "" && element != "." && element != ".." && !strings.ContainsAny(element, `/\`)
+}
+
+func getServiceTemplatesDir() (string, error) {
+	dir, err := preference.GetConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, serviceTemplatesDirName), nil
+}
+
+// listLocalServiceTemplates returns the templates stored in the given directory, as <source>/<name>.yaml files
+func listLocalServiceTemplates(dir string) ([]ServiceTemplate, error) {
+	sources, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var templates []ServiceTemplate
+	for _, source := range sources {
+		if !source.IsDir() {
+			continue
+		}
+		files, err := filepath.Glob(filepath.Join(dir, source.Name(), "*.yaml"))
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			t, err := readServiceTemplate(file, source.Name())
+			if err != nil {
+				return nil, err
+			}
+			templates = append(templates, t)
+		}
+	}
+	return templates, nil
+}
+
+// readServiceTemplate reads the template defined in the given file, named after the file
+func readServiceTemplate(filename string, source string) (ServiceTemplate, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return ServiceTemplate{}, err
+	}
+	var t ServiceTemplate
+	err = yaml.Unmarshal(data, &t)
+	if err != nil {
+		return ServiceTemplate{}, errors.Wrapf(err, "unable to parse the service template %q", filename)
+	}
+	t.Name = strings.TrimSuffix(filepath.Base(filename), ".yaml")
+	t.Source = source
+	return t, validateServiceTemplate(t)
+}
+
+// getRegistryServiceTemplates returns the templates listed in the service templates index of the registry
+func getRegistryServiceTemplates(registry Registry) ([]ServiceTemplate, error) {
+	URL := registry.URL
+	if strings.Contains(URL, "github") {
+		var err error
+		URL, err = convertURL(URL)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to convert URL %s", registry.URL)
+		}
+	}
+
+	cfg, err := preference.NewClient()
+	if err != nil {
+		return nil, err
+	}
+	credentials, err := registryUtil.GetRegistryCredentials(cfg, registry.Name, registry.URL)
+	if err != nil {
+		return nil, err
+	}
+	request := util.HTTPRequestParams{
+		URL:             strings.TrimSuffix(URL, "/") + serviceTemplatesIndexPath,
+		HTTPCredentials: credentials,
+	}
+
+	jsonBytes, err := util.HTTPGetRequest(request, cfg.GetRegistryCacheTime())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to download the service templates index from %s", request.URL)
+	}
+	var templates []ServiceTemplate
+	err = yaml.Unmarshal(jsonBytes, &templates)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to unmarshal the service templates index from %s", request.URL)
+	}
+	for i := range templates {
+		templates[i].Source = registry.Name
+		if err = validateServiceTemplate(templates[i]); err != nil {
+			return nil, err
+		}
+	}
+	return templates, nil
+}
+
+// validateServiceTemplate checks that the template defines a custom resource
+func validateServiceTemplate(t ServiceTemplate) error {
+	if t.Name == "" {
+		return fmt.Errorf("a service template of %q has no name", t.Source)
+	}
+	if apiVersion, _ := t.Service["apiVersion"].(string); apiVersion == "" || t.Kind() == "" {
+		return fmt.Errorf("the service template %q must define the \"apiVersion\" and \"kind\" of its service", t.FullName())
+	}
+	return nil
+}
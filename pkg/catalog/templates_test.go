@@ -0,0 +1,184 @@
+package catalog
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/redhat-developer/odo/pkg/preference"
+)
+
+const postgresSmallTemplate = `description: Small PostgreSQL instance
+parameters:
+- name: postgresVersion
+  description: Major version of PostgreSQL
+service:
+  apiVersion: postgres-operator.crunchydata.com/v1beta1
+  kind: PostgresCluster
+  spec:
+    postgresVersion: 13
+`
+
+const serviceTemplatesIndex = `[
+  {
+    "name": "redis-cache",
+    "description": "Redis cache without persistence",
+    "service": {"apiVersion": "redis.redis.opstreelabs.in/v1beta1", "kind": "Redis", "spec": {"storage": null}}
+  },
+  {
+    "name": "postgres-small",
+    "description": "Overridden by the local template",
+    "service": {"apiVersion": "postgres-operator.crunchydata.com/v1beta1", "kind": "PostgresCluster"}
+  }
+]`
+
+// setupServiceTemplates creates a local template team/postgres-small and a registry named team providing
+// the templates team/redis-cache and team/postgres-small, and returns a function to clean them up
+func setupServiceTemplates(t *testing.T) func() {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != serviceTemplatesIndexPath {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if _, err := rw.Write([]byte(serviceTemplatesIndex)); err != nil {
+			t.Error(err)
+		}
+	}))
+
+	configDir, err := ioutil.TempDir("", "odoconfig")
+	if err != nil {
+		t.Fatal(err)
+	}
+	configFile := filepath.Join(configDir, "preference.yaml")
+	err = ioutil.WriteFile(configFile, []byte(`kind: Preference
+apiversion: odo.openshift.io/v1alpha1
+OdoSettings:
+  RegistryList:
+  - Name: team
+    URL: `+server.URL+`
+`), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.MkdirAll(filepath.Join(configDir, serviceTemplatesDirName, "team"), 0750)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ioutil.WriteFile(filepath.Join(configDir, serviceTemplatesDirName, "team", "postgres-small.yaml"), []byte(postgresSmallTemplate), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.Setenv(preference.GlobalConfigEnvName, configFile)
+
+	return func() {
+		os.Unsetenv(preference.GlobalConfigEnvName)
+		os.RemoveAll(configDir)
+		server.Close()
+	}
+}
+
+func TestListServiceTemplates(t *testing.T) {
+	defer setupServiceTemplates(t)()
+
+	templates, err := ListServiceTemplates()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var got []string
+	for _, tmpl := range templates {
+		got = append(got, tmpl.FullName()+": "+tmpl.Description)
+	}
+	want := []string{
+		"team/postgres-small: Small PostgreSQL instance",
+		"team/redis-cache: Redis cache without persistence",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got templates %q, want %q", got, want)
+	}
+}
+
+func TestGetServiceTemplate(t *testing.T) {
+	defer setupServiceTemplates(t)()
+
+	tests := []struct {
+		name     string
+		fullName string
+		wantKind string
+		wantErr  string
+	}{
+		{
+			name:     "local template",
+			fullName: "team/postgres-small",
+			wantKind: "PostgresCluster",
+		},
+		{
+			name:     "registry template",
+			fullName: "team/redis-cache",
+			wantKind: "Redis",
+		},
+		{
+			name:     "unknown template",
+			fullName: "team/mongodb",
+			wantErr:  "service template \"team/mongodb\" not found",
+		},
+		{
+			name:     "unknown source",
+			fullName: "other/redis-cache",
+			wantErr:  "service template \"other/redis-cache\" not found",
+		},
+		{
+			name:     "invalid name",
+			fullName: "postgres-small",
+			wantErr:  "invalid service template name",
+		},
+		{
+			name:     "parent directory as source",
+			fullName: "../postgres-small",
+			wantErr:  "invalid service template name",
+		},
+		{
+			name:     "path separator in source",
+			fullName: `..\other/postgres-small`,
+			wantErr:  "invalid service template name",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetServiceTemplate(tt.fullName)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("got error %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.FullName() != tt.fullName || got.Kind() != tt.wantKind {
+				t.Errorf("got template %s of kind %s, want %s of kind %s", got.FullName(), got.Kind(), tt.fullName, tt.wantKind)
+			}
+		})
+	}
+}
+
+func TestReadServiceTemplateWithoutKind(t *testing.T) {
+	dir, err := ioutil.TempDir("", "servicetemplates")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "broken.yaml")
+	err = ioutil.WriteFile(filename, []byte("service:\n  apiVersion: v1\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = readServiceTemplate(filename, "team")
+	if err == nil || !strings.Contains(err.Error(), "must define the \"apiVersion\" and \"kind\"") {
+		t.Errorf("got error %v, want an error about the missing kind", err)
+	}
+}
@@ -9,6 +9,8 @@ type Registry struct {
 	Name   string
 	URL    string
 	Secure bool
+	// PublicKey is the path of the public key verifying the signatures of the stacks of the registry
+	PublicKey string `json:",omitempty"`
 }
 
 // DevfileComponentType is the main struct for devfile catalog components
@@ -19,7 +21,29 @@ type DevfileComponentType struct {
 	Link        string
 	Registry    Registry
 	Language    string
+	ProjectType string
 	Tags        []string
+	// Version is the default version of the stack
+	Version string
+	// Versions are the versions of the stack, for registries listing multiple versions of the stacks
+	Versions []StackVersion
+	// verification holds the checksums and the signatures of the default version, for registries listing a single version of the stacks
+	verification registryIndexVerification
+}
+
+// StackVersion is a version of a devfile stack available in a registry
+type StackVersion struct {
+	Version         string
+	SchemaVersion   string
+	Default         bool
+	Link            string
+	StarterProjects []string
+	// Checksums are the checksums of the files of the stack, indexed by their paths relative to the stack directory
+	Checksums map[string]string `json:",omitempty"`
+	// StarterProjectChecksums are the checksums of the zip files of the starter projects, indexed by their names
+	StarterProjectChecksums map[string]string `json:",omitempty"`
+	// Signatures are the base64-encoded signatures of the files of the stack, indexed by their paths relative to the stack directory
+	Signatures map[string]string `json:",omitempty"`
 }
 
 // DevfileComponentTypeList lists all the DevfileComponentType's
@@ -0,0 +1,78 @@
+package catalog
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+// stackDevfile is the devfile of a stack, relative to the stack directory
+const stackDevfile = "devfile.yaml"
+
+// VerifyStack verifies the files of the version of the stack downloaded into dir: their checksums must match the
+// checksums listed in the index of the registry, and when the registry has a public key, the devfile and the other
+// files with signatures listed in the index must be signed with the private key of the registry
+func VerifyStack(registry Registry, stackName string, version StackVersion, dir string) error {
+	if len(version.Checksums) == 0 && registry.PublicKey == "" {
+		klog.V(4).Infof("no checksum nor public key to verify the stack %s of registry %s", stackName, registry.Name)
+		return nil
+	}
+
+	for _, name := range sortedKeys(version.Checksums) {
+		content, err := readStackFile(dir, name)
+		if err != nil {
+			return err
+		}
+		err = util.VerifyChecksum(content, version.Checksums[name])
+		if err != nil {
+			return errors.Wrapf(err, "invalid checksum of the file %s of stack %s", name, stackName)
+		}
+	}
+
+	if registry.PublicKey == "" {
+		return nil
+	}
+	if _, ok := version.Signatures[stackDevfile]; !ok {
+		return &util.VerificationError{Message: fmt.Sprintf("the devfile of stack %s is not signed in the index of registry %s", stackName, registry.Name)}
+	}
+	for _, name := range sortedKeys(version.Signatures) {
+		content, err := readStackFile(dir, name)
+		if err != nil {
+			return err
+		}
+		err = util.VerifySignature(content, version.Signatures[name], registry.PublicKey)
+		if err != nil {
+			return errors.Wrapf(err, "invalid signature of the file %s of stack %s", name, stackName)
+		}
+	}
+	return nil
+}
+
+// readStackFile reads the file of the stack downloaded into dir, the path of the file being relative to dir
+func readStackFile(dir, name string) ([]byte, error) {
+	path := filepath.Join(dir, filepath.FromSlash(name))
+	if rel, err := filepath.Rel(dir, path); err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return nil, errors.Errorf("invalid path %s of a file of the stack", name)
+	}
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to verify the file %s of the stack", name)
+	}
+	return content, nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,124 @@
+package catalog
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+func TestVerifyStack(t *testing.T) {
+	dir, err := ioutil.TempDir("", "odo-verify")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	devfile := []byte("schemaVersion: 2.0.0\n")
+	if err = ioutil.WriteFile(filepath.Join(dir, "devfile.yaml"), devfile, 0600); err != nil {
+		t.Fatal(err)
+	}
+	outerloop := []byte("kind: Deployment\n")
+	if err = ioutil.WriteFile(filepath.Join(dir, "outerloop-deploy.yaml"), outerloop, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKey := filepath.Join(dir, "cosign.pub")
+	if err = ioutil.WriteFile(publicKey, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	sign := func(content []byte) string {
+		digest := sha256.Sum256(content)
+		signature, signErr := ecdsa.SignASN1(rand.Reader, privateKey, digest[:])
+		if signErr != nil {
+			t.Fatal(signErr)
+		}
+		return base64.StdEncoding.EncodeToString(signature)
+	}
+
+	registry := Registry{Name: "SignedRegistry", URL: "https://registry.example.com"}
+	signedRegistry := Registry{Name: "SignedRegistry", URL: "https://registry.example.com", PublicKey: publicKey}
+
+	tests := []struct {
+		name     string
+		registry Registry
+		version  StackVersion
+		wantErr  bool
+	}{
+		{
+			name:     "no checksum nor public key",
+			registry: registry,
+		},
+		{
+			name:     "valid checksums",
+			registry: registry,
+			version: StackVersion{Checksums: map[string]string{
+				"devfile.yaml":          util.GetChecksum(devfile),
+				"outerloop-deploy.yaml": util.GetChecksum(outerloop),
+			}},
+		},
+		{
+			name:     "checksum mismatch",
+			registry: registry,
+			version:  StackVersion{Checksums: map[string]string{"devfile.yaml": util.GetChecksum(outerloop)}},
+			wantErr:  true,
+		},
+		{
+			name:     "checksum of a missing file",
+			registry: registry,
+			version:  StackVersion{Checksums: map[string]string{"missing.yaml": util.GetChecksum(devfile)}},
+			wantErr:  true,
+		},
+		{
+			name:     "checksum of a file outside of the stack",
+			registry: registry,
+			version:  StackVersion{Checksums: map[string]string{"../devfile.yaml": util.GetChecksum(devfile)}},
+			wantErr:  true,
+		},
+		{
+			name:     "valid signatures",
+			registry: signedRegistry,
+			version: StackVersion{Signatures: map[string]string{
+				"devfile.yaml":          sign(devfile),
+				"outerloop-deploy.yaml": sign(outerloop),
+			}},
+		},
+		{
+			name:     "invalid signature",
+			registry: signedRegistry,
+			version:  StackVersion{Signatures: map[string]string{"devfile.yaml": sign(outerloop)}},
+			wantErr:  true,
+		},
+		{
+			name:     "devfile not signed",
+			registry: signedRegistry,
+			version:  StackVersion{Signatures: map[string]string{"outerloop-deploy.yaml": sign(outerloop)}},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := VerifyStack(tt.registry, "go", tt.version, dir)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got error %v, want error: %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package catalog
+
+import (
+	"fmt"
+	"strings"
+
+	indexSchema "github.com/devfile/registry-support/index/generator/schema"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+// LatestStackVersion is the version designating the most recent version of a stack
+const LatestStackVersion = "latest"
+
+// registryIndexEntry is an entry of the index of a registry; the registries supporting multiple versions
+// of a stack list these versions in addition to the fields of the entry, which describe the default version
+type registryIndexEntry struct {
+	indexSchema.Schema
+	Versions []registryIndexVersion `json:"versions,omitempty"`
+	registryIndexVerification
+}
+
+// registryIndexVerification holds the checksums and the signatures verifying a version of a stack listed in the index of a registry
+type registryIndexVerification struct {
+	Checksums               map[string]string `json:"checksums,omitempty"`
+	StarterProjectChecksums map[string]string `json:"starterProjectChecksums,omitempty"`
+	Signatures              map[string]string `json:"signatures,omitempty"`
+}
+
+// registryIndexVersion is a version of a stack listed in the index of a registry
+type registryIndexVersion struct {
+	Version         string            `json:"version,omitempty"`
+	SchemaVersion   string            `json:"schemaVersion,omitempty"`
+	Default         bool              `json:"default,omitempty"`
+	Links           map[string]string `json:"links,omitempty"`
+	StarterProjects []string          `json:"starterProjects,omitempty"`
+	registryIndexVerification
+}
+
+// newRegistryIndex returns the entries of a registry index listing a single version of each stack
+func newRegistryIndex(devfileIndex []indexSchema.Schema) []registryIndexEntry {
+	entries := make([]registryIndexEntry, 0, len(devfileIndex))
+	for _, schema := range devfileIndex {
+		entries = append(entries, registryIndexEntry{Schema: schema})
+	}
+	return entries
+}
+
+// getStackVersions returns the versions of the stack described by the index entry, and its default version
+func getStackVersions(entry registryIndexEntry) (versions []StackVersion, defaultVersion StackVersion) {
+	if len(entry.Versions) == 0 {
+		defaultVersion = StackVersion{
+			Version:         entry.Version,
+			Default:         true,
+			Link:            entry.Links["self"],
+			StarterProjects: entry.StarterProjects,
+		}
+		defaultVersion.setVerification(entry.registryIndexVerification)
+		return nil, defaultVersion
+	}
+
+	for _, v := range entry.Versions {
+		version := StackVersion{
+			Version:         v.Version,
+			SchemaVersion:   v.SchemaVersion,
+			Default:         v.Default,
+			Link:            v.Links["self"],
+			StarterProjects: v.StarterProjects,
+		}
+		version.setVerification(v.registryIndexVerification)
+		versions = append(versions, version)
+		if v.Default {
+			defaultVersion = version
+		}
+	}
+	if defaultVersion.Version == "" {
+		// without version marked as default, the latest version is the default one
+		defaultVersion = getLatestStackVersion(versions)
+	}
+	return versions, defaultVersion
+}
+
+// setVerification sets the checksums and the signatures of the version listed in the index of the registry
+func (v *StackVersion) setVerification(verification registryIndexVerification) {
+	v.Checksums = verification.Checksums
+	v.StarterProjectChecksums = verification.StarterProjectChecksums
+	v.Signatures = verification.Signatures
+}
+
+// getLatestStackVersion returns the most recent stable version, or the most recent version when no version is stable
+func getLatestStackVersion(versions []StackVersion) StackVersion {
+	values := make([]string, len(versions))
+	for i, v := range versions {
+		values[i] = v.Version
+	}
+	return versions[util.GetLatestVersion(values)]
+}
+
+// ParseStackName splits a component type of the form <stack name>[@<version>] into the name and the version of the stack
+func ParseStackName(componentType string) (name, version string) {
+	if i := strings.LastIndex(componentType, "@"); i >= 0 {
+		return componentType[:i], componentType[i+1:]
+	}
+	return componentType, ""
+}
+
+// GetStackVersion returns the requested version of the stack; its default version is returned when version is empty,
+// and its most recent version when version is "latest"
+func (d DevfileComponentType) GetStackVersion(version string) (StackVersion, error) {
+	if len(d.Versions) == 0 {
+		if version == "" || version == LatestStackVersion || sameVersion(version, d.Version) {
+			stackVersion := StackVersion{Version: d.Version, Default: true, Link: d.Link}
+			stackVersion.setVerification(d.verification)
+			return stackVersion, nil
+		}
+		if d.Version == "" {
+			return StackVersion{}, fmt.Errorf("version %q of stack %q not found in registry %q, which does not list the versions of the stack", version, d.Name, d.Registry.Name)
+		}
+		return StackVersion{}, fmt.Errorf("version %q of stack %q not found in registry %q, the only available version is %q", version, d.Name, d.Registry.Name, d.Version)
+	}
+
+	switch version {
+	case "":
+		for _, v := range d.Versions {
+			if v.Version == d.Version {
+				return v, nil
+			}
+		}
+		return getLatestStackVersion(d.Versions), nil
+	case LatestStackVersion:
+		return getLatestStackVersion(d.Versions), nil
+	}
+	var available []string
+	for _, v := range d.Versions {
+		if sameVersion(version, v.Version) {
+			return v, nil
+		}
+		available = append(available, v.Version)
+	}
+	return StackVersion{}, fmt.Errorf("version %q of stack %q not found in registry %q, available versions are: %s", version, d.Name, d.Registry.Name, strings.Join(available, ", "))
+}
+
+// sameVersion returns true when both versions are equal, ignoring the "v" prefix
+func sameVersion(v1, v2 string) bool {
+	return strings.TrimPrefix(v1, "v") == strings.TrimPrefix(v2, "v")
+}
@@ -0,0 +1,164 @@
+package catalog
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	registryUtil "github.com/redhat-developer/odo/pkg/odo/cli/registry/util"
+)
+
+func TestParseStackName(t *testing.T) {
+	tests := []struct {
+		componentType string
+		wantName      string
+		wantVersion   string
+	}{
+		{componentType: "java-springboot", wantName: "java-springboot"},
+		{componentType: "java-springboot@1.2.0", wantName: "java-springboot", wantVersion: "1.2.0"},
+		{componentType: "java-springboot@latest", wantName: "java-springboot", wantVersion: "latest"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.componentType, func(t *testing.T) {
+			name, version := ParseStackName(tt.componentType)
+			if name != tt.wantName || version != tt.wantVersion {
+				t.Errorf("got %q and %q, want %q and %q", name, version, tt.wantName, tt.wantVersion)
+			}
+		})
+	}
+}
+
+func TestGetStackVersion(t *testing.T) {
+	multiVersion := DevfileComponentType{
+		Name:    "java-springboot",
+		Version: "1.1.0",
+		Versions: []StackVersion{
+			{Version: "1.1.0", Default: true, Link: "devfile-catalog/java-springboot:1.1.0"},
+			{Version: "1.2.0", Link: "devfile-catalog/java-springboot:1.2.0"},
+			{Version: "2.0.0-rc1", Link: "devfile-catalog/java-springboot:2.0.0-rc1"},
+		},
+	}
+	singleVersion := DevfileComponentType{Name: "nodejs", Version: "1.0.0", Link: "devfile-catalog/nodejs:latest"}
+
+	tests := []struct {
+		name      string
+		component DevfileComponentType
+		version   string
+		want      string
+		wantErr   bool
+	}{
+		{name: "default version", component: multiVersion, want: "1.1.0"},
+		{name: "latest stable version", component: multiVersion, version: "latest", want: "1.2.0"},
+		{name: "specific version", component: multiVersion, version: "1.2.0", want: "1.2.0"},
+		{name: "version with v prefix", component: multiVersion, version: "v1.2.0", want: "1.2.0"},
+		{name: "unknown version", component: multiVersion, version: "3.0.0", wantErr: true},
+		{name: "single version", component: singleVersion, want: "1.0.0"},
+		{name: "only version", component: singleVersion, version: "1.0.0", want: "1.0.0"},
+		{name: "unknown version of single version stack", component: singleVersion, version: "2.0.0", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.component.GetStackVersion(tt.version)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %v", err, tt.wantErr)
+			}
+			if got.Version != tt.want {
+				t.Errorf("got version %q, want %q", got.Version, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetRegistryDevfilesWithVersions(t *testing.T) {
+	// the index of the registry must not be cached by the test
+	defer setPreference(t, "OdoSettings:\n  RegistryCacheTime: 0\n")()
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != ociIndexV2Path {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, err := rw.Write([]byte(`[
+			{
+				"name": "java-springboot",
+				"displayName": "Spring Boot",
+				"language": "java",
+				"versions": [
+					{"version": "1.1.0", "schemaVersion": "2.0.0", "default": true, "links": {"self": "devfile-catalog/java-springboot:1.1.0"}, "starterProjects": ["springbootproject"]},
+					{"version": "2.0.0", "schemaVersion": "2.2.0", "links": {"self": "devfile-catalog/java-springboot:2.0.0"}}
+				]
+			}
+		]`))
+		if err != nil {
+			t.Error(err)
+		}
+	}))
+	defer server.Close()
+
+	registry := Registry{Name: "versions", URL: server.URL}
+	got, err := getRegistryDevfiles(registry)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []DevfileComponentType{
+		{
+			Name:        "java-springboot",
+			DisplayName: "Spring Boot",
+			Link:        "devfile-catalog/java-springboot:1.1.0",
+			Registry:    registry,
+			Language:    "java",
+			Version:     "1.1.0",
+			Versions: []StackVersion{
+				{Version: "1.1.0", SchemaVersion: "2.0.0", Default: true, Link: "devfile-catalog/java-springboot:1.1.0", StarterProjects: []string{"springbootproject"}},
+				{Version: "2.0.0", SchemaVersion: "2.2.0", Link: "devfile-catalog/java-springboot:2.0.0"},
+			},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestGetFileRegistryDevfilesWithVersions(t *testing.T) {
+	registryDir, err := ioutil.TempDir("", "odo-registry")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(registryDir)
+
+	stackDir := filepath.Join(registryDir, "stacks", "go")
+	for _, version := range []string{"1.0.0", "1.1.0", "2.0.0"} {
+		if err = os.MkdirAll(filepath.Join(stackDir, version), 0750); err != nil {
+			t.Fatal(err)
+		}
+		devfile := "schemaVersion: 2.0.0\nmetadata:\n  name: go\n  language: go\n  version: " + version + "\n"
+		if err = ioutil.WriteFile(filepath.Join(stackDir, version, "devfile.yaml"), []byte(devfile), 0640); err != nil {
+			t.Fatal(err)
+		}
+	}
+	stackFile := "name: go\nversions:\n- version: 1.0.0\n- version: 1.1.0\n  default: true\n- version: 2.0.0\n"
+	if err = ioutil.WriteFile(filepath.Join(stackDir, "stack.yaml"), []byte(stackFile), 0640); err != nil {
+		t.Fatal(err)
+	}
+
+	registry := Registry{Name: "local", URL: registryUtil.FileRegistryPrefix + filepath.ToSlash(registryDir)}
+	devfiles, err := getRegistryDevfiles(registry)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(devfiles) != 1 || devfiles[0].Name != "go" || devfiles[0].Version != "1.1.0" || len(devfiles[0].Versions) != 3 {
+		t.Fatalf("got devfiles %+v, want the go stack with 3 versions and the default version 1.1.0", devfiles)
+	}
+
+	version, err := devfiles[0].GetStackVersion("2.0.0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if dir := GetFileRegistryStackDir(registry, "go", version.Version); dir != filepath.Join(stackDir, "2.0.0") {
+		t.Errorf("got stack directory %s, want %s", dir, filepath.Join(stackDir, "2.0.0"))
+	}
+}
@@ -437,6 +437,7 @@ func getRemoteComponentMetadata(client kclient.ClientInterface, componentName st
 }
 
 // setLinksServiceNames sets the service name of the links from the info in ServiceBindingRequests present in the cluster
+// and in the secrets of the links created by odo
 func setLinksServiceNames(client kclient.ClientInterface, linkedSecrets []SecretMount, selector string) error {
 	ok, err := client.IsServiceBindingSupported()
 	if err != nil {
@@ -472,45 +473,45 @@ func setLinksServiceNames(client kclient.ClientInterface, linkedSecrets []Secret
 				serviceBindings[sbr.Status.Secret] = service.Kind + "/" + service.Name
 			}
 		}
-	} else {
-		// service binding operator is not installed
-		// get the secrets instead of the service binding objects to retrieve the link data
-		secrets, err := client.ListSecrets(selector)
-		if err != nil {
-			return err
-		}
+	}
 
-		// get the services to get their names against the component names
-		services, err := client.ListServices("")
-		if err != nil {
-			return err
-		}
+	// get the secrets of the links created without the service binding operator:
+	// all the links when the operator is not installed, and the links to plain Secrets and ConfigMaps
+	secrets, err := client.ListSecrets(selector)
+	if err != nil {
+		return err
+	}
 
-		serviceCompMap := make(map[string]string)
-		for _, gotService := range services {
-			serviceCompMap[gotService.Labels[componentlabels.ComponentLabel]] = gotService.Name
-		}
+	// get the services to get their names against the component names
+	services, err := client.ListServices("")
+	if err != nil {
+		return err
+	}
 
-		for _, secret := range secrets {
-			serviceName, serviceOK := secret.Labels[service.ServiceLabel]
-			_, linkOK := secret.Labels[service.LinkLabel]
-			serviceKind, serviceKindOK := secret.Labels[service.ServiceKind]
-			if serviceKindOK && serviceOK && linkOK {
-				if serviceKind == "Service" {
-					if _, ok := serviceBindings[secret.Name]; !ok {
-						serviceBindings[secret.Name] = serviceCompMap[serviceName]
-					}
-				} else {
-					// service name is stored as kind-name in the labels
-					parts := strings.SplitN(serviceName, "-", 2)
-					if len(parts) < 2 {
-						continue
-					}
+	serviceCompMap := make(map[string]string)
+	for _, gotService := range services {
+		serviceCompMap[gotService.Labels[componentlabels.ComponentLabel]] = gotService.Name
+	}
 
-					serviceName = fmt.Sprintf("%v/%v", parts[0], parts[1])
-					if _, ok := serviceBindings[secret.Name]; !ok {
-						serviceBindings[secret.Name] = serviceName
-					}
+	for _, secret := range secrets {
+		serviceName, serviceOK := secret.Labels[service.ServiceLabel]
+		_, linkOK := secret.Labels[service.LinkLabel]
+		serviceKind, serviceKindOK := secret.Labels[service.ServiceKind]
+		if serviceKindOK && serviceOK && linkOK {
+			if serviceKind == "Service" {
+				if _, ok := serviceBindings[secret.Name]; !ok {
+					serviceBindings[secret.Name] = serviceCompMap[serviceName]
+				}
+			} else {
+				// service name is stored as kind-name in the labels
+				parts := strings.SplitN(serviceName, "-", 2)
+				if len(parts) < 2 {
+					continue
+				}
+
+				serviceName = fmt.Sprintf("%v/%v", parts[0], parts[1])
+				if _, ok := serviceBindings[secret.Name]; !ok {
+					serviceBindings[secret.Name] = serviceName
 				}
 			}
 		}
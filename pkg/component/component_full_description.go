@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"sort"
 
 	"github.com/redhat-developer/odo/pkg/kclient"
 	"github.com/redhat-developer/odo/pkg/localConfigProvider"
@@ -76,6 +77,33 @@ func (cfd *ComponentFullDescription) loadStoragesFromClientAndLocalConfig(client
 	return nil
 }
 
+// loadLinksStatusFromClient collects the binding keys of the linked services and whether their secrets are out of date
+func (cfd *ComponentFullDescription) loadLinksStatusFromClient(client kclient.ClientInterface) error {
+	for i, linkedService := range cfd.Status.LinkedServices {
+		if linkedService.SecretName == "" {
+			continue
+		}
+		secret, err := client.GetSecret(linkedService.SecretName, client.GetCurrentNamespace())
+		if err != nil {
+			return err
+		}
+
+		// only the names of the binding data are collected, the values are never exposed
+		var keys []string
+		for key := range secret.Data {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		cfd.Status.LinkedServices[i].Keys = keys
+
+		cfd.Status.LinkedServices[i].Stale, err = service.IsLinkStale(client, *secret)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // fillEmptyFields fills any fields that are empty in the ComponentFullDescription
 func (cfd *ComponentFullDescription) fillEmptyFields(componentDesc Component, componentName string, applicationName string, projectName string) {
 	//fix missing names in case it in not in description
@@ -136,6 +164,10 @@ func NewComponentFullDescriptionFromClientAndLocalConfigProvider(client kclient.
 		cfd.Spec.Env = componentDescFromCluster.Spec.Env
 		cfd.Spec.Type = componentDescFromCluster.Spec.Type
 		cfd.Status.LinkedServices = componentDescFromCluster.Status.LinkedServices
+		err = cfd.loadLinksStatusFromClient(client)
+		if err != nil {
+			return cfd, err
+		}
 	}
 
 	for _, link := range configLinks {
@@ -275,37 +307,31 @@ func (cfd *ComponentFullDescription) Print(client kclient.ClientInterface) error
 				continue
 			}
 
-			// Let's also get the secrets / environment variables that are being passed in.. (if there are any)
-			secrets, err := client.GetSecret(linkedService.SecretName, cfd.GetNamespace())
-			if err != nil {
-				return err
-			}
+			output += fmt.Sprintf(" · %s\n   Secret: %s\n", linkedService.ServiceName, linkedService.SecretName)
 
-			if len(secrets.Data) > 0 {
-				// Iterate through the secrets to throw in a string
+			if len(linkedService.Keys) > 0 {
+				// Iterate through the binding keys to throw in a string
 				var secretOutput string
-				for i := range secrets.Data {
+				for _, key := range linkedService.Keys {
 					if linkedService.MountVolume {
-						secretOutput += fmt.Sprintf("    · %v\n", filepath.ToSlash(filepath.Join(linkedService.MountPath, i)))
+						secretOutput += fmt.Sprintf("    · %v\n", filepath.ToSlash(filepath.Join(linkedService.MountPath, key)))
 					} else {
-						secretOutput += fmt.Sprintf("    · %v\n", i)
+						secretOutput += fmt.Sprintf("    · %v\n", key)
 					}
 				}
 
-				if len(secretOutput) > 0 {
-					// Cut off the last newline
-					secretOutput = secretOutput[:len(secretOutput)-1]
-					if linkedService.MountVolume {
-						output += fmt.Sprintf(" · %s\n   Files:\n%s\n", linkedService.ServiceName, secretOutput)
-					} else {
-						output += fmt.Sprintf(" · %s\n   Environment Variables:\n%s\n", linkedService.ServiceName, secretOutput)
-					}
+				if linkedService.MountVolume {
+					output += fmt.Sprintf("   Files:\n%s", secretOutput)
+				} else {
+					output += fmt.Sprintf("   Environment Variables:\n%s", secretOutput)
 				}
+			}
 
+			if linkedService.Stale {
+				output += fmt.Sprintf("   Status: out of date, the binding data of the service changed since the link was created; use `odo link %s --refresh` to update it\n", linkedService.ServiceName)
 			} else {
-				output += fmt.Sprintf(" · %s\n", linkedService.SecretName)
+				output += "   Status: in sync\n"
 			}
-
 		}
 
 		if len(output) > 0 {
@@ -0,0 +1,311 @@
+package component
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	devfilepkg "github.com/devfile/api/v2/pkg/devfile"
+	"github.com/devfile/library/pkg/devfile/parser/data"
+	"github.com/pkg/errors"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/localConfigProvider"
+	"github.com/redhat-developer/odo/pkg/unions"
+	"github.com/redhat-developer/odo/pkg/util"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// ImportRunCommandID is the id of the run command generated from the command of the imported container
+const ImportRunCommandID = "run"
+
+// ImportedComponent is the description of a component generated from an existing Deployment
+type ImportedComponent struct {
+	// Devfile contains the container components, endpoints and volumes of the Deployment
+	Devfile data.DevfileData
+	// URLs are the settings of the endpoints exposed by an Ingress, to be saved in the env file
+	URLs []localConfigProvider.LocalURL
+	// Warnings are the parts of the Deployment which cannot be represented in the devfile
+	Warnings []string
+}
+
+// ImportDeployment reads the Deployment, with the Services and Ingresses exposing it and the PersistentVolumeClaims it mounts,
+// and generates the devfile of a component named componentName running the same containers
+func ImportDeployment(client kclient.ClientInterface, deploymentName, componentName string) (ImportedComponent, error) {
+	deployment, err := client.GetDeploymentByName(deploymentName)
+	if err != nil {
+		return ImportedComponent{}, errors.Wrapf(err, "unable to get deployment %q", deploymentName)
+	}
+
+	exposed, err := getExposedPorts(client, deployment)
+	if err != nil {
+		return ImportedComponent{}, err
+	}
+
+	devfileData, err := data.NewDevfileData(string(data.APISchemaVersion200))
+	if err != nil {
+		return ImportedComponent{}, err
+	}
+	devfileData.SetSchemaVersion(string(data.APISchemaVersion200))
+	devfileData.SetMetadata(devfilepkg.DevfileMetadata{Name: componentName})
+
+	imported := ImportedComponent{Devfile: devfileData}
+	podSpec := deployment.Spec.Template.Spec
+
+	if len(podSpec.InitContainers) > 0 {
+		imported.Warnings = append(imported.Warnings, fmt.Sprintf("the init containers of deployment %q are not imported", deploymentName))
+	}
+
+	volumes, err := getVolumeComponents(client, podSpec, &imported)
+	if err != nil {
+		return ImportedComponent{}, err
+	}
+
+	var components []devfilev1.Component
+	for i, c := range podSpec.Containers {
+		container := devfilev1.Container{
+			Image:         c.Image,
+			MemoryLimit:   getQuantity(c.Resources.Limits, corev1.ResourceMemory),
+			MemoryRequest: getQuantity(c.Resources.Requests, corev1.ResourceMemory),
+			CpuLimit:      getQuantity(c.Resources.Limits, corev1.ResourceCPU),
+			CpuRequest:    getQuantity(c.Resources.Requests, corev1.ResourceCPU),
+			// the sources are synchronized in the first container only, where the run command is executed
+			MountSources: util.GetBoolPtr(i == 0),
+		}
+
+		for _, e := range c.Env {
+			if e.ValueFrom != nil {
+				imported.Warnings = append(imported.Warnings, fmt.Sprintf("the environment variable %q of container %q is not imported as its value is not set directly", e.Name, c.Name))
+				continue
+			}
+			container.Env = append(container.Env, devfilev1.EnvVar{Name: e.Name, Value: e.Value})
+		}
+		if len(c.EnvFrom) > 0 {
+			imported.Warnings = append(imported.Warnings, fmt.Sprintf("the environment variables of container %q loaded from ConfigMaps or Secrets are not imported", c.Name))
+		}
+
+		for _, m := range c.VolumeMounts {
+			if _, ok := volumes[m.Name]; ok {
+				container.VolumeMounts = append(container.VolumeMounts, devfilev1.VolumeMount{Name: m.Name, Path: m.MountPath})
+			}
+		}
+
+		component := devfilev1.Component{
+			Name: c.Name,
+			ComponentUnion: devfilev1.ComponentUnion{
+				Container: &devfilev1.ContainerComponent{
+					Container: container,
+					Endpoints: getEndpoints(c, exposed, &imported),
+				},
+			},
+		}
+
+		if i == 0 {
+			err = addRunCommand(devfileData, c, deploymentName, &imported)
+			if err != nil {
+				return ImportedComponent{}, err
+			}
+		} else {
+			// the other containers keep running their own command
+			component.Container.Command = c.Command
+			component.Container.Args = c.Args
+		}
+		components = append(components, component)
+	}
+
+	var names []string
+	for name := range volumes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		components = append(components, volumes[name])
+	}
+
+	err = devfileData.AddComponents(components)
+	if err != nil {
+		return ImportedComponent{}, err
+	}
+	return imported, nil
+}
+
+// addRunCommand adds to the devfile the run command executing the command of the container
+func addRunCommand(devfileData data.DevfileData, c corev1.Container, deploymentName string, imported *ImportedComponent) error {
+	commandLine := strings.Join(append(append([]string{}, c.Command...), c.Args...), " ")
+	if commandLine == "" {
+		imported.Warnings = append(imported.Warnings, fmt.Sprintf("the container %q of deployment %q runs the entrypoint of its image, add a run command to the devfile to start the application", c.Name, deploymentName))
+		return nil
+	}
+	return devfileData.AddCommands([]devfilev1.Command{{
+		Id: ImportRunCommandID,
+		CommandUnion: devfilev1.CommandUnion{
+			Exec: &devfilev1.ExecCommand{
+				LabeledCommand: devfilev1.LabeledCommand{
+					BaseCommand: devfilev1.BaseCommand{
+						Group: &devfilev1.CommandGroup{Kind: devfilev1.RunCommandGroupKind, IsDefault: util.GetBoolPtr(true)},
+					},
+				},
+				CommandLine: commandLine,
+				Component:   c.Name,
+				WorkingDir:  c.WorkingDir,
+			},
+		},
+	}})
+}
+
+// getVolumeComponents returns the volume components of the devfile by name, for the PersistentVolumeClaims and emptyDir volumes of the pod
+func getVolumeComponents(client kclient.ClientInterface, podSpec corev1.PodSpec, imported *ImportedComponent) (map[string]devfilev1.Component, error) {
+	volumes := map[string]devfilev1.Component{}
+	for _, v := range podSpec.Volumes {
+		var volume devfilev1.Volume
+		switch {
+		case v.PersistentVolumeClaim != nil:
+			pvc, err := client.GetPVCFromName(v.PersistentVolumeClaim.ClaimName)
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to get persistent volume claim %q", v.PersistentVolumeClaim.ClaimName)
+			}
+			volume.Size = getQuantity(pvc.Spec.Resources.Requests, corev1.ResourceStorage)
+		case v.EmptyDir != nil:
+			volume.Ephemeral = util.GetBoolPtr(true)
+		default:
+			imported.Warnings = append(imported.Warnings, fmt.Sprintf("the volume %q is not imported, only persistent volume claims and emptyDir volumes are supported", v.Name))
+			continue
+		}
+		volumes[v.Name] = devfilev1.Component{
+			Name: v.Name,
+			ComponentUnion: devfilev1.ComponentUnion{
+				Volume: &devfilev1.VolumeComponent{Volume: volume},
+			},
+		}
+	}
+	return volumes, nil
+}
+
+// getExposedPorts returns the ports of the pods of the deployment exposed by a Service, indexed by container port number
+// or name, with the URL of the Ingress routing to the Service port, or nil
+func getExposedPorts(client kclient.ClientInterface, deployment *appsv1.Deployment) (map[string]*localConfigProvider.LocalURL, error) {
+	services, err := client.ListServices("")
+	if err != nil {
+		return nil, err
+	}
+	ingresses, err := client.ListIngresses("")
+	if err != nil {
+		return nil, err
+	}
+
+	podLabels := labels.Set(deployment.Spec.Template.Labels)
+	exposed := map[string]*localConfigProvider.LocalURL{}
+	for _, svc := range services {
+		if len(svc.Spec.Selector) == 0 || !labels.SelectorFromSet(svc.Spec.Selector).Matches(podLabels) {
+			continue
+		}
+		for _, port := range svc.Spec.Ports {
+			targetPort := port.TargetPort
+			if targetPort.IntValue() == 0 && targetPort.Type == intstr.Int {
+				targetPort = intstr.FromInt(int(port.Port))
+			}
+			exposed[targetPort.String()] = getIngressURL(ingresses.Items, svc.Name, port)
+		}
+	}
+	return exposed, nil
+}
+
+// getIngressURL returns the URL of the first Ingress routing to the port of the service, or nil
+func getIngressURL(ingresses []*unions.KubernetesIngress, serviceName string, port corev1.ServicePort) *localConfigProvider.LocalURL {
+	for _, ingress := range ingresses {
+		if ingress.NetworkingV1Ingress != nil {
+			i := ingress.NetworkingV1Ingress
+			for _, rule := range i.Spec.Rules {
+				if rule.HTTP == nil {
+					continue
+				}
+				for _, path := range rule.HTTP.Paths {
+					backend := path.Backend.Service
+					if backend == nil || backend.Name != serviceName || (backend.Port.Number != port.Port && (backend.Port.Name == "" || backend.Port.Name != port.Name)) {
+						continue
+					}
+					return newIngressURL(i.Name, rule.Host, path.Path, len(i.Spec.TLS) > 0)
+				}
+			}
+		} else if ingress.ExtensionV1Beta1Ingress != nil {
+			i := ingress.ExtensionV1Beta1Ingress
+			for _, rule := range i.Spec.Rules {
+				if rule.HTTP == nil {
+					continue
+				}
+				for _, path := range rule.HTTP.Paths {
+					backend := path.Backend
+					if backend.ServiceName != serviceName || (backend.ServicePort.String() != fmt.Sprint(port.Port) && backend.ServicePort.String() != port.Name) {
+						continue
+					}
+					return newIngressURL(i.Name, rule.Host, path.Path, len(i.Spec.TLS) > 0)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func newIngressURL(name, host, path string, secure bool) *localConfigProvider.LocalURL {
+	return &localConfigProvider.LocalURL{
+		Name:   name,
+		Host:   host,
+		Path:   path,
+		Secure: secure,
+		Kind:   localConfigProvider.INGRESS,
+	}
+}
+
+// getEndpoints returns the endpoints of the ports of the container: public when an Ingress routes to them, internal when
+// they are exposed by a Service only, and none otherwise
+func getEndpoints(c corev1.Container, exposed map[string]*localConfigProvider.LocalURL, imported *ImportedComponent) []devfilev1.Endpoint {
+	var endpoints []devfilev1.Endpoint
+	for _, port := range c.Ports {
+		endpoint := devfilev1.Endpoint{
+			Name:       getEndpointName(port),
+			TargetPort: int(port.ContainerPort),
+			Exposure:   devfilev1.NoneEndpointExposure,
+		}
+		if port.Protocol == corev1.ProtocolUDP {
+			endpoint.Protocol = devfilev1.UDPEndpointProtocol
+		}
+
+		url, ok := exposed[fmt.Sprint(port.ContainerPort)]
+		if !ok && port.Name != "" {
+			url, ok = exposed[port.Name]
+		}
+		if ok {
+			endpoint.Exposure = devfilev1.InternalEndpointExposure
+			if url != nil {
+				endpoint.Exposure = devfilev1.PublicEndpointExposure
+				endpoint.Path = url.Path
+				endpoint.Secure = util.GetBoolPtr(url.Secure)
+				url := *url
+				url.Name = endpoint.Name
+				url.Port = endpoint.TargetPort
+				imported.URLs = append(imported.URLs, url)
+			}
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+	return endpoints
+}
+
+// getEndpointName returns the name of the port if it is a valid endpoint name, or a name generated from its number
+func getEndpointName(port corev1.ContainerPort) string {
+	if port.Name != "" && util.ValidateK8sResourceName("endpoint name", port.Name) == nil {
+		return port.Name
+	}
+	return fmt.Sprintf("port-%d", port.ContainerPort)
+}
+
+func getQuantity(list corev1.ResourceList, name corev1.ResourceName) string {
+	if q, ok := list[name]; ok {
+		return q.String()
+	}
+	return ""
+}
@@ -0,0 +1,190 @@
+package component
+
+import (
+	"reflect"
+	"testing"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	parsercommon "github.com/devfile/library/pkg/devfile/parser/data/v2/common"
+	"github.com/golang/mock/gomock"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/localConfigProvider"
+	"github.com/redhat-developer/odo/pkg/unions"
+	"github.com/redhat-developer/odo/pkg/util"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestImportDeployment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	fkClient := kclient.NewMockClientInterface(ctrl)
+
+	fkClient.EXPECT().GetDeploymentByName("shop").Return(&appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "shop"},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "shop", "tier": "web"}},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:       "web",
+							Image:      "quay.io/shop/web:1.0",
+							Command:    []string{"npm"},
+							Args:       []string{"start"},
+							WorkingDir: "/app",
+							Ports: []corev1.ContainerPort{
+								{Name: "http", ContainerPort: 8080},
+								{ContainerPort: 9090},
+								{ContainerPort: 5000},
+							},
+							Env: []corev1.EnvVar{
+								{Name: "MODE", Value: "production"},
+								{Name: "PASSWORD", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{Key: "password"}}},
+							},
+							Resources: corev1.ResourceRequirements{
+								Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("512Mi")},
+							},
+							VolumeMounts: []corev1.VolumeMount{
+								{Name: "data", MountPath: "/data"},
+								{Name: "cache", MountPath: "/cache"},
+								{Name: "config", MountPath: "/config"},
+							},
+						},
+						{
+							Name:    "proxy",
+							Image:   "quay.io/shop/proxy:1.0",
+							Command: []string{"proxy", "--port=8000"},
+						},
+					},
+					Volumes: []corev1.Volume{
+						{Name: "data", VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "shop-data"}}},
+						{Name: "cache", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+						{Name: "config", VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{}}},
+					},
+				},
+			},
+		},
+	}, nil)
+	fkClient.EXPECT().ListServices("").Return([]corev1.Service{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "shop"},
+			Spec: corev1.ServiceSpec{
+				Selector: map[string]string{"app": "shop"},
+				Ports: []corev1.ServicePort{
+					{Name: "http", Port: 80, TargetPort: intstr.FromString("http")},
+					{Name: "metrics", Port: 9090},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "other"},
+			Spec: corev1.ServiceSpec{
+				Selector: map[string]string{"app": "other"},
+				Ports:    []corev1.ServicePort{{Port: 5000}},
+			},
+		},
+	}, nil)
+	pathType := networkingv1.PathTypePrefix
+	fkClient.EXPECT().ListIngresses("").Return(&unions.KubernetesIngressList{Items: []*unions.KubernetesIngress{
+		{NetworkingV1Ingress: &networkingv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{Name: "shop"},
+			Spec: networkingv1.IngressSpec{
+				Rules: []networkingv1.IngressRule{{
+					Host: "shop.example.com",
+					IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{
+						Paths: []networkingv1.HTTPIngressPath{{
+							Path:     "/store",
+							PathType: &pathType,
+							Backend: networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{
+								Name: "shop",
+								Port: networkingv1.ServiceBackendPort{Number: 80},
+							}},
+						}},
+					}},
+				}},
+			},
+		}},
+	}}, nil)
+	fkClient.EXPECT().GetPVCFromName("shop-data").Return(&corev1.PersistentVolumeClaim{
+		Spec: corev1.PersistentVolumeClaimSpec{
+			Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("2Gi")}},
+		},
+	}, nil)
+
+	imported, err := ImportDeployment(fkClient, "shop", "myshop")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if name := imported.Devfile.GetMetadata().Name; name != "myshop" {
+		t.Errorf("got component name %q, want %q", name, "myshop")
+	}
+
+	components, err := imported.Devfile.GetComponents(parsercommon.DevfileOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantComponents := []devfilev1.Component{
+		{
+			Name: "web",
+			ComponentUnion: devfilev1.ComponentUnion{Container: &devfilev1.ContainerComponent{
+				Container: devfilev1.Container{
+					Image:        "quay.io/shop/web:1.0",
+					MemoryLimit:  "512Mi",
+					MountSources: util.GetBoolPtr(true),
+					Env:          []devfilev1.EnvVar{{Name: "MODE", Value: "production"}},
+					VolumeMounts: []devfilev1.VolumeMount{{Name: "data", Path: "/data"}, {Name: "cache", Path: "/cache"}},
+				},
+				Endpoints: []devfilev1.Endpoint{
+					{Name: "http", TargetPort: 8080, Exposure: devfilev1.PublicEndpointExposure, Path: "/store", Secure: util.GetBoolPtr(false)},
+					{Name: "port-9090", TargetPort: 9090, Exposure: devfilev1.InternalEndpointExposure},
+					{Name: "port-5000", TargetPort: 5000, Exposure: devfilev1.NoneEndpointExposure},
+				},
+			}},
+		},
+		{
+			Name: "proxy",
+			ComponentUnion: devfilev1.ComponentUnion{Container: &devfilev1.ContainerComponent{
+				Container: devfilev1.Container{
+					Image:        "quay.io/shop/proxy:1.0",
+					Command:      []string{"proxy", "--port=8000"},
+					MountSources: util.GetBoolPtr(false),
+				},
+			}},
+		},
+		{
+			Name:           "cache",
+			ComponentUnion: devfilev1.ComponentUnion{Volume: &devfilev1.VolumeComponent{Volume: devfilev1.Volume{Ephemeral: util.GetBoolPtr(true)}}},
+		},
+		{
+			Name:           "data",
+			ComponentUnion: devfilev1.ComponentUnion{Volume: &devfilev1.VolumeComponent{Volume: devfilev1.Volume{Size: "2Gi"}}},
+		},
+	}
+	if !reflect.DeepEqual(components, wantComponents) {
+		t.Errorf("got components\n%+v\nwant\n%+v", components, wantComponents)
+	}
+
+	commands, err := imported.Devfile.GetCommands(parsercommon.DevfileOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(commands) != 1 || commands[0].Exec == nil || commands[0].Exec.CommandLine != "npm start" || commands[0].Exec.Component != "web" || commands[0].Exec.WorkingDir != "/app" {
+		t.Errorf("got commands %+v, want the run command %q in component %q", commands, "npm start", "web")
+	}
+
+	wantURLs := []localConfigProvider.LocalURL{{Name: "http", Port: 8080, Host: "shop.example.com", Path: "/store", Kind: localConfigProvider.INGRESS}}
+	if !reflect.DeepEqual(imported.URLs, wantURLs) {
+		t.Errorf("got URLs %+v, want %+v", imported.URLs, wantURLs)
+	}
+
+	if len(imported.Warnings) != 2 {
+		t.Errorf("got warnings %v, want warnings for the environment variable PASSWORD and the volume config", imported.Warnings)
+	}
+}
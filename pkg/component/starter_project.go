@@ -1,7 +1,9 @@
 package component
 
 import (
+	"fmt"
 	"io/ioutil"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strings"
@@ -11,25 +13,26 @@ import (
 
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing"
-	"github.com/go-git/go-git/v5/plumbing/transport/http"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
 	"github.com/redhat-developer/odo/pkg/devfile/location"
 	"github.com/redhat-developer/odo/pkg/log"
 	registryUtil "github.com/redhat-developer/odo/pkg/odo/cli/registry/util"
 	"github.com/redhat-developer/odo/pkg/util"
 
 	"github.com/pkg/errors"
+	"k8s.io/klog"
 )
 
 const (
 	defaultStarterProjectName = "devfile-starter-project-name"
 )
 
-func checkoutProject(subDir, zipURL, path, starterToken string) error {
+func checkoutProject(subDir, zipURL, path string, credentials util.HTTPCredentials, checksum string) error {
 
 	if subDir == "" {
 		subDir = "/"
 	}
-	err := util.GetAndExtractZip(zipURL, path, subDir, starterToken)
+	err := util.GetAndExtractZip(zipURL, path, subDir, credentials, checksum)
 	if err != nil {
 		return errors.Wrap(err, "failed to download and extract project zip folder")
 	}
@@ -73,8 +76,48 @@ func GetStarterProject(projects []devfilev1.StarterProject, projectPassed string
 
 }
 
+// GetStarterProjectCredentials returns the credentials used to download the starter project of a stack of the registry:
+// the credentials of the registry are only sent when the starter project is hosted by the registry host
+func GetStarterProjectCredentials(starterProject *devfilev1.StarterProject, registryURL string, registryCredentials util.HTTPCredentials) util.HTTPCredentials {
+	if registryCredentials.IsEmpty() {
+		return registryCredentials
+	}
+	var starterURL string
+	switch {
+	case starterProject.Git != nil:
+		_, remoteURL, _, err := parsercommon.GetDefaultSource(starterProject.Git.GitLikeProjectSource)
+		if err != nil {
+			return util.HTTPCredentials{}
+		}
+		starterURL = remoteURL
+	case starterProject.Zip != nil:
+		starterURL = starterProject.Zip.Location
+	}
+	if !sameHost(starterURL, registryURL) {
+		klog.V(4).Infof("the starter project %s is not hosted by the registry %s, it is downloaded without the credentials of the registry", starterProject.Name, registryURL)
+		return util.HTTPCredentials{}
+	}
+	return registryCredentials
+}
+
+// sameHost returns true when both URLs have the same host, including the port
+func sameHost(url1, url2 string) bool {
+	u1, err := url.Parse(url1)
+	if err != nil {
+		return false
+	}
+	u2, err := url.Parse(url2)
+	if err != nil {
+		return false
+	}
+	return u1.Host != "" && strings.EqualFold(u1.Host, u2.Host)
+}
+
 // DownloadStarterProject Downloads first starter project from list of starter projects in devfile
-func DownloadStarterProject(starterProject *devfilev1.StarterProject, decryptedToken string, contextDir string) error {
+// credentials are used to access the git repository or the zip file of the starter project, if needed
+// checksum, when not empty, is the checksum the zip file of the starter project must match; the git starter projects
+// can't be verified, and are refused when a checksum is given
+func DownloadStarterProject(starterProject *devfilev1.StarterProject, credentials util.HTTPCredentials, checksum string, contextDir string) error {
 	var path string
 	var err error
 	// Retrieve the working directory in order to clone correctly
@@ -96,7 +139,10 @@ func DownloadStarterProject(starterProject *devfilev1.StarterProject, decryptedT
 	log.Info("\nStarter Project")
 
 	if starterProject.Git != nil {
-		err := downloadGitProject(starterProject, decryptedToken, path)
+		if checksum != "" {
+			return &util.VerificationError{Message: fmt.Sprintf("the checksum of the starter project %s cannot be verified, the checksums of git starter projects are not supported", starterProject.Name)}
+		}
+		err := downloadGitProject(starterProject, credentials, path)
 
 		if err != nil {
 			return err
@@ -106,7 +152,7 @@ func DownloadStarterProject(starterProject *devfilev1.StarterProject, decryptedT
 		url := starterProject.Zip.Location
 		sparseDir := starterProject.SubDir
 		downloadSpinner := log.Spinnerf("Downloading starter project %s from %s", starterProject.Name, url)
-		err := checkoutProject(sparseDir, url, path, decryptedToken)
+		err := checkoutProject(sparseDir, url, path, credentials, checksum)
 		if err != nil {
 			downloadSpinner.End(false)
 			return err
@@ -120,7 +166,7 @@ func DownloadStarterProject(starterProject *devfilev1.StarterProject, decryptedT
 }
 
 // downloadGitProject downloads the git starter projects from devfile.yaml
-func downloadGitProject(starterProject *devfilev1.StarterProject, starterToken, path string) error {
+func downloadGitProject(starterProject *devfilev1.StarterProject, credentials util.HTTPCredentials, path string) error {
 	remoteName, remoteUrl, revision, err := parsercommon.GetDefaultSource(starterProject.Git.GitLikeProjectSource)
 	if err != nil {
 		return errors.Wrapf(err, "unable to get default project source for starter project %s", starterProject.Name)
@@ -155,13 +201,26 @@ func downloadGitProject(starterProject *devfilev1.StarterProject, starterToken,
 		Depth: 1,
 	}
 
-	if starterToken != "" {
-		cloneOptions.Auth = &http.BasicAuth{
+	if credentials.Username != "" {
+		cloneOptions.Auth = &githttp.BasicAuth{
+			Username: credentials.Username,
+			Password: credentials.Password,
+		}
+	} else if credentials.Token != "" {
+		cloneOptions.Auth = &githttp.BasicAuth{
 			Username: registryUtil.RegistryUser,
-			Password: starterToken,
+			Password: credentials.Token,
 		}
 	}
 
+	// the TLS configuration of go-git is global, only the CA bundle can be set for a single clone
+	if credentials.CABundle != "" {
+		cloneOptions.CABundle = []byte(credentials.CABundle)
+	}
+	if credentials.TLSConfig != nil && len(credentials.TLSConfig.Certificates) > 0 {
+		log.Warningf("The client certificate of the registry is not presented when cloning the git starter project %s", starterProject.Name)
+	}
+
 	originalPath := ""
 	if starterProject.SubDir != "" {
 		originalPath = path
@@ -0,0 +1,70 @@
+package component
+
+import (
+	"testing"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+func TestGetStarterProjectCredentials(t *testing.T) {
+	credentials := util.HTTPCredentials{Username: "user", Password: "secret"}
+	gitStarter := func(url string) *devfilev1.StarterProject {
+		return &devfilev1.StarterProject{
+			Name: "starter",
+			ProjectSource: devfilev1.ProjectSource{Git: &devfilev1.GitProjectSource{GitLikeProjectSource: devfilev1.GitLikeProjectSource{
+				Remotes: map[string]string{"origin": url},
+			}}},
+		}
+	}
+	zipStarter := func(url string) *devfilev1.StarterProject {
+		return &devfilev1.StarterProject{
+			Name:          "starter",
+			ProjectSource: devfilev1.ProjectSource{Zip: &devfilev1.ZipProjectSource{Location: url}},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		starterProject *devfilev1.StarterProject
+		registryURL    string
+		want           util.HTTPCredentials
+	}{
+		{
+			name:           "git starter project hosted by the registry host",
+			starterProject: gitStarter("https://git.example.com/org/starter.git"),
+			registryURL:    "https://git.example.com/org/registry",
+			want:           credentials,
+		},
+		{
+			name:           "git starter project hosted on another host",
+			starterProject: gitStarter("https://github.com/org/starter.git"),
+			registryURL:    "https://registry.example.com",
+		},
+		{
+			name:           "zip starter project hosted by the registry host",
+			starterProject: zipStarter("https://REGISTRY.example.com/starters/starter.zip"),
+			registryURL:    "https://registry.example.com",
+			want:           credentials,
+		},
+		{
+			name:           "zip starter project hosted on another port of the registry host",
+			starterProject: zipStarter("https://registry.example.com:8443/starters/starter.zip"),
+			registryURL:    "https://registry.example.com",
+		},
+		{
+			name:           "mirrored starter project of a registry stored in a local directory",
+			starterProject: zipStarter("file:///registry/starters/go/starter.zip"),
+			registryURL:    "file:///registry",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := GetStarterProjectCredentials(tt.starterProject, tt.registryURL, credentials)
+			if got != tt.want {
+				t.Errorf("got credentials %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
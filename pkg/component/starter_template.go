@@ -0,0 +1,177 @@
+package component
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+	"k8s.io/klog"
+)
+
+// StarterManifestFile is the manifest declaring the parameters of a starter project, at the root of the starter project
+const StarterManifestFile = ".starter.yaml"
+
+// StarterParameter is a parameter of a starter project, substituted in the contents and paths of the files of the project
+type StarterParameter struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description,omitempty"`
+	Default     string `yaml:"default,omitempty"`
+}
+
+// starterManifest is the content of the manifest of a starter project
+type starterManifest struct {
+	Parameters []StarterParameter `yaml:"parameters"`
+}
+
+// starterPlaceholder matches the placeholders of the parameters, as {{ name }}; with {{ name | path }}, the dots of
+// the value are replaced by path separators, to render the directories of a Java package for example
+var starterPlaceholder = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_.-]*)\s*(\|\s*path\s*)?\}\}`)
+
+// GetStarterParameters returns the parameters declared by the manifest of the starter project downloaded in contextDir,
+// or nil when the starter project has no manifest
+func GetStarterParameters(contextDir string) ([]StarterParameter, error) {
+	content, err := ioutil.ReadFile(filepath.Join(contextDir, StarterManifestFile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var manifest starterManifest
+	err = yaml.Unmarshal(content, &manifest)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to parse the manifest %s of the starter project", StarterManifestFile)
+	}
+	for _, parameter := range manifest.Parameters {
+		if parameter.Name == "" {
+			return nil, errors.Errorf("a parameter of the manifest %s of the starter project has no name", StarterManifestFile)
+		}
+	}
+	return manifest.Parameters, nil
+}
+
+// ResolveStarterParameters returns the values of all the parameters of a starter project, the parameters without values
+// taking their default values; an error is returned when a value is passed for an unknown parameter,
+// or when a parameter has neither a value nor a default value
+func ResolveStarterParameters(parameters []StarterParameter, values map[string]string) (map[string]string, error) {
+	resolved := make(map[string]string, len(parameters))
+	var names []string
+	for _, parameter := range parameters {
+		names = append(names, parameter.Name)
+		value, ok := values[parameter.Name]
+		if !ok {
+			value = parameter.Default
+		}
+		if value == "" {
+			return nil, errors.Errorf("no value for the parameter %q of the starter project, please provide it with --starter-param %s=<value>", parameter.Name, parameter.Name)
+		}
+		resolved[parameter.Name] = value
+	}
+	for name := range values {
+		if _, ok := resolved[name]; !ok {
+			sort.Strings(names)
+			return nil, errors.Errorf("the starter project has no parameter %q, available parameters: %s", name, strings.Join(names, ", "))
+		}
+	}
+	return resolved, nil
+}
+
+// RenderStarterProject substitutes the values of the parameters in the contents and paths of the files of the starter
+// project downloaded in contextDir, then removes the manifest of the starter project; the .odo and .git directories
+// and the binary files are left untouched
+func RenderStarterProject(contextDir string, values map[string]string) error {
+	var paths []string
+	err := filepath.Walk(contextDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if path == contextDir {
+			return nil
+		}
+		if info.IsDir() && (info.Name() == ".odo" || info.Name() == ".git") {
+			return filepath.SkipDir
+		}
+		if info.Mode().IsRegular() && info.Name() != StarterManifestFile {
+			err = renderStarterFile(path, info.Mode(), values)
+			if err != nil {
+				return err
+			}
+		}
+		paths = append(paths, path)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// the deepest paths are renamed first, so the paths of their parent directories are still valid
+	for i := len(paths) - 1; i >= 0; i-- {
+		dir, name := filepath.Split(paths[i])
+		rendered := renderStarterTemplate(name, values)
+		if rendered == name {
+			continue
+		}
+		newPath := filepath.Join(dir, filepath.FromSlash(rendered))
+		err = os.MkdirAll(filepath.Dir(newPath), 0750)
+		if err != nil {
+			return err
+		}
+		klog.V(4).Infof("Renaming %s to %s", paths[i], newPath)
+		err = os.Rename(paths[i], newPath)
+		if err != nil {
+			return errors.Wrapf(err, "unable to rename %s", paths[i])
+		}
+	}
+
+	err = os.Remove(filepath.Join(contextDir, StarterManifestFile))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// renderStarterFile substitutes the values of the parameters in the content of a text file
+func renderStarterFile(path string, mode os.FileMode, values map[string]string) error {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if isBinary(content) {
+		return nil
+	}
+	rendered := renderStarterTemplate(string(content), values)
+	if rendered == string(content) {
+		return nil
+	}
+	return ioutil.WriteFile(path, []byte(rendered), mode)
+}
+
+// renderStarterTemplate substitutes the values of the parameters in the text,
+// the placeholders of unknown parameters being left untouched
+func renderStarterTemplate(text string, values map[string]string) string {
+	return starterPlaceholder.ReplaceAllStringFunc(text, func(placeholder string) string {
+		match := starterPlaceholder.FindStringSubmatch(placeholder)
+		value, ok := values[match[1]]
+		if !ok {
+			return placeholder
+		}
+		if match[2] != "" {
+			return strings.ReplaceAll(value, ".", "/")
+		}
+		return value
+	})
+}
+
+// isBinary returns true when the content contains a NUL byte in its first 8000 bytes, as git does
+func isBinary(content []byte) bool {
+	if len(content) > 8000 {
+		content = content[:8000]
+	}
+	return bytes.IndexByte(content, 0) != -1
+}
@@ -0,0 +1,127 @@
+package component
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestResolveStarterParameters(t *testing.T) {
+	parameters := []StarterParameter{
+		{Name: "artifactId", Default: "demo"},
+		{Name: "serviceName"},
+	}
+
+	tests := []struct {
+		name    string
+		values  map[string]string
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name:   "default values",
+			values: map[string]string{"serviceName": "orders"},
+			want:   map[string]string{"artifactId": "demo", "serviceName": "orders"},
+		},
+		{
+			name:   "all the values passed",
+			values: map[string]string{"artifactId": "orders-api", "serviceName": "orders"},
+			want:   map[string]string{"artifactId": "orders-api", "serviceName": "orders"},
+		},
+		{
+			name:    "missing value without default",
+			values:  map[string]string{},
+			wantErr: true,
+		},
+		{
+			name:    "unknown parameter",
+			values:  map[string]string{"serviceName": "orders", "groupId": "com.example"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ResolveStarterParameters(parameters, tt.values)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error: %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderStarterProject(t *testing.T) {
+	contextDir, err := ioutil.TempDir("", "odo-starter")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(contextDir)
+
+	files := map[string]string{
+		StarterManifestFile: "parameters:\n- name: artifactId\n  description: The Maven artifact ID\n  default: demo\n- name: packageName\n",
+		"pom.xml":           "<artifactId>{{ artifactId }}</artifactId>\n<version>${project.version}</version>\n",
+		"src/main/java/{{packageName | path}}/Application.java": "package {{packageName}};\n",
+		"chart/templates/deployment.yaml":                       "name: {{ .Values.name }}-{{artifactId}}\n",
+		".odo/env/env.yaml":                                     "Name: {{artifactId}}\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(contextDir, filepath.FromSlash(name))
+		err = os.MkdirAll(filepath.Dir(path), 0750)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = ioutil.WriteFile(path, []byte(content), 0600)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	parameters, err := GetStarterParameters(contextDir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	wantParameters := []StarterParameter{
+		{Name: "artifactId", Description: "The Maven artifact ID", Default: "demo"},
+		{Name: "packageName"},
+	}
+	if !reflect.DeepEqual(parameters, wantParameters) {
+		t.Errorf("got parameters %+v, want %+v", parameters, wantParameters)
+	}
+
+	err = RenderStarterProject(contextDir, map[string]string{"artifactId": "orders", "packageName": "com.example.orders"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wantFiles := map[string]string{
+		"pom.xml": "<artifactId>orders</artifactId>\n<version>${project.version}</version>\n",
+		"src/main/java/com/example/orders/Application.java": "package com.example.orders;\n",
+		"chart/templates/deployment.yaml":                   "name: {{ .Values.name }}-orders\n",
+		".odo/env/env.yaml":                                 "Name: {{artifactId}}\n",
+	}
+	for name, want := range wantFiles {
+		content, err := ioutil.ReadFile(filepath.Join(contextDir, filepath.FromSlash(name)))
+		if err != nil {
+			t.Errorf("unable to read %s: %v", name, err)
+			continue
+		}
+		if string(content) != want {
+			t.Errorf("got content %q for %s, want %q", content, name, want)
+		}
+	}
+	for _, name := range []string{StarterManifestFile, "src/main/java/{{packageName | path}}"} {
+		if _, err := os.Stat(filepath.Join(contextDir, filepath.FromSlash(name))); !os.IsNotExist(err) {
+			t.Errorf("%s should be removed", name)
+		}
+	}
+
+	// a starter project without manifest has no parameters
+	parameters, err = GetStarterParameters(contextDir)
+	if err != nil || parameters != nil {
+		t.Errorf("got parameters %+v and error %v, want none", parameters, err)
+	}
+}
@@ -44,6 +44,10 @@ type SecretMount struct {
 	SecretName  string
 	MountVolume bool
 	MountPath   string
+	// Keys are the names of the binding data projected into the secret
+	Keys []string
+	// Stale indicates that the service changed since the secret was created
+	Stale bool
 }
 
 // ComponentStatus is Status of components
@@ -0,0 +1,254 @@
+package component
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// ConflictResolution is the way to resolve the fields of a devfile modified differently in the devfile
+// of the component and in the new version of its stack
+type ConflictResolution string
+
+const (
+	// KeepCurrent resolves the conflicts with the values of the devfile of the component
+	KeepCurrent ConflictResolution = "current"
+	// UseStack resolves the conflicts with the values of the new version of the stack
+	UseStack ConflictResolution = "stack"
+)
+
+// MergeConflict is a field of a devfile modified differently in the devfile of the component and in the new version of its stack
+type MergeConflict struct {
+	// Path is the path of the field in the devfile, the elements of lists being identified by their name
+	Path string
+	// Base is the value of the field in the version of the stack the component is created from
+	Base string
+	// Current is the value of the field in the devfile of the component
+	Current string
+	// Stack is the value of the field in the new version of the stack
+	Stack string
+}
+
+// absent is the value of the fields absent from a devfile
+type absent struct{}
+
+// devfileMerger merges the changes of a stack into the devfile of a component
+type devfileMerger struct {
+	resolution ConflictResolution
+	conflicts  []MergeConflict
+}
+
+// MergeDevfiles performs a three-way merge between the devfile of the version of the stack a component is created from (base),
+// the devfile of the component (current) and the devfile of the new version of the stack (stack); the changes made to the devfile
+// of the component are kept and the changes of the stack are applied, the conflicts being resolved with the given resolution.
+// The merged devfile is returned along with the conflicts.
+func MergeDevfiles(base, current, stack []byte, resolution ConflictResolution) ([]byte, []MergeConflict, error) {
+	var baseDevfile, currentDevfile, stackDevfile yaml.MapSlice
+	for _, devfile := range []struct {
+		name    string
+		content []byte
+		out     *yaml.MapSlice
+	}{
+		{"the devfile of the original stack", base, &baseDevfile},
+		{"the devfile of the component", current, &currentDevfile},
+		{"the devfile of the new stack", stack, &stackDevfile},
+	} {
+		err := yaml.Unmarshal(devfile.content, devfile.out)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "unable to parse %s", devfile.name)
+		}
+	}
+
+	merger := devfileMerger{resolution: resolution}
+	merged := merger.merge("", baseDevfile, currentDevfile, stackDevfile)
+	if _, ok := merged.(absent); ok {
+		merged = yaml.MapSlice{}
+	}
+	out, err := yaml.Marshal(merged)
+	if err != nil {
+		return nil, nil, err
+	}
+	return out, merger.conflicts, nil
+}
+
+// merge merges the base, current and stack values of the field at the given path
+func (m *devfileMerger) merge(path string, base, current, stack interface{}) interface{} {
+	switch {
+	case equalValues(current, stack), equalValues(base, stack):
+		return current
+	case equalValues(base, current):
+		return stack
+	}
+
+	// both the component and the stack modified the field, their changes are merged when they modify different sub fields
+	if currentMap, ok := current.(yaml.MapSlice); ok {
+		if stackMap, ok := stack.(yaml.MapSlice); ok {
+			baseMap, _ := base.(yaml.MapSlice)
+			return m.mergeMaps(path, baseMap, currentMap, stackMap)
+		}
+	}
+	if currentList, ok := current.([]interface{}); ok && isNamedList(currentList) {
+		if stackList, ok := stack.([]interface{}); ok && isNamedList(stackList) {
+			baseList, _ := base.([]interface{})
+			if isNamedList(baseList) {
+				return m.mergeNamedLists(path, baseList, currentList, stackList)
+			}
+		}
+	}
+
+	m.conflicts = append(m.conflicts, MergeConflict{
+		Path:    path,
+		Base:    formatValue(base),
+		Current: formatValue(current),
+		Stack:   formatValue(stack),
+	})
+	if m.resolution == UseStack {
+		return stack
+	}
+	return current
+}
+
+// mergeMaps merges the fields of maps, keeping the order of the fields of the current map,
+// and adding the fields added by the stack after them
+func (m *devfileMerger) mergeMaps(path string, base, current, stack yaml.MapSlice) interface{} {
+	merged := yaml.MapSlice{}
+	for _, item := range current {
+		key := fmt.Sprint(item.Key)
+		value := m.merge(joinPath(path, key), getField(base, key), item.Value, getField(stack, key))
+		if _, ok := value.(absent); !ok {
+			merged = append(merged, yaml.MapItem{Key: item.Key, Value: value})
+		}
+	}
+	for _, item := range stack {
+		key := fmt.Sprint(item.Key)
+		if _, ok := getField(current, key).(absent); !ok {
+			continue
+		}
+		value := m.merge(joinPath(path, key), getField(base, key), absent{}, item.Value)
+		if _, ok := value.(absent); !ok {
+			merged = append(merged, yaml.MapItem{Key: item.Key, Value: value})
+		}
+	}
+	return merged
+}
+
+// mergeNamedLists merges lists of elements identified by their name, as the components and commands of a devfile,
+// keeping the order of the elements of the current list, and adding the elements added by the stack after them
+func (m *devfileMerger) mergeNamedLists(path string, base, current, stack []interface{}) interface{} {
+	merged := []interface{}{}
+	for _, item := range current {
+		name := getName(item)
+		value := m.merge(fmt.Sprintf("%s[%s]", path, name), findByName(base, name), item, findByName(stack, name))
+		if _, ok := value.(absent); !ok {
+			merged = append(merged, value)
+		}
+	}
+	for _, item := range stack {
+		name := getName(item)
+		if _, ok := findByName(current, name).(absent); !ok {
+			continue
+		}
+		value := m.merge(fmt.Sprintf("%s[%s]", path, name), findByName(base, name), absent{}, item)
+		if _, ok := value.(absent); !ok {
+			merged = append(merged, value)
+		}
+	}
+	return merged
+}
+
+// isNamedList returns true when all the elements of the list are maps with a name
+func isNamedList(list []interface{}) bool {
+	for _, item := range list {
+		if getName(item) == "" {
+			return false
+		}
+	}
+	return true
+}
+
+// getName returns the name of an element of a list, the commands of a devfile being identified by their id,
+// or an empty string when the element has no name
+func getName(item interface{}) string {
+	itemMap, ok := item.(yaml.MapSlice)
+	if !ok {
+		return ""
+	}
+	for _, key := range []string{"name", "id"} {
+		if name, ok := getField(itemMap, key).(string); ok {
+			return name
+		}
+	}
+	return ""
+}
+
+// findByName returns the element of the list with the given name, or absent if there is no such element
+func findByName(list []interface{}, name string) interface{} {
+	for _, item := range list {
+		if getName(item) == name {
+			return item
+		}
+	}
+	return absent{}
+}
+
+// getField returns the value of the field of the map, or absent if the map has no such field
+func getField(m yaml.MapSlice, key string) interface{} {
+	for _, item := range m {
+		if fmt.Sprint(item.Key) == key {
+			return item.Value
+		}
+	}
+	return absent{}
+}
+
+// equalValues returns true when both values are equal, whatever the order of the fields of their maps
+func equalValues(a, b interface{}) bool {
+	switch av := a.(type) {
+	case yaml.MapSlice:
+		bv, ok := b.(yaml.MapSlice)
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for _, item := range av {
+			if !equalValues(item.Value, getField(bv, fmt.Sprint(item.Key))) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		bv, ok := b.([]interface{})
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for i := range av {
+			if !equalValues(av[i], bv[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return a == b
+	}
+}
+
+// formatValue returns the value as a single line YAML document
+func formatValue(value interface{}) string {
+	if _, ok := value.(absent); ok {
+		return "<absent>"
+	}
+	out, err := yaml.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	lines := strings.Split(strings.TrimSpace(string(out)), "\n")
+	return strings.Join(lines, " ")
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
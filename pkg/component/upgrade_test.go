@@ -0,0 +1,148 @@
+package component
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestMergeDevfiles(t *testing.T) {
+	base := `schemaVersion: 2.0.0
+metadata:
+  name: nodejs
+  version: 1.0.0
+components:
+- name: runtime
+  container:
+    image: registry.access.redhat.com/ubi8/nodejs-12:1-36
+    memoryLimit: 1024Mi
+    endpoints:
+    - name: http-3000
+      targetPort: 3000
+commands:
+- id: install
+  exec:
+    component: runtime
+    commandLine: npm install
+- id: run
+  exec:
+    component: runtime
+    commandLine: npm start
+`
+	current := `schemaVersion: 2.0.0
+metadata:
+  name: mynode
+  version: 1.0.0
+components:
+- name: runtime
+  container:
+    image: registry.access.redhat.com/ubi8/nodejs-12:1-36
+    memoryLimit: 2048Mi
+    endpoints:
+    - name: http-3000
+      targetPort: 3000
+- name: db
+  container:
+    image: postgres:13
+commands:
+- id: install
+  exec:
+    component: runtime
+    commandLine: npm ci
+- id: run
+  exec:
+    component: runtime
+    commandLine: npm start
+`
+	stack := `schemaVersion: 2.1.0
+metadata:
+  name: nodejs
+  version: 1.1.0
+components:
+- name: runtime
+  container:
+    image: registry.access.redhat.com/ubi8/nodejs-14:latest
+    memoryLimit: 1024Mi
+    endpoints:
+    - name: http-3000
+      targetPort: 3000
+    - name: debug
+      targetPort: 5858
+commands:
+- id: install
+  exec:
+    component: runtime
+    commandLine: npm install --production
+- id: run
+  exec:
+    component: runtime
+    commandLine: npm start
+`
+	wantCurrent := `schemaVersion: 2.1.0
+metadata:
+  name: mynode
+  version: 1.1.0
+components:
+- name: runtime
+  container:
+    image: registry.access.redhat.com/ubi8/nodejs-14:latest
+    memoryLimit: 2048Mi
+    endpoints:
+    - name: http-3000
+      targetPort: 3000
+    - name: debug
+      targetPort: 5858
+- name: db
+  container:
+    image: postgres:13
+commands:
+- id: install
+  exec:
+    component: runtime
+    commandLine: npm ci
+- id: run
+  exec:
+    component: runtime
+    commandLine: npm start
+`
+	wantConflicts := []MergeConflict{
+		{Path: "commands[install].exec.commandLine", Base: "npm install", Current: "npm ci", Stack: "npm install --production"},
+	}
+
+	merged, conflicts, err := MergeDevfiles([]byte(base), []byte(current), []byte(stack), KeepCurrent)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(merged) != wantCurrent {
+		t.Errorf("got merged devfile\n%s\nwant\n%s", merged, wantCurrent)
+	}
+	if !reflect.DeepEqual(conflicts, wantConflicts) {
+		t.Errorf("got conflicts %+v, want %+v", conflicts, wantConflicts)
+	}
+
+	merged, _, err = MergeDevfiles([]byte(base), []byte(current), []byte(stack), UseStack)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	wantStack := strings.Replace(wantCurrent, "npm ci", "npm install --production", 1)
+	if string(merged) != wantStack {
+		t.Errorf("got merged devfile\n%s\nwant\n%s", merged, wantStack)
+	}
+}
+
+func TestMergeDevfilesRemovedFields(t *testing.T) {
+	base := "metadata:\n  name: go\n  icon: go.svg\nstarterProjects:\n- name: go-starter\n"
+	current := "metadata:\n  name: go\nstarterProjects:\n- name: go-starter\n"
+	stack := "metadata:\n  name: go\n  icon: go.svg\n"
+
+	merged, conflicts, err := MergeDevfiles([]byte(base), []byte(current), []byte(stack), KeepCurrent)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := "metadata:\n  name: go\n"; string(merged) != want {
+		t.Errorf("got merged devfile\n%s\nwant\n%s", merged, want)
+	}
+	if !reflect.DeepEqual(conflicts, []MergeConflict(nil)) {
+		t.Errorf("got conflicts %+v, want none", conflicts)
+	}
+}
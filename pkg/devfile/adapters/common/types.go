@@ -31,6 +31,7 @@ type PushParameters struct {
 	Debug                    bool                    // Runs the component in debug mode
 	DebugPort                int                     // Port used for remote debugging
 	RunModeChanged           bool                    // It determines if run mode is changed from run to debug or vice versa
+	WaitServices             bool                    // WaitServices determines whether to wait until the linked services are ready before updating the component
 }
 
 // SyncParameters is a struct containing the parameters to be used when syncing a devfile component
@@ -190,18 +190,47 @@ func (a Adapter) Push(parameters common.PushParameters) (err error) {
 
 	log.Infof("\nCreating Services for component %s", a.ComponentName)
 
+	// replace the services whose Operator is not available by their dev substitutes
+	k8sComponents, err = service.SubstituteUnavailableServices(a.Client, k8sComponents, a.ComponentName, a.Context)
+	if err != nil {
+		return err
+	}
+
 	// validate if the GVRs represented by Kubernetes inlined components are supported by the underlying cluster
 	err = service.ValidateResourcesExist(a.Client, k8sComponents, a.Context)
 	if err != nil {
 		return err
 	}
 
+	// validate the Kubernetes inlined components against the schemas of their custom resources
+	err = service.ValidateResourcesSchema(a.Client, k8sComponents, a.Context)
+	if err != nil {
+		return err
+	}
+
 	// create the Kubernetes objects from the manifest and delete the ones not in the devfile
 	err = service.PushKubernetesResources(a.Client, k8sComponents, labels, a.Context)
 	if err != nil {
 		return errors.Wrap(err, "failed to create service(s) associated with the component")
 	}
 
+	err = service.DeleteUnusedServiceSubstitutes(a.Client, k8sComponents, a.ComponentName, a.Context)
+	if err != nil {
+		return err
+	}
+
+	// wait for the linked services before the component is updated and restarted to bind them
+	if parameters.WaitServices {
+		linkedServices, err := service.GetLinkedServices(k8sComponents, a.Context)
+		if err != nil {
+			return err
+		}
+		err = service.WaitForServicesReady(a.Client, linkedServices, time.Duration(a.prefClient.GetPushTimeout())*time.Second)
+		if err != nil {
+			return err
+		}
+	}
+
 	log.Infof("\nCreating Kubernetes resources for component %s", a.ComponentName)
 
 	isMainStorageEphemeral := a.prefClient.GetEphemeralSourceVolume()
@@ -244,6 +273,11 @@ func (a Adapter) Push(parameters common.PushParameters) (err error) {
 		return err
 	}
 
+	err = service.UpdateHelmReleasesWithOwnerReferences(a.Client, labels, ownerReference)
+	if err != nil {
+		return err
+	}
+
 	// create the Kubernetes objects from the manifest and delete the ones not in the devfile
 	needRestart, err := service.PushLinks(a.Client, k8sComponents, labels, a.deployment, a.Context)
 	if err != nil {
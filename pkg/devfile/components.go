@@ -186,3 +186,43 @@ func deleteKubernetesComponentFromDevfile(name string, devfileObj parser.Devfile
 
 	return devfileObj.WriteYamlDevfile()
 }
+
+// UpdateKubernetesComponent replaces the resource definition of a Kubernetes component, either inlined in the devfile
+// or stored in a local file referenced by its uri
+func UpdateKubernetesComponent(crd, name, componentContext string, devfileObj parser.DevfileObj) error {
+	return updateKubernetesComponent(crd, name, componentContext, devfileObj, devfilefs.DefaultFs{})
+}
+
+func updateKubernetesComponent(crd, name, componentContext string, devfileObj parser.DevfileObj, fs devfilefs.Filesystem) error {
+	components, err := devfileObj.Data.GetComponents(common.DevfileOptions{
+		ComponentOptions: parsercommon.ComponentOptions{ComponentType: devfilev1.KubernetesComponentType},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, c := range components {
+		if c.Name != name {
+			continue
+		}
+
+		if c.Kubernetes.Uri == "" {
+			c.Kubernetes.Inlined = crd
+			err = devfileObj.Data.UpdateComponent(c)
+			if err != nil {
+				return err
+			}
+			return devfileObj.WriteYamlDevfile()
+		}
+
+		parsedURL, err := url.Parse(c.Kubernetes.Uri)
+		if err != nil {
+			return err
+		}
+		if len(parsedURL.Host) != 0 && len(parsedURL.Scheme) != 0 {
+			return fmt.Errorf("the definition of the service %q is stored at the remote location %q and can't be updated", name, c.Kubernetes.Uri)
+		}
+		return fs.WriteFile(filepath.Join(componentContext, c.Kubernetes.Uri), []byte(crd), 0755)
+	}
+	return fmt.Errorf("could not find the service %q in devfile", name)
+}
@@ -351,3 +351,60 @@ func TestDeleteKubernetesComponentFromDevfile(t *testing.T) {
 		})
 	}
 }
+
+func Test_updateKubernetesComponent(t *testing.T) {
+	fs := devfileFileSystem.NewFakeFs()
+
+	testFolderName := "someFolder"
+	testFileName, err := devfiletesting.SetupTestFolder(testFolderName, fs)
+	if err != nil {
+		t.Fatalf("unexpected error : %v", err)
+	}
+	uri := filepath.Join(consts.UriFolder, filepath.Base(testFileName.Name()))
+
+	devfileObj := parser.DevfileObj{
+		Data: devfiletesting.GetDevfileData(t, []devfiletesting.InlinedComponent{
+			{
+				Name:    "inlined",
+				Inlined: "old CRD",
+			},
+		}, []devfiletesting.URIComponent{
+			{
+				Name: "uri",
+				URI:  uri,
+			},
+		}),
+		Ctx: devfileCtx.FakeContext(fs, parser.OutputDevfileYamlPath),
+	}
+
+	err = updateKubernetesComponent("new inlined CRD", "inlined", testFolderName, devfileObj, fs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	components, err := devfileObj.Data.GetComponents(common.DevfileOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range components {
+		if c.Name == "inlined" && c.Kubernetes.Inlined != "new inlined CRD" {
+			t.Errorf("got inlined definition %q, want the new definition", c.Kubernetes.Inlined)
+		}
+	}
+
+	err = updateKubernetesComponent("new uri CRD", "uri", testFolderName, devfileObj, fs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	content, err := fs.ReadFile(filepath.Join(testFolderName, uri))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != "new uri CRD" {
+		t.Errorf("got file content %q, want the new definition", string(content))
+	}
+
+	err = updateKubernetesComponent("CRD", "missing", testFolderName, devfileObj, fs)
+	if err == nil {
+		t.Errorf("expected an error for a component not defined in the devfile")
+	}
+}
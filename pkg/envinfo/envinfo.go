@@ -323,6 +323,17 @@ func (esi *EnvSpecificInfo) SetRunMode(runMode RUNMode) error {
 	return esi.writeToFile()
 }
 
+// GetStack returns the registry stack the devfile of the component is created from, nil if it is not created from a registry
+func (ei *EnvInfo) GetStack() *Stack {
+	return ei.componentSettings.Stack
+}
+
+// SetStack sets the registry stack the devfile of the component is created from and writes to the file
+func (esi *EnvSpecificInfo) SetStack(stack Stack) error {
+	esi.componentSettings.Stack = &stack
+	return esi.writeToFile()
+}
+
 // GetNamespace returns component namespace
 func (ei *EnvInfo) GetNamespace() string {
 	return ei.componentSettings.Project
@@ -5,17 +5,32 @@ import (
 
 	"github.com/blang/semver"
 	"github.com/devfile/library/pkg/devfile/parser/data/v2/common"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/klog/v2"
 
 	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/attributes"
 	"github.com/redhat-developer/odo/pkg/localConfigProvider"
 )
 
 const (
 	// DefaultVolumeSize Default volume size for volumes defined in a devfile
 	DefaultVolumeSize = "1Gi"
+
+	// StorageClassAttribute is the attribute of a devfile volume holding the storage class of the volume
+	StorageClassAttribute = "dev.odo.storage.class"
+	// AccessModeAttribute is the attribute of a devfile volume holding the access mode of the volume
+	AccessModeAttribute = "dev.odo.storage.accessMode"
 )
 
+// supportedAccessModes are the access modes which can be set on a volume
+var supportedAccessModes = []corev1.PersistentVolumeAccessMode{
+	corev1.ReadWriteOnce,
+	corev1.ReadOnlyMany,
+	corev1.ReadWriteMany,
+	corev1.ReadWriteOncePod,
+}
+
 // CompleteStorage completes the given storage
 func (ei *EnvInfo) CompleteStorage(storage *localConfigProvider.LocalStorage) {
 	if storage.Size == "" {
@@ -39,6 +54,21 @@ func (ei *EnvInfo) ValidateStorage(storage localConfigProvider.LocalStorage) err
 		if !version.GE(minSupport) {
 			return fmt.Errorf("Version of devfile is %q, should be at least %q to use --ephemeral flag", version, minSupport)
 		}
+		if storage.StorageClass != "" || storage.AccessMode != "" {
+			return fmt.Errorf("storage class and access mode can only be set on persistent storage")
+		}
+	}
+
+	if storage.AccessMode != "" {
+		supported := false
+		for _, mode := range supportedAccessModes {
+			if storage.AccessMode == string(mode) {
+				supported = true
+			}
+		}
+		if !supported {
+			return fmt.Errorf("access mode %q is not supported, supported access modes are %v", storage.AccessMode, supportedAccessModes)
+		}
 	}
 
 	storageList, err := ei.ListStorage()
@@ -98,8 +128,19 @@ func (ei *EnvInfo) CreateStorage(storage localConfigProvider.LocalStorage) error
 			Path: storage.Path,
 		},
 	}
+	var attrs attributes.Attributes
+	if storage.StorageClass != "" || storage.AccessMode != "" {
+		attrs = attributes.Attributes{}
+		if storage.StorageClass != "" {
+			attrs.PutString(StorageClassAttribute, storage.StorageClass)
+		}
+		if storage.AccessMode != "" {
+			attrs.PutString(AccessModeAttribute, storage.AccessMode)
+		}
+	}
 	vc := []devfilev1.Component{{
-		Name: storage.Name,
+		Name:       storage.Name,
+		Attributes: attrs,
 		ComponentUnion: devfilev1.ComponentUnion{
 			Volume: &devfilev1.VolumeComponent{
 				Volume: devfilev1.Volume{
@@ -161,7 +202,7 @@ func (ei *EnvInfo) CreateStorage(storage localConfigProvider.LocalStorage) error
 func (ei *EnvInfo) ListStorage() ([]localConfigProvider.LocalStorage, error) {
 	var storageList []localConfigProvider.LocalStorage
 
-	volumeMap := make(map[string]devfilev1.Volume)
+	volumeMap := make(map[string]devfilev1.Component)
 	components, err := ei.devfileObj.Data.GetComponents(common.DevfileOptions{})
 	if err != nil {
 		return storageList, err
@@ -174,7 +215,7 @@ func (ei *EnvInfo) ListStorage() ([]localConfigProvider.LocalStorage, error) {
 		if component.Volume.Size == "" {
 			component.Volume.Size = DefaultVolumeSize
 		}
-		volumeMap[component.Name] = component.Volume.Volume
+		volumeMap[component.Name] = component
 	}
 
 	for _, component := range components {
@@ -185,11 +226,13 @@ func (ei *EnvInfo) ListStorage() ([]localConfigProvider.LocalStorage, error) {
 			vol, ok := volumeMap[volumeMount.Name]
 			if ok {
 				storageList = append(storageList, localConfigProvider.LocalStorage{
-					Name:      volumeMount.Name,
-					Size:      vol.Size,
-					Ephemeral: vol.Ephemeral,
-					Path:      GetVolumeMountPath(volumeMount),
-					Container: component.Name,
+					Name:         volumeMount.Name,
+					Size:         vol.Volume.Size,
+					Ephemeral:    vol.Volume.Ephemeral,
+					Path:         GetVolumeMountPath(volumeMount),
+					StorageClass: vol.Attributes.GetString(StorageClassAttribute, nil),
+					AccessMode:   vol.Attributes.GetString(AccessModeAttribute, nil),
+					Container:    component.Name,
 				})
 			}
 		}
@@ -7,10 +7,12 @@ import (
 	"github.com/devfile/library/pkg/devfile/parser/data"
 
 	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/attributes"
 	"github.com/devfile/library/pkg/devfile/parser"
 	"github.com/devfile/library/pkg/testingutil"
 	"github.com/kylelemons/godebug/pretty"
 	"github.com/redhat-developer/odo/pkg/localConfigProvider"
+	"github.com/redhat-developer/odo/pkg/util"
 )
 
 func TestGetVolumeMountPath(t *testing.T) {
@@ -323,6 +325,55 @@ func TestEnvInfo_ListStorage(t *testing.T) {
 			},
 			want: nil,
 		},
+		{
+			name: "case 5: list the volumes with their storage class and access mode",
+			fields: fields{
+				devfileObj: parser.DevfileObj{
+					Data: func() data.DevfileData {
+						devfileData, err := data.NewDevfileData(string(data.APISchemaVersion200))
+						if err != nil {
+							t.Error(err)
+						}
+						volume := testingutil.GetFakeVolumeComponent("volume-0", "5Gi")
+						volume.Attributes = attributes.Attributes{}.
+							PutString(StorageClassAttribute, "fast").
+							PutString(AccessModeAttribute, "ReadWriteMany")
+						err = devfileData.AddComponents([]devfilev1.Component{
+							{
+								Name: "container-0",
+								ComponentUnion: devfilev1.ComponentUnion{
+									Container: &devfilev1.ContainerComponent{
+										Container: devfilev1.Container{
+											VolumeMounts: []devfilev1.VolumeMount{
+												{
+													Name: "volume-0",
+													Path: "/cache",
+												},
+											},
+										},
+									},
+								},
+							},
+							volume,
+						})
+						if err != nil {
+							t.Error(err)
+						}
+						return devfileData
+					}(),
+				},
+			},
+			want: []localConfigProvider.LocalStorage{
+				{
+					Name:         "volume-0",
+					Size:         "5Gi",
+					Path:         "/cache",
+					StorageClass: "fast",
+					AccessMode:   "ReadWriteMany",
+					Container:    "container-0",
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -446,6 +497,114 @@ func TestEnvInfo_ValidateStorage(t *testing.T) {
 				},
 			},
 			wantErr: true,
+		}, {
+			name: "case 3: storage with a storage class and a supported access mode",
+			fields: fields{
+				devfileObj: parser.DevfileObj{
+					Data: func() data.DevfileData {
+						devfileData, err := data.NewDevfileData(string(data.APISchemaVersion210))
+						if err != nil {
+							t.Error(err)
+						}
+						err = devfileData.AddComponents([]devfilev1.Component{
+							{
+								Name: "container-0",
+								ComponentUnion: devfilev1.ComponentUnion{
+									Container: &devfilev1.ContainerComponent{
+										Container: devfilev1.Container{},
+									},
+								},
+							},
+						})
+						if err != nil {
+							t.Error(err)
+						}
+						return devfileData
+					}(),
+				},
+			},
+			args: args{
+				storage: localConfigProvider.LocalStorage{
+					Name:         "volume-0",
+					Size:         "10Gi",
+					Path:         "/data",
+					StorageClass: "fast",
+					AccessMode:   "ReadWriteMany",
+				},
+			},
+		},
+		{
+			name: "case 4: storage with an unsupported access mode",
+			fields: fields{
+				devfileObj: parser.DevfileObj{
+					Data: func() data.DevfileData {
+						devfileData, err := data.NewDevfileData(string(data.APISchemaVersion210))
+						if err != nil {
+							t.Error(err)
+						}
+						err = devfileData.AddComponents([]devfilev1.Component{
+							{
+								Name: "container-0",
+								ComponentUnion: devfilev1.ComponentUnion{
+									Container: &devfilev1.ContainerComponent{
+										Container: devfilev1.Container{},
+									},
+								},
+							},
+						})
+						if err != nil {
+							t.Error(err)
+						}
+						return devfileData
+					}(),
+				},
+			},
+			args: args{
+				storage: localConfigProvider.LocalStorage{
+					Name:       "volume-0",
+					Size:       "10Gi",
+					Path:       "/data",
+					AccessMode: "ReadWriteSometimes",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "case 5: ephemeral storage with a storage class",
+			fields: fields{
+				devfileObj: parser.DevfileObj{
+					Data: func() data.DevfileData {
+						devfileData, err := data.NewDevfileData(string(data.APISchemaVersion210))
+						if err != nil {
+							t.Error(err)
+						}
+						err = devfileData.AddComponents([]devfilev1.Component{
+							{
+								Name: "container-0",
+								ComponentUnion: devfilev1.ComponentUnion{
+									Container: &devfilev1.ContainerComponent{
+										Container: devfilev1.Container{},
+									},
+								},
+							},
+						})
+						if err != nil {
+							t.Error(err)
+						}
+						return devfileData
+					}(),
+				},
+			},
+			args: args{
+				storage: localConfigProvider.LocalStorage{
+					Name:         "volume-0",
+					Size:         "10Gi",
+					Path:         "/data",
+					Ephemeral:    util.GetBoolPtr(true),
+					StorageClass: "fast",
+				},
+			},
+			wantErr: true,
 		},
 	}
 	for _, tt := range tests {
@@ -37,6 +37,16 @@ type ComponentSettings struct {
 
 	// RunMode indicates the mode of run used for a successful push
 	RunMode *RUNMode `yaml:"RunMode,omitempty" json:"runMode,omitempty"`
+
+	// Stack is the registry stack the devfile of the component is created from
+	Stack *Stack `yaml:"Stack,omitempty" json:"stack,omitempty"`
+}
+
+// Stack identifies a version of a stack of a devfile registry
+type Stack struct {
+	Registry string `yaml:"Registry,omitempty" json:"registry,omitempty"`
+	Name     string `yaml:"Name,omitempty" json:"name,omitempty"`
+	Version  string `yaml:"Version,omitempty" json:"version,omitempty"`
 }
 
 func NewInfo(cs ComponentSettings) Info {
@@ -0,0 +1,252 @@
+package export
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	devfile "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	devfilefs "github.com/devfile/library/pkg/testingutil/filesystem"
+	applabels "github.com/redhat-developer/odo/pkg/application/labels"
+	componentlabels "github.com/redhat-developer/odo/pkg/component/labels"
+	adaptersCommon "github.com/redhat-developer/odo/pkg/devfile/adapters/common"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/service"
+	"github.com/redhat-developer/odo/pkg/storage"
+	storagelabels "github.com/redhat-developer/odo/pkg/storage/labels"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// devEnvVars are the environment variables set by odo on the containers for the development only
+var devEnvVars = map[string]bool{
+	adaptersCommon.EnvOdoCommandRun:             true,
+	adaptersCommon.EnvOdoCommandRunWorkingDir:   true,
+	adaptersCommon.EnvOdoCommandDebug:           true,
+	adaptersCommon.EnvOdoCommandDebugWorkingDir: true,
+	adaptersCommon.EnvDebugPort:                 true,
+}
+
+// GetComponentResources returns the resources of the component deployed on the cluster, without the parts used only
+// for the development, followed by the resources defined by the Kubernetes components of the devfile (services and links)
+func GetComponentResources(client kclient.ClientInterface, componentName, appName string, k8sComponents []devfile.Component, context string) ([]unstructured.Unstructured, error) {
+	selector := componentlabels.GetSelector(componentName, appName)
+
+	// the secrets of the links created by odo are replaced by the links themselves
+	linkSecrets := map[string]bool{}
+	secrets, err := client.ListSecrets(selector)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range secrets {
+		if _, ok := s.Labels[service.LinkLabel]; ok {
+			linkSecrets[s.Name] = true
+		}
+	}
+
+	var objects []interface{}
+
+	deployment, err := client.GetOneDeploymentFromSelector(selector)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the deployment of component %q, make sure it has been pushed: %w", componentName, err)
+	}
+	deployment.APIVersion, deployment.Kind = "apps/v1", "Deployment"
+	stripDeployment(deployment, linkSecrets)
+	objects = append(objects, deployment)
+
+	services, err := client.ListServices(selector)
+	if err != nil {
+		return nil, err
+	}
+	for i := range services {
+		services[i].APIVersion, services[i].Kind = "v1", "Service"
+		services[i].Spec.ClusterIP = ""
+		services[i].Spec.ClusterIPs = nil
+		objects = append(objects, &services[i])
+	}
+
+	pvcs, err := client.ListPVCs(selector)
+	if err != nil {
+		return nil, err
+	}
+	for i := range pvcs {
+		if pvcs[i].Labels[storagelabels.SourcePVCLabel] == storage.OdoSourceVolume {
+			continue
+		}
+		pvcs[i].APIVersion, pvcs[i].Kind = "v1", "PersistentVolumeClaim"
+		pvcs[i].Spec.VolumeName = ""
+		objects = append(objects, &pvcs[i])
+	}
+
+	ingresses, err := client.ListIngresses(selector)
+	if err != nil {
+		return nil, err
+	}
+	for _, ingress := range ingresses.Items {
+		if ingress.NetworkingV1Ingress != nil {
+			ingress.NetworkingV1Ingress.APIVersion, ingress.NetworkingV1Ingress.Kind = "networking.k8s.io/v1", "Ingress"
+			objects = append(objects, ingress.NetworkingV1Ingress)
+		} else if ingress.ExtensionV1Beta1Ingress != nil {
+			ingress.ExtensionV1Beta1Ingress.APIVersion, ingress.ExtensionV1Beta1Ingress.Kind = "extensions/v1beta1", "Ingress"
+			objects = append(objects, ingress.ExtensionV1Beta1Ingress)
+		}
+	}
+
+	routeSupported, err := client.IsRouteSupported()
+	if err != nil {
+		return nil, err
+	}
+	if routeSupported {
+		routes, err := client.ListRoutes(selector)
+		if err != nil {
+			return nil, err
+		}
+		for i := range routes {
+			routes[i].APIVersion, routes[i].Kind = "route.openshift.io/v1", "Route"
+			routes[i].Spec.Host = ""
+			objects = append(objects, &routes[i])
+		}
+	}
+
+	var resources []unstructured.Unstructured
+	for _, obj := range objects {
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return nil, err
+		}
+		u := unstructured.Unstructured{Object: content}
+		cleanResource(&u)
+		resources = append(resources, u)
+	}
+
+	for _, c := range k8sComponents {
+		u, err := service.GetK8sComponentAsUnstructured(c.Kubernetes, context, devfilefs.DefaultFs{})
+		if err != nil {
+			return nil, err
+		}
+		cleanResource(&u)
+		resources = append(resources, u)
+	}
+	return resources, nil
+}
+
+// stripDeployment removes from the deployment the supervisord init container, the volumes of the sources and of supervisord,
+// the environment variables used by odo and the secrets of the links, and runs the run command of the devfile in the
+// containers started by supervisord
+func stripDeployment(deployment *appsv1.Deployment, linkSecrets map[string]bool) {
+	podSpec := &deployment.Spec.Template.Spec
+
+	var initContainers []corev1.Container
+	for _, c := range podSpec.InitContainers {
+		if c.Name != adaptersCommon.SupervisordInitContainerName {
+			initContainers = append(initContainers, c)
+		}
+	}
+	podSpec.InitContainers = initContainers
+
+	devVolumes := map[string]bool{
+		storage.OdoSourceVolume:              true,
+		adaptersCommon.SupervisordVolumeName: true,
+	}
+	var volumes []corev1.Volume
+	for _, v := range podSpec.Volumes {
+		if v.Secret != nil && linkSecrets[v.Secret.SecretName] {
+			devVolumes[v.Name] = true
+		}
+		if !devVolumes[v.Name] {
+			volumes = append(volumes, v)
+		}
+	}
+	podSpec.Volumes = volumes
+
+	for i := range podSpec.Containers {
+		c := &podSpec.Containers[i]
+
+		if len(c.Command) > 0 && c.Command[0] == adaptersCommon.SupervisordBinaryPath {
+			c.Command, c.Args = nil, nil
+			if command := getEnvValue(c.Env, adaptersCommon.EnvOdoCommandRun); command != "" {
+				if dir := getEnvValue(c.Env, adaptersCommon.EnvOdoCommandRunWorkingDir); dir != "" {
+					command = fmt.Sprintf("cd %s && %s", dir, command)
+				}
+				c.Command = []string{"/bin/sh", "-c", command}
+			}
+		}
+
+		var env []corev1.EnvVar
+		for _, e := range c.Env {
+			if !devEnvVars[e.Name] {
+				env = append(env, e)
+			}
+		}
+		c.Env = env
+
+		var envFrom []corev1.EnvFromSource
+		for _, e := range c.EnvFrom {
+			if e.SecretRef == nil || !linkSecrets[e.SecretRef.Name] {
+				envFrom = append(envFrom, e)
+			}
+		}
+		c.EnvFrom = envFrom
+
+		var mounts []corev1.VolumeMount
+		for _, m := range c.VolumeMounts {
+			if !devVolumes[m.Name] {
+				mounts = append(mounts, m)
+			}
+		}
+		c.VolumeMounts = mounts
+	}
+}
+
+func getEnvValue(env []corev1.EnvVar, name string) string {
+	for _, e := range env {
+		if e.Name == name {
+			return e.Value
+		}
+	}
+	return ""
+}
+
+// cleanResource removes the fields set by the cluster and the labels of odo as manager of the resource
+func cleanResource(u *unstructured.Unstructured) {
+	delete(u.Object, "status")
+	metadata, ok := u.Object["metadata"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	for _, field := range []string{"namespace", "uid", "resourceVersion", "generation", "creationTimestamp", "managedFields", "selfLink", "ownerReferences"} {
+		delete(metadata, field)
+	}
+	unstructured.RemoveNestedField(u.Object, "spec", "template", "metadata", "creationTimestamp")
+
+	labels := u.GetLabels()
+	delete(labels, applabels.ManagedBy)
+	delete(labels, applabels.ManagerVersion)
+	u.SetLabels(labels)
+
+	annotations := u.GetAnnotations()
+	for k := range annotations {
+		if k == "deployment.kubernetes.io/revision" || k == "kubectl.kubernetes.io/last-applied-configuration" || strings.HasPrefix(k, "pv.kubernetes.io/") || strings.HasPrefix(k, "volume.beta.kubernetes.io/") {
+			delete(annotations, k)
+		}
+	}
+	u.SetAnnotations(annotations)
+}
+
+// getFileName returns the name of the file of the resource
+func getFileName(u unstructured.Unstructured) string {
+	return strings.ToLower(u.GetKind()) + "-" + u.GetName() + ".yaml"
+}
+
+// getFileNames returns the names of the files of the resources, sorted
+func getFileNames(resources []unstructured.Unstructured) []string {
+	names := make([]string, 0, len(resources))
+	for _, r := range resources {
+		names = append(names, getFileName(r))
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,202 @@
+package export
+
+import (
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	devfilefs "github.com/devfile/library/pkg/testingutil/filesystem"
+	"github.com/golang/mock/gomock"
+	adaptersCommon "github.com/redhat-developer/odo/pkg/devfile/adapters/common"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/service"
+	"github.com/redhat-developer/odo/pkg/storage"
+	storagelabels "github.com/redhat-developer/odo/pkg/storage/labels"
+	"github.com/redhat-developer/odo/pkg/unions"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func getDevDeployment() *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "nodejs-app",
+			Namespace:       "myproject",
+			ResourceVersion: "1234",
+			Labels: map[string]string{
+				"app.kubernetes.io/instance":           "nodejs",
+				"app.kubernetes.io/part-of":            "app",
+				"app.kubernetes.io/managed-by":         "odo",
+				"app.kubernetes.io/managed-by-version": "v2.3.1",
+			},
+		},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					InitContainers: []corev1.Container{{Name: adaptersCommon.SupervisordInitContainerName, Image: "odo-init"}},
+					Containers: []corev1.Container{{
+						Name:    "runtime",
+						Image:   "registry.access.redhat.com/ubi8/nodejs-14",
+						Command: []string{adaptersCommon.SupervisordBinaryPath},
+						Args:    []string{"-c", adaptersCommon.SupervisordConfFile},
+						Env: []corev1.EnvVar{
+							{Name: "PORT", Value: "3000"},
+							{Name: adaptersCommon.EnvOdoCommandRun, Value: "npm start"},
+							{Name: adaptersCommon.EnvOdoCommandRunWorkingDir, Value: "/project"},
+							{Name: adaptersCommon.EnvDebugPort, Value: "5858"},
+						},
+						EnvFrom: []corev1.EnvFromSource{
+							{SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "nodejs-secret-mydb"}}},
+							{ConfigMapRef: &corev1.ConfigMapEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "settings"}}},
+						},
+						VolumeMounts: []corev1.VolumeMount{
+							{Name: storage.OdoSourceVolume, MountPath: "/project"},
+							{Name: adaptersCommon.SupervisordVolumeName, MountPath: adaptersCommon.SupervisordMountPath},
+							{Name: "data", MountPath: "/data"},
+						},
+					}},
+					Volumes: []corev1.Volume{
+						{Name: storage.OdoSourceVolume},
+						{Name: adaptersCommon.SupervisordVolumeName},
+						{Name: "data", VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "data-nodejs"}}},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestGetComponentResources(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	fkClient := kclient.NewMockClientInterface(ctrl)
+	selector := "app.kubernetes.io/instance=nodejs,app.kubernetes.io/part-of=app"
+	fkClient.EXPECT().ListSecrets(selector).Return([]corev1.Secret{
+		{ObjectMeta: metav1.ObjectMeta{Name: "nodejs-secret-mydb", Labels: map[string]string{service.LinkLabel: "nodejs-secret-mydb"}}},
+	}, nil)
+	fkClient.EXPECT().GetOneDeploymentFromSelector(selector).Return(getDevDeployment(), nil)
+	fkClient.EXPECT().ListServices(selector).Return([]corev1.Service{
+		{ObjectMeta: metav1.ObjectMeta{Name: "nodejs-app"}, Spec: corev1.ServiceSpec{ClusterIP: "10.0.0.1", Ports: []corev1.ServicePort{{Port: 3000}}}},
+	}, nil)
+	fkClient.EXPECT().ListPVCs(selector).Return([]corev1.PersistentVolumeClaim{
+		{ObjectMeta: metav1.ObjectMeta{Name: "odo-projects-nodejs", Labels: map[string]string{storagelabels.SourcePVCLabel: storage.OdoSourceVolume}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "data-nodejs"}, Spec: corev1.PersistentVolumeClaimSpec{VolumeName: "pv-1"}},
+	}, nil)
+	fkClient.EXPECT().ListIngresses(selector).Return(unions.NewEmptyKubernetesIngressList(), nil)
+	fkClient.EXPECT().IsRouteSupported().Return(false, nil)
+
+	resources, err := GetComponentResources(fkClient, "nodejs", "app", nil, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{"deployment-nodejs-app.yaml", "persistentvolumeclaim-data-nodejs.yaml", "service-nodejs-app.yaml"}
+	if got := getFileNames(resources); !reflect.DeepEqual(got, want) {
+		t.Fatalf("got resources %v, want %v", got, want)
+	}
+
+	deployment := resources[0]
+	if deployment.GetNamespace() != "" || deployment.GetResourceVersion() != "" {
+		t.Errorf("the namespace and resource version of the deployment are not removed")
+	}
+	if want := map[string]string{"app.kubernetes.io/instance": "nodejs", "app.kubernetes.io/part-of": "app"}; !reflect.DeepEqual(deployment.GetLabels(), want) {
+		t.Errorf("got labels %v, want %v", deployment.GetLabels(), want)
+	}
+	containers, _, _ := unstructured.NestedSlice(deployment.Object, "spec", "template", "spec", "containers")
+	wantContainer := map[string]interface{}{
+		"name":         "runtime",
+		"image":        "registry.access.redhat.com/ubi8/nodejs-14",
+		"command":      []interface{}{"/bin/sh", "-c", "cd /project && npm start"},
+		"env":          []interface{}{map[string]interface{}{"name": "PORT", "value": "3000"}},
+		"envFrom":      []interface{}{map[string]interface{}{"configMapRef": map[string]interface{}{"name": "settings"}}},
+		"volumeMounts": []interface{}{map[string]interface{}{"name": "data", "mountPath": "/data"}},
+		"resources":    map[string]interface{}{},
+	}
+	if !reflect.DeepEqual(containers, []interface{}{wantContainer}) {
+		t.Errorf("got containers %v, want %v", containers, wantContainer)
+	}
+	if _, found, _ := unstructured.NestedSlice(deployment.Object, "spec", "template", "spec", "initContainers"); found {
+		t.Errorf("the supervisord init container is not removed")
+	}
+	volumes, _, _ := unstructured.NestedSlice(deployment.Object, "spec", "template", "spec", "volumes")
+	if len(volumes) != 1 {
+		t.Errorf("got volumes %v, want only the data volume", volumes)
+	}
+
+	if clusterIP, found, _ := unstructured.NestedString(resources[2].Object, "spec", "clusterIP"); found {
+		t.Errorf("the cluster IP %q of the service is not removed", clusterIP)
+	}
+}
+
+func TestWriteHelm(t *testing.T) {
+	fs := devfilefs.NewFakeFs()
+	resources := []unstructured.Unstructured{
+		{Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata":   map[string]interface{}{"name": "nodejs-app"},
+			"spec": map[string]interface{}{"template": map[string]interface{}{"spec": map[string]interface{}{
+				"containers": []interface{}{map[string]interface{}{"name": "runtime", "image": "nodejs:14"}},
+			}}},
+		}},
+		{Object: map[string]interface{}{
+			"apiVersion": "binding.operators.coreos.com/v1alpha1",
+			"kind":       "ServiceBinding",
+			"metadata":   map[string]interface{}{"name": "nodejs-redis"},
+			"spec":       map[string]interface{}{"namingStrategy": "{{ .name | upper }}"},
+		}},
+	}
+	err := Write(resources, "nodejs", FormatHelm, "chart", fs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		file string
+		want string
+	}{
+		{file: "Chart.yaml", want: "name: nodejs"},
+		{file: "values.yaml", want: "images:\n  runtime: nodejs:14"},
+		{file: filepath.Join("templates", "deployment-nodejs-app.yaml"), want: `image: {{ index .Values.images "runtime" }}`},
+		{file: filepath.Join("templates", "servicebinding-nodejs-redis.yaml"), want: `namingStrategy: '{{"{{"}} .name | upper }}'`},
+	}
+	for _, tt := range tests {
+		data, err := fs.ReadFile(filepath.Join("chart", tt.file))
+		if err != nil {
+			t.Fatalf("unable to read %s: %v", tt.file, err)
+		}
+		if !strings.Contains(string(data), tt.want) {
+			t.Errorf("%s does not contain %q:\n%s", tt.file, tt.want, data)
+		}
+	}
+	// the resources are not modified
+	if image, _, _ := unstructured.NestedSlice(resources[0].Object, "spec", "template", "spec", "containers"); image[0].(map[string]interface{})["image"] != "nodejs:14" {
+		t.Errorf("the image of the resource has been changed")
+	}
+}
+
+func TestWriteKustomize(t *testing.T) {
+	fs := devfilefs.NewFakeFs()
+	resources := []unstructured.Unstructured{
+		{Object: map[string]interface{}{"apiVersion": "v1", "kind": "Service", "metadata": map[string]interface{}{"name": "nodejs-app"}}},
+		{Object: map[string]interface{}{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": map[string]interface{}{"name": "nodejs-app"}}},
+	}
+	err := Write(resources, "nodejs", FormatKustomize, "export", fs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	data, err := fs.ReadFile(filepath.Join("export", "kustomization.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "apiVersion: kustomize.config.k8s.io/v1beta1\nkind: Kustomization\nresources:\n- deployment-nodejs-app.yaml\n- service-nodejs-app.yaml\n"
+	if string(data) != want {
+		t.Errorf("got kustomization\n%s\nwant\n%s", data, want)
+	}
+	if _, err = fs.Stat(filepath.Join("export", "service-nodejs-app.yaml")); err != nil {
+		t.Errorf("the service is not written: %v", err)
+	}
+}
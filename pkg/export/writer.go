@@ -0,0 +1,145 @@
+package export
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	devfilefs "github.com/devfile/library/pkg/testingutil/filesystem"
+	"github.com/ghodss/yaml"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Format is the structure of the exported resources
+type Format string
+
+const (
+	// FormatKustomize writes the resources with a kustomization.yaml file listing them
+	FormatKustomize Format = "kustomize"
+	// FormatHelm writes the resources as the templates of a Helm chart, the images of the containers being values of the chart
+	FormatHelm Format = "helm"
+)
+
+// Formats are the supported formats
+var Formats = []Format{FormatKustomize, FormatHelm}
+
+// imagePlaceholder is the temporary value of the image of a container, replaced by a reference to the value of the chart
+const imagePlaceholder = "__odo_export_image_%s__"
+
+// Write writes the resources of the component in the directory, in the given format
+func Write(resources []unstructured.Unstructured, componentName string, format Format, dir string, fs devfilefs.Filesystem) error {
+	switch format {
+	case FormatKustomize:
+		return writeKustomize(resources, dir, fs)
+	case FormatHelm:
+		return writeHelm(resources, componentName, dir, fs)
+	}
+	return fmt.Errorf("unknown format %q, supported formats are %v", format, Formats)
+}
+
+func writeKustomize(resources []unstructured.Unstructured, dir string, fs devfilefs.Filesystem) error {
+	err := fs.MkdirAll(dir, 0750)
+	if err != nil {
+		return err
+	}
+	for _, r := range resources {
+		data, err := yaml.Marshal(r.Object)
+		if err != nil {
+			return err
+		}
+		err = fs.WriteFile(filepath.Join(dir, getFileName(r)), data, 0640)
+		if err != nil {
+			return err
+		}
+	}
+
+	kustomization, err := yaml.Marshal(map[string]interface{}{
+		"apiVersion": "kustomize.config.k8s.io/v1beta1",
+		"kind":       "Kustomization",
+		"resources":  getFileNames(resources),
+	})
+	if err != nil {
+		return err
+	}
+	return fs.WriteFile(filepath.Join(dir, "kustomization.yaml"), kustomization, 0640)
+}
+
+func writeHelm(resources []unstructured.Unstructured, componentName string, dir string, fs devfilefs.Filesystem) error {
+	templatesDir := filepath.Join(dir, "templates")
+	err := fs.MkdirAll(templatesDir, 0750)
+	if err != nil {
+		return err
+	}
+
+	images := map[string]interface{}{}
+	for _, r := range resources {
+		r = *r.DeepCopy()
+		if r.GetKind() == "Deployment" {
+			err = setImagePlaceholders(r, images)
+			if err != nil {
+				return err
+			}
+		}
+		data, err := yaml.Marshal(r.Object)
+		if err != nil {
+			return err
+		}
+		// the resources can contain Go templates, such as the naming strategies of the links, not to be rendered by Helm
+		template := strings.ReplaceAll(string(data), "{{", `{{"{{"}}`)
+		for name := range images {
+			template = strings.ReplaceAll(template, fmt.Sprintf(imagePlaceholder, name), fmt.Sprintf("{{ index .Values.images %q }}", name))
+		}
+		err = fs.WriteFile(filepath.Join(templatesDir, getFileName(r)), []byte(template), 0640)
+		if err != nil {
+			return err
+		}
+	}
+
+	chart, err := yaml.Marshal(map[string]interface{}{
+		"apiVersion":  "v2",
+		"name":        componentName,
+		"description": fmt.Sprintf("Helm chart of the component %s", componentName),
+		"type":        "application",
+		"version":     "0.1.0",
+	})
+	if err != nil {
+		return err
+	}
+	err = fs.WriteFile(filepath.Join(dir, "Chart.yaml"), chart, 0640)
+	if err != nil {
+		return err
+	}
+
+	values, err := yaml.Marshal(map[string]interface{}{"images": images})
+	if err != nil {
+		return err
+	}
+	return fs.WriteFile(filepath.Join(dir, "values.yaml"), values, 0640)
+}
+
+// setImagePlaceholders replaces the images of the containers of the deployment by placeholders, and records the images by container name
+func setImagePlaceholders(deployment unstructured.Unstructured, images map[string]interface{}) error {
+	for _, field := range []string{"initContainers", "containers"} {
+		containers, _, err := unstructured.NestedSlice(deployment.Object, "spec", "template", "spec", field)
+		if err != nil {
+			return err
+		}
+		for i := range containers {
+			container, ok := containers[i].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			name, _ := container["name"].(string)
+			images[name] = container["image"]
+			container["image"] = fmt.Sprintf(imagePlaceholder, name)
+		}
+		if len(containers) > 0 {
+			err = unstructured.SetNestedSlice(deployment.Object, containers, "spec", "template", "spec", field)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
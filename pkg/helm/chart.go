@@ -0,0 +1,181 @@
+package helm
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+)
+
+// libraryChartType is the type of the charts only providing named templates to other charts
+const libraryChartType = "library"
+
+// Dependency is a subchart declared in the metadata of a chart
+type Dependency struct {
+	Name      string `json:"name"`
+	Alias     string `json:"alias,omitempty"`
+	Condition string `json:"condition,omitempty"`
+}
+
+// Metadata is the content of the Chart.yaml file of a chart
+type Metadata struct {
+	APIVersion   string       `json:"apiVersion,omitempty"`
+	Name         string       `json:"name"`
+	Version      string       `json:"version"`
+	AppVersion   string       `json:"appVersion,omitempty"`
+	Description  string       `json:"description,omitempty"`
+	Type         string       `json:"type,omitempty"`
+	Dependencies []Dependency `json:"dependencies,omitempty"`
+}
+
+// File is a file of a chart
+type File struct {
+	Name string
+	Data []byte
+}
+
+// Chart is a Helm chart loaded from an archive
+type Chart struct {
+	Metadata Metadata
+	// Values are the default values of the chart, from its values.yaml file
+	Values map[string]interface{}
+	// Templates are the files of the templates directory of the chart, sorted by name
+	Templates []File
+	// Dependencies are the subcharts of the chart, from its charts directory
+	Dependencies []*Chart
+}
+
+// LoadArchive loads a chart from a gzipped tar archive, as distributed by chart repositories
+func LoadArchive(data []byte) (*Chart, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read the chart archive")
+	}
+	defer gz.Close()
+
+	files := map[string][]byte{}
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to read the chart archive")
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		// the files of the chart are in a directory named after the chart
+		name := path.Clean(strings.TrimPrefix(header.Name, "/"))
+		parts := strings.SplitN(name, "/", 2)
+		if len(parts) != 2 || strings.HasPrefix(parts[1], "../") {
+			continue
+		}
+		content, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to read the chart archive")
+		}
+		files[parts[1]] = content
+	}
+	return loadFiles(files)
+}
+
+// loadFiles loads a chart from its files, indexed by their path relative to the chart directory
+func loadFiles(files map[string][]byte) (*Chart, error) {
+	chartFile, ok := files["Chart.yaml"]
+	if !ok {
+		return nil, errors.New("invalid chart: no Chart.yaml file found")
+	}
+
+	chart := &Chart{}
+	err := yaml.Unmarshal(chartFile, &chart.Metadata)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to parse the Chart.yaml file")
+	}
+	if chart.Metadata.Name == "" {
+		return nil, errors.New("invalid chart: no name in the Chart.yaml file")
+	}
+
+	chart.Values = map[string]interface{}{}
+	if valuesFile, ok := files["values.yaml"]; ok {
+		err = yaml.Unmarshal(valuesFile, &chart.Values)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to parse the values.yaml file of chart %q", chart.Metadata.Name)
+		}
+		if chart.Values == nil {
+			chart.Values = map[string]interface{}{}
+		}
+	}
+
+	// the subcharts are either directories or archives in the charts directory
+	subchartsFiles := map[string]map[string][]byte{}
+	for name, content := range files {
+		switch {
+		case strings.HasPrefix(name, "templates/"):
+			chart.Templates = append(chart.Templates, File{Name: name, Data: content})
+		case strings.HasPrefix(name, "charts/"):
+			parts := strings.SplitN(strings.TrimPrefix(name, "charts/"), "/", 2)
+			if len(parts) == 1 {
+				if !strings.HasSuffix(parts[0], ".tgz") {
+					continue
+				}
+				subchart, err := LoadArchive(content)
+				if err != nil {
+					return nil, errors.Wrapf(err, "unable to load the subchart %q of chart %q", parts[0], chart.Metadata.Name)
+				}
+				chart.Dependencies = append(chart.Dependencies, subchart)
+				continue
+			}
+			if _, ok := subchartsFiles[parts[0]]; !ok {
+				subchartsFiles[parts[0]] = map[string][]byte{}
+			}
+			subchartsFiles[parts[0]][parts[1]] = content
+		}
+	}
+
+	for name, subchartFiles := range subchartsFiles {
+		subchart, err := loadFiles(subchartFiles)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to load the subchart %q of chart %q", name, chart.Metadata.Name)
+		}
+		chart.Dependencies = append(chart.Dependencies, subchart)
+	}
+
+	sort.Slice(chart.Templates, func(i, j int) bool {
+		return chart.Templates[i].Name < chart.Templates[j].Name
+	})
+	sort.Slice(chart.Dependencies, func(i, j int) bool {
+		return chart.Dependencies[i].Metadata.Name < chart.Dependencies[j].Metadata.Name
+	})
+	return chart, nil
+}
+
+// IsLibrary returns true if the chart only provides named templates to other charts
+func (c *Chart) IsLibrary() bool {
+	return c.Metadata.Type == libraryChartType
+}
+
+// dependency returns the declaration of the given subchart in the metadata of the chart
+func (c *Chart) dependency(subchart *Chart) Dependency {
+	for _, d := range c.Metadata.Dependencies {
+		if d.Name == subchart.Metadata.Name {
+			return d
+		}
+	}
+	return Dependency{Name: subchart.Metadata.Name}
+}
+
+// String returns the name and version of the chart, as used in the helm.sh/chart label
+func (c *Chart) String() string {
+	return fmt.Sprintf("%s-%s", c.Metadata.Name, c.Metadata.Version)
+}
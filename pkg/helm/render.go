@@ -0,0 +1,277 @@
+package helm
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+	"github.com/redhat-developer/odo/pkg/log"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Release describes the installation of a chart
+type Release struct {
+	Name      string
+	Namespace string
+}
+
+// APIVersions is the list of the API versions available on the cluster, with and without their kinds
+type APIVersions []string
+
+// Has returns true if the API version (e.g. "apps/v1") or the API version and kind (e.g. "apps/v1/Deployment") is available
+func (a APIVersions) Has(apiVersion string) bool {
+	for _, v := range a {
+		if v == apiVersion {
+			return true
+		}
+	}
+	return false
+}
+
+// KubeVersion is the version of Kubernetes of the cluster
+type KubeVersion struct {
+	Version    string
+	GitVersion string
+	Major      string
+	Minor      string
+}
+
+// Capabilities describes the cluster the chart is rendered for
+type Capabilities struct {
+	KubeVersion KubeVersion
+	APIVersions APIVersions
+}
+
+// DefaultCapabilities are the capabilities used to render the charts, as they are rendered without accessing the cluster
+var DefaultCapabilities = Capabilities{
+	KubeVersion: KubeVersion{
+		Version:    "v1.20.0",
+		GitVersion: "v1.20.0",
+		Major:      "1",
+		Minor:      "20",
+	},
+	APIVersions: APIVersions{
+		"v1",
+		"apps/v1",
+		"batch/v1",
+		"batch/v1beta1",
+		"autoscaling/v1",
+		"autoscaling/v2beta1",
+		"autoscaling/v2beta2",
+		"networking.k8s.io/v1",
+		"networking.k8s.io/v1/Ingress",
+		"policy/v1beta1",
+		"rbac.authorization.k8s.io/v1",
+		"storage.k8s.io/v1",
+	},
+}
+
+// installOrder is the order in which Helm creates the resources of a release, the other kinds being created last
+var installOrder = []string{
+	"Namespace",
+	"NetworkPolicy",
+	"ResourceQuota",
+	"LimitRange",
+	"PodSecurityPolicy",
+	"PodDisruptionBudget",
+	"ServiceAccount",
+	"Secret",
+	"ConfigMap",
+	"StorageClass",
+	"PersistentVolume",
+	"PersistentVolumeClaim",
+	"CustomResourceDefinition",
+	"ClusterRole",
+	"ClusterRoleBinding",
+	"Role",
+	"RoleBinding",
+	"Service",
+	"DaemonSet",
+	"Pod",
+	"ReplicationController",
+	"ReplicaSet",
+	"Deployment",
+	"HorizontalPodAutoscaler",
+	"StatefulSet",
+	"Job",
+	"CronJob",
+	"Ingress",
+	"APIService",
+}
+
+// documentSeparator separates the YAML documents of a rendered template
+var documentSeparator = regexp.MustCompile(`(?m)^---[ \t]*$`)
+
+// renderedChart is a chart or a subchart to render, with its values
+type renderedChart struct {
+	chart  *Chart
+	values map[string]interface{}
+	// path is the path of the chart used in the names of its templates, e.g. mychart/charts/subchart
+	path string
+}
+
+// Render renders the templates of the chart and its enabled subcharts with the given values, as "helm template" does,
+// and returns the resulting manifests sorted in the order Helm would install them
+func Render(chart *Chart, release Release, values map[string]interface{}, capabilities Capabilities) ([]unstructured.Unstructured, error) {
+	charts := collectCharts(chart, coalesceValues(values, chart.Values), chart.Metadata.Name)
+
+	root := template.New(chart.Metadata.Name).Option("missingkey=zero")
+	funcs := funcMap()
+	funcs["include"] = func(name string, data interface{}) (string, error) {
+		var buf bytes.Buffer
+		if err := root.ExecuteTemplate(&buf, name, data); err != nil {
+			return "", err
+		}
+		return buf.String(), nil
+	}
+	funcs["tpl"] = func(text string, data interface{}) (string, error) {
+		t := template.New("tpl").Funcs(funcs).Option("missingkey=zero")
+		for _, existing := range root.Templates() {
+			if _, err := t.AddParseTree(existing.Name(), existing.Tree); err != nil {
+				return "", err
+			}
+		}
+		if _, err := t.New("tpl").Parse(text); err != nil {
+			return "", err
+		}
+		var buf bytes.Buffer
+		if err := t.ExecuteTemplate(&buf, "tpl", data); err != nil {
+			return "", err
+		}
+		return buf.String(), nil
+	}
+	// like with "helm template", the charts are rendered without accessing the cluster and lookup never finds any resource
+	lookupUsed := false
+	funcs["lookup"] = func(apiVersion, kind, namespace, name string) (map[string]interface{}, error) {
+		lookupUsed = true
+		return map[string]interface{}{}, nil
+	}
+	root.Funcs(funcs)
+
+	// parse all the templates first, as the named templates can be used across the charts
+	for _, c := range charts {
+		for _, file := range c.chart.Templates {
+			name := path.Join(c.path, file.Name)
+			if _, err := root.New(name).Parse(string(file.Data)); err != nil {
+				return nil, errors.Wrapf(err, "unable to parse the template %q", name)
+			}
+		}
+	}
+
+	var manifests []unstructured.Unstructured
+	for _, c := range charts {
+		if c.chart.IsLibrary() {
+			continue
+		}
+		data := map[string]interface{}{
+			"Values":       c.values,
+			"Release":      map[string]interface{}{"Name": release.Name, "Namespace": release.Namespace, "Service": "Helm", "IsInstall": true, "IsUpgrade": false, "Revision": 1},
+			"Chart":        chartObject(c.chart.Metadata),
+			"Capabilities": capabilities,
+		}
+		for _, file := range c.chart.Templates {
+			base := path.Base(file.Name)
+			if strings.HasPrefix(base, "_") || !isManifestFile(base) {
+				continue
+			}
+			name := path.Join(c.path, file.Name)
+			data["Template"] = map[string]interface{}{"Name": name, "BasePath": path.Join(c.path, "templates")}
+
+			var buf bytes.Buffer
+			if err := root.ExecuteTemplate(&buf, name, data); err != nil {
+				return nil, errors.Wrapf(err, "unable to render the template %q", name)
+			}
+			// like Helm, the missing values are rendered as empty strings
+			rendered := strings.Replace(buf.String(), "<no value>", "", -1)
+
+			fileManifests, err := parseManifests(rendered)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid manifest rendered by the template %q", name)
+			}
+			manifests = append(manifests, fileManifests...)
+		}
+	}
+
+	if lookupUsed {
+		log.Warningf("The chart %q looks up resources of the cluster, which are never found as the chart is rendered without accessing the cluster; please check the rendered resources", chart.Metadata.Name)
+	}
+
+	sort.SliceStable(manifests, func(i, j int) bool {
+		return kindOrder(manifests[i].GetKind()) < kindOrder(manifests[j].GetKind())
+	})
+	return manifests, nil
+}
+
+// collectCharts returns the chart and its enabled subcharts, recursively, with their values
+func collectCharts(chart *Chart, values map[string]interface{}, chartPath string) []renderedChart {
+	charts := []renderedChart{{chart: chart, values: values, path: chartPath}}
+	for _, subchart := range chart.Dependencies {
+		dependency := chart.dependency(subchart)
+		if dependency.Condition != "" && !isEnabled(values, dependency.Condition) {
+			continue
+		}
+		name := subchart.Metadata.Name
+		if dependency.Alias != "" {
+			name = dependency.Alias
+		}
+		charts = append(charts, collectCharts(subchart, subchartValues(values, name, subchart), path.Join(chartPath, "charts", subchart.Metadata.Name))...)
+	}
+	return charts
+}
+
+// chartObject returns the metadata of a chart as exposed to its templates
+func chartObject(metadata Metadata) map[string]interface{} {
+	return map[string]interface{}{
+		"Name":        metadata.Name,
+		"Version":     metadata.Version,
+		"AppVersion":  metadata.AppVersion,
+		"Description": metadata.Description,
+		"Type":        metadata.Type,
+		"APIVersion":  metadata.APIVersion,
+	}
+}
+
+// isManifestFile returns true if the template produces Kubernetes manifests
+func isManifestFile(name string) bool {
+	ext := path.Ext(name)
+	return ext == ".yaml" || ext == ".yml" || ext == ".json"
+}
+
+// parseManifests parses the YAML documents of a rendered template, ignoring the empty ones
+func parseManifests(rendered string) ([]unstructured.Unstructured, error) {
+	var manifests []unstructured.Unstructured
+	for _, document := range documentSeparator.Split(rendered, -1) {
+		if strings.TrimSpace(document) == "" {
+			continue
+		}
+		u := unstructured.Unstructured{}
+		if err := yaml.Unmarshal([]byte(document), &u.Object); err != nil {
+			return nil, err
+		}
+		if len(u.Object) == 0 {
+			// the document only contains comments
+			continue
+		}
+		if u.GetKind() == "" || u.GetName() == "" {
+			return nil, fmt.Errorf("the manifest must have a kind and a name:\n%s", document)
+		}
+		manifests = append(manifests, u)
+	}
+	return manifests, nil
+}
+
+// kindOrder returns the position of the kind in the installation order
+func kindOrder(kind string) int {
+	for i, k := range installOrder {
+		if k == kind {
+			return i
+		}
+	}
+	return len(installOrder)
+}
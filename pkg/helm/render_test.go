@@ -0,0 +1,294 @@
+package helm
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"reflect"
+	"testing"
+)
+
+// newChartArchive returns a gzipped tar archive containing the given files in a directory named after the chart
+func newChartArchive(t *testing.T, chartName string, files map[string]string) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, content := range files {
+		err := tw.WriteHeader(&tar.Header{
+			Name:     chartName + "/" + name,
+			Mode:     0644,
+			Size:     int64(len(content)),
+			Typeflag: tar.TypeReg,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = tw.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+var testChartFiles = map[string]string{
+	"Chart.yaml": `apiVersion: v2
+name: mydb
+version: 1.2.0
+appVersion: "13.4"
+dependencies:
+- name: metrics
+  condition: metrics.enabled
+`,
+	"values.yaml": `replicaCount: 1
+image:
+  repository: postgres
+  tag: "13.4"
+auth:
+  username: admin
+metrics:
+  enabled: false
+`,
+	"templates/_helpers.tpl": `{{- define "mydb.fullname" -}}
+{{- printf "%s-%s" .Release.Name .Chart.Name | trunc 63 | trimSuffix "-" -}}
+{{- end -}}
+{{- define "mydb.labels" -}}
+app.kubernetes.io/name: {{ .Chart.Name }}
+app.kubernetes.io/instance: {{ .Release.Name }}
+{{- end -}}`,
+	"templates/deployment.yaml": `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: {{ include "mydb.fullname" . }}
+  labels:
+    {{- include "mydb.labels" . | nindent 4 }}
+spec:
+  replicas: {{ .Values.replicaCount }}
+  template:
+    spec:
+      containers:
+      - name: db
+        image: {{ .Values.image.repository }}:{{ .Values.image.tag | default .Chart.AppVersion }}
+        env:
+        - name: USERNAME
+          value: {{ required "auth.username is required" .Values.auth.username | quote }}
+        - name: UNSET
+          value: "{{ .Values.unset }}"
+`,
+	"templates/service.yaml": `{{- if semverCompare ">=1.19-0" .Capabilities.KubeVersion.GitVersion }}
+# the service of the database
+apiVersion: v1
+kind: Service
+metadata:
+  name: {{ include "mydb.fullname" . }}
+spec:
+  ports:
+  - port: 5432
+{{- end }}
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: {{ include "mydb.fullname" . }}-auth
+stringData:
+  username: {{ .Values.auth.username }}
+`,
+	"templates/NOTES.txt": `Thank you for installing {{ .Chart.Name }}`,
+	"charts/metrics/Chart.yaml": `apiVersion: v2
+name: metrics
+version: 0.1.0
+`,
+	"charts/metrics/values.yaml": `port: 9187
+`,
+	"charts/metrics/templates/service.yaml": `apiVersion: v1
+kind: Service
+metadata:
+  name: {{ .Release.Name }}-metrics
+spec:
+  ports:
+  - port: {{ .Values.port }}
+`,
+}
+
+func TestLoadArchive(t *testing.T) {
+	chart, err := LoadArchive(newChartArchive(t, "mydb", testChartFiles))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if chart.String() != "mydb-1.2.0" {
+		t.Errorf("got chart %q, want %q", chart.String(), "mydb-1.2.0")
+	}
+	var templates []string
+	for _, f := range chart.Templates {
+		templates = append(templates, f.Name)
+	}
+	wantTemplates := []string{"templates/NOTES.txt", "templates/_helpers.tpl", "templates/deployment.yaml", "templates/service.yaml"}
+	if !reflect.DeepEqual(templates, wantTemplates) {
+		t.Errorf("got templates %v, want %v", templates, wantTemplates)
+	}
+	if len(chart.Dependencies) != 1 || chart.Dependencies[0].Metadata.Name != "metrics" {
+		t.Errorf("got dependencies %v, want the metrics subchart", chart.Dependencies)
+	}
+
+	_, err = LoadArchive(newChartArchive(t, "invalid", map[string]string{"values.yaml": ""}))
+	if err == nil {
+		t.Errorf("expected an error for a chart without Chart.yaml")
+	}
+}
+
+func TestRender(t *testing.T) {
+	chart, err := LoadArchive(newChartArchive(t, "mydb", testChartFiles))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name          string
+		values        map[string]interface{}
+		wantResources []string
+		wantReplicas  interface{}
+		wantUsername  string
+		wantErr       bool
+	}{
+		{
+			name:          "case 1: default values",
+			values:        map[string]interface{}{},
+			wantResources: []string{"Secret/db-mydb-auth", "Service/db-mydb", "Deployment/db-mydb"},
+			wantReplicas:  float64(1),
+			wantUsername:  "admin",
+		},
+		{
+			name: "case 2: overridden values and enabled subchart",
+			values: map[string]interface{}{
+				"replicaCount": int64(3),
+				"auth":         map[string]interface{}{"username": "john"},
+				"metrics":      map[string]interface{}{"enabled": true, "port": int64(9000)},
+			},
+			wantResources: []string{"Secret/db-mydb-auth", "Service/db-mydb", "Service/db-metrics", "Deployment/db-mydb"},
+			wantReplicas:  float64(3),
+			wantUsername:  "john",
+		},
+		{
+			name:    "case 3: missing required value",
+			values:  map[string]interface{}{"auth": map[string]interface{}{"username": nil}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manifests, err := Render(chart, Release{Name: "db", Namespace: "myproject"}, tt.values, DefaultCapabilities)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Render() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			var resources []string
+			for _, m := range manifests {
+				resources = append(resources, m.GetKind()+"/"+m.GetName())
+			}
+			if !reflect.DeepEqual(resources, tt.wantResources) {
+				t.Errorf("got resources %v, want %v", resources, tt.wantResources)
+			}
+
+			deployment := manifests[len(manifests)-1]
+			if deployment.GetLabels()["app.kubernetes.io/instance"] != "db" {
+				t.Errorf("got labels %v, want the labels of the named template", deployment.GetLabels())
+			}
+			spec := deployment.Object["spec"].(map[string]interface{})
+			if spec["replicas"] != tt.wantReplicas {
+				t.Errorf("got %v replicas, want %v", spec["replicas"], tt.wantReplicas)
+			}
+			container := spec["template"].(map[string]interface{})["spec"].(map[string]interface{})["containers"].([]interface{})[0].(map[string]interface{})
+			if container["image"] != "postgres:13.4" {
+				t.Errorf("got image %v, want %v", container["image"], "postgres:13.4")
+			}
+			env := container["env"].([]interface{})
+			if value := env[0].(map[string]interface{})["value"]; value != tt.wantUsername {
+				t.Errorf("got username %v, want %v", value, tt.wantUsername)
+			}
+			if value := env[1].(map[string]interface{})["value"]; value != "" {
+				t.Errorf("got %q for a missing value, want an empty string", value)
+			}
+		})
+	}
+}
+
+func TestRenderSprigFunctions(t *testing.T) {
+	chart, err := LoadArchive(newChartArchive(t, "funcs", map[string]string{
+		"Chart.yaml": `apiVersion: v2
+name: funcs
+version: 0.1.0
+`,
+		"values.yaml": `labels:
+  tier: db
+ports: [5432, 5432, 9187]
+`,
+		"templates/configmap.yaml": `{{- $labels := mergeOverwrite (dict "tier" "backend" "app" "funcs") .Values.labels -}}
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ .Release.Name }}-funcs
+  labels: {{- toYaml $labels | nindent 4 }}
+data:
+  ports: {{ .Values.ports | uniq | compact | join "," | quote }}
+  isMap: {{ typeIs "map[string]interface {}" .Values.labels | quote }}
+  version: {{ regexFind "[0-9]+" "v13.4" | quote }}
+  list: {{ append (list "a") "b" | join "," | quote }}
+  existing: {{ lookup "v1" "Secret" .Release.Namespace "db" | len | quote }}
+`,
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	manifests, err := Render(chart, Release{Name: "db", Namespace: "myproject"}, map[string]interface{}{}, DefaultCapabilities)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(manifests) != 1 {
+		t.Fatalf("got %d manifests, want 1", len(manifests))
+	}
+	if labels := manifests[0].GetLabels(); labels["tier"] != "db" || labels["app"] != "funcs" {
+		t.Errorf("got labels %v, want the merged labels", labels)
+	}
+	want := map[string]interface{}{"ports": "5432,9187", "isMap": "true", "version": "13", "list": "a,b", "existing": "0"}
+	if data := manifests[0].Object["data"]; !reflect.DeepEqual(data, want) {
+		t.Errorf("got data %v, want %v", data, want)
+	}
+}
+
+func TestSemverCompare(t *testing.T) {
+	tests := []struct {
+		constraint string
+		version    string
+		want       bool
+	}{
+		{constraint: ">=1.19-0", version: "v1.20.0", want: true},
+		{constraint: ">=1.19-0", version: "v1.18.3-gke.100", want: false},
+		{constraint: "<1.14-0", version: "1.20", want: false},
+		{constraint: ">=1.14-0, <1.19-0", version: "v1.16.2", want: true},
+		{constraint: "^1.2.0", version: "1.9.1", want: true},
+		{constraint: "^1.2.0", version: "2.0.0", want: false},
+		{constraint: "~1.2.0", version: "1.2.9", want: true},
+		{constraint: "~1.2.0", version: "1.3.0", want: false},
+		{constraint: "1.0.0 || >=2.0.0", version: "2.1.0", want: true},
+	}
+	semverCompare := funcMap()["semverCompare"].(func(string, string) (bool, error))
+	for _, tt := range tests {
+		got, err := semverCompare(tt.constraint, tt.version)
+		if err != nil {
+			t.Errorf("semverCompare(%q, %q) unexpected error: %v", tt.constraint, tt.version, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("semverCompare(%q, %q) = %v, want %v", tt.constraint, tt.version, got, tt.want)
+		}
+	}
+}
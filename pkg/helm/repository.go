@@ -0,0 +1,293 @@
+package helm
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+	"github.com/redhat-developer/odo/pkg/util"
+	"k8s.io/klog"
+)
+
+const (
+	// indexFileName is the name of the index file of a chart repository
+	indexFileName = "index.yaml"
+
+	// repositoryConfigEnv overrides the path of the file containing the chart repositories configured in Helm
+	repositoryConfigEnv = "HELM_REPOSITORY_CONFIG"
+	// repositoryCacheEnv overrides the path of the directory containing the cached indexes and charts of Helm
+	repositoryCacheEnv = "HELM_REPOSITORY_CACHE"
+
+	// configHomeEnv and cacheHomeEnv override the base directories of the configuration and of the cache of Helm
+	configHomeEnv = "HELM_CONFIG_HOME"
+	cacheHomeEnv  = "HELM_CACHE_HOME"
+	// xdgConfigHomeEnv and xdgCacheHomeEnv are the XDG base directories, containing the helm directories when set
+	xdgConfigHomeEnv = "XDG_CONFIG_HOME"
+	xdgCacheHomeEnv  = "XDG_CACHE_HOME"
+)
+
+// RepositoryEntry is a chart repository configured in Helm with "helm repo add"
+type RepositoryEntry struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// repositoryFile is the file containing the chart repositories configured in Helm
+type repositoryFile struct {
+	Repositories []RepositoryEntry `json:"repositories"`
+}
+
+// ChartVersion is a version of a chart listed in the index of a chart repository
+type ChartVersion struct {
+	Name        string   `json:"name"`
+	Version     string   `json:"version"`
+	AppVersion  string   `json:"appVersion,omitempty"`
+	Description string   `json:"description,omitempty"`
+	URLs        []string `json:"urls"`
+}
+
+// IndexFile is the index of a chart repository
+type IndexFile struct {
+	APIVersion string                    `json:"apiVersion"`
+	Entries    map[string][]ChartVersion `json:"entries"`
+}
+
+// Repository is a chart repository, either a local directory containing an index.yaml file,
+// or a repository configured in Helm and whose index has been cached by "helm repo update"
+type Repository struct {
+	Name  string
+	URL   string
+	Index IndexFile
+	// dir is the directory of a local repository
+	dir string
+}
+
+// repositoryConfigPath returns the path of the file containing the chart repositories configured in Helm
+func repositoryConfigPath() (string, error) {
+	if p := os.Getenv(repositoryConfigEnv); p != "" {
+		return p, nil
+	}
+	dir, err := helmHome(configHomeEnv, xdgConfigHomeEnv, defaultConfigHome)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "repositories.yaml"), nil
+}
+
+// repositoryCachePath returns the path of the directory containing the cached indexes and charts of Helm
+func repositoryCachePath() (string, error) {
+	if p := os.Getenv(repositoryCacheEnv); p != "" {
+		return p, nil
+	}
+	dir, err := helmHome(cacheHomeEnv, xdgCacheHomeEnv, defaultCacheHome)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "repository"), nil
+}
+
+// helmHome returns a base directory of Helm, resolved in the same order as Helm does: the Helm specific variable,
+// then the helm directory of the XDG base directory, then the helm directory of the default base directory of the platform
+func helmHome(helmEnv, xdgEnv string, defaultHome func(homeDir string) string) (string, error) {
+	if p := os.Getenv(helmEnv); p != "" {
+		return p, nil
+	}
+	if p := os.Getenv(xdgEnv); p != "" {
+		return filepath.Join(p, "helm"), nil
+	}
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(defaultHome(homeDir), "helm"), nil
+}
+
+// defaultConfigHome returns the default base directory of the configuration of Helm on the platform
+func defaultConfigHome(homeDir string) string {
+	switch runtime.GOOS {
+	case "darwin":
+		return filepath.Join(homeDir, "Library", "Preferences")
+	case "windows":
+		return os.Getenv("APPDATA")
+	default:
+		return filepath.Join(homeDir, ".config")
+	}
+}
+
+// defaultCacheHome returns the default base directory of the cache of Helm on the platform
+func defaultCacheHome(homeDir string) string {
+	switch runtime.GOOS {
+	case "darwin":
+		return filepath.Join(homeDir, "Library", "Caches")
+	case "windows":
+		return os.TempDir()
+	default:
+		return filepath.Join(homeDir, ".cache")
+	}
+}
+
+// GetRepository returns the chart repository of the given name
+// name is either the path of a local directory containing an index.yaml file, or the name of a repository configured in Helm
+func GetRepository(name string) (*Repository, error) {
+	if util.CheckPathExists(filepath.Join(name, indexFileName)) {
+		dir, err := filepath.Abs(name)
+		if err != nil {
+			return nil, err
+		}
+		index, err := loadIndexFile(filepath.Join(dir, indexFileName))
+		if err != nil {
+			return nil, err
+		}
+		return &Repository{
+			Name:  name,
+			URL:   (&url.URL{Scheme: "file", Path: filepath.ToSlash(dir)}).String(),
+			Index: index,
+			dir:   dir,
+		}, nil
+	}
+
+	configPath, err := repositoryConfigPath()
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadFile(configPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("no Helm chart repository named %q found: %q is not a local chart repository and no repository is configured in Helm", name, name)
+		}
+		return nil, errors.Wrapf(err, "unable to read the Helm repositories configuration %q", configPath)
+	}
+	var repositories repositoryFile
+	err = yaml.Unmarshal(data, &repositories)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to parse the Helm repositories configuration %q", configPath)
+	}
+
+	for _, entry := range repositories.Repositories {
+		if entry.Name != name {
+			continue
+		}
+		cachePath, err := repositoryCachePath()
+		if err != nil {
+			return nil, err
+		}
+		indexPath := filepath.Join(cachePath, name+"-"+indexFileName)
+		if !util.CheckPathExists(indexPath) {
+			return nil, fmt.Errorf("the index of the Helm chart repository %q is not cached, please run %q", name, "helm repo update")
+		}
+		index, err := loadIndexFile(indexPath)
+		if err != nil {
+			return nil, err
+		}
+		return &Repository{
+			Name:  name,
+			URL:   entry.URL,
+			Index: index,
+		}, nil
+	}
+	return nil, fmt.Errorf("no Helm chart repository named %q found, add it with %q", name, "helm repo add")
+}
+
+// loadIndexFile loads the index of a chart repository from the given file
+func loadIndexFile(indexPath string) (IndexFile, error) {
+	var index IndexFile
+	data, err := ioutil.ReadFile(indexPath)
+	if err != nil {
+		return index, err
+	}
+	err = yaml.Unmarshal(data, &index)
+	if err != nil {
+		return index, errors.Wrapf(err, "unable to parse the chart repository index %q", indexPath)
+	}
+	return index, nil
+}
+
+// FindChart returns the given version of the chart from the index of the repository
+// the latest stable version is returned when version is empty
+func (r *Repository) FindChart(name, version string) (ChartVersion, error) {
+	versions, ok := r.Index.Entries[name]
+	if !ok || len(versions) == 0 {
+		return ChartVersion{}, fmt.Errorf("chart %q not found in the Helm chart repository %q", name, r.Name)
+	}
+
+	if version != "" {
+		for _, v := range versions {
+			if v.Version == version || strings.TrimPrefix(v.Version, "v") == strings.TrimPrefix(version, "v") {
+				return v, nil
+			}
+		}
+		return ChartVersion{}, fmt.Errorf("version %q of chart %q not found in the Helm chart repository %q", version, name, r.Name)
+	}
+
+	values := make([]string, len(versions))
+	for i, v := range versions {
+		values[i] = v.Version
+	}
+	return versions[util.GetLatestVersion(values)], nil
+}
+
+// FetchChart returns the archive of the given chart version
+// the archive is read from a local repository or from the cache of Helm, and downloaded into the cache otherwise
+func (r *Repository) FetchChart(chart ChartVersion) ([]byte, error) {
+	if len(chart.URLs) == 0 {
+		return nil, fmt.Errorf("no URL found for version %q of chart %q", chart.Version, chart.Name)
+	}
+
+	chartURL, err := r.resolveURL(chart.URLs[0])
+	if err != nil {
+		return nil, err
+	}
+
+	if chartURL.Scheme == "file" {
+		return ioutil.ReadFile(filepath.FromSlash(chartURL.Path))
+	}
+
+	cachePath, err := repositoryCachePath()
+	if err != nil {
+		return nil, err
+	}
+	cachedChart := filepath.Join(cachePath, path.Base(chartURL.Path))
+	if data, e := ioutil.ReadFile(cachedChart); e == nil {
+		klog.V(4).Infof("using the cached chart %q", cachedChart)
+		return data, nil
+	}
+
+	data, err := util.DownloadFileInMemory(util.HTTPRequestParams{URL: chartURL.String()})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to download the chart %q", chartURL.String())
+	}
+
+	// the chart is still usable if it can't be cached
+	if e := os.MkdirAll(cachePath, 0750); e == nil {
+		if e = ioutil.WriteFile(cachedChart, data, 0640); e != nil {
+			klog.V(4).Infof("unable to cache the chart %q: %v", cachedChart, e)
+		}
+	}
+	return data, nil
+}
+
+// resolveURL resolves the URL of a chart, which can be relative to the URL of the repository
+func (r *Repository) resolveURL(chartURL string) (*url.URL, error) {
+	u, err := url.Parse(chartURL)
+	if err != nil {
+		return nil, err
+	}
+	if u.IsAbs() {
+		return u, nil
+	}
+	if r.dir != "" && !filepath.IsAbs(chartURL) {
+		return &url.URL{Scheme: "file", Path: filepath.ToSlash(filepath.Join(r.dir, filepath.FromSlash(chartURL)))}, nil
+	}
+	base, err := url.Parse(strings.TrimSuffix(r.URL, "/") + "/")
+	if err != nil {
+		return nil, err
+	}
+	return base.ResolveReference(u), nil
+}
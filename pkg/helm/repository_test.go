@@ -0,0 +1,219 @@
+package helm
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLocalRepository(t *testing.T) {
+	dir, err := ioutil.TempDir("", "helm-repo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	index := `apiVersion: v1
+entries:
+  mydb:
+  - name: mydb
+    version: 1.10.0
+    urls:
+    - mydb-1.10.0.tgz
+  - name: mydb
+    version: 2.0.0-rc.1
+    urls:
+    - mydb-2.0.0-rc.1.tgz
+  - name: mydb
+    version: 1.2.0
+    urls:
+    - charts/mydb-1.2.0.tgz
+`
+	if err = ioutil.WriteFile(filepath.Join(dir, indexFileName), []byte(index), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.MkdirAll(filepath.Join(dir, "charts"), 0700); err != nil {
+		t.Fatal(err)
+	}
+	archive := newChartArchive(t, "mydb", testChartFiles)
+	if err = ioutil.WriteFile(filepath.Join(dir, "charts", "mydb-1.2.0.tgz"), archive, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	repo, err := GetRepository(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	latest, err := repo.FindChart("mydb", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if latest.Version != "1.10.0" {
+		t.Errorf("got latest version %q, want %q", latest.Version, "1.10.0")
+	}
+
+	_, err = repo.FindChart("mydb", "3.0.0")
+	if err == nil {
+		t.Errorf("expected an error for a missing version")
+	}
+	_, err = repo.FindChart("redis", "")
+	if err == nil {
+		t.Errorf("expected an error for a missing chart")
+	}
+
+	version, err := repo.FindChart("mydb", "1.2.0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	data, err := repo.FetchChart(version)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	chart, err := LoadArchive(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if chart.Metadata.Name != "mydb" {
+		t.Errorf("got chart %q, want %q", chart.Metadata.Name, "mydb")
+	}
+}
+
+func TestGetRepositoryFromHelmConfiguration(t *testing.T) {
+	dir, err := ioutil.TempDir("", "helm-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	configPath := filepath.Join(dir, "repositories.yaml")
+	cachePath := filepath.Join(dir, "cache")
+	os.Setenv(repositoryConfigEnv, configPath)
+	defer os.Unsetenv(repositoryConfigEnv)
+	os.Setenv(repositoryCacheEnv, cachePath)
+	defer os.Unsetenv(repositoryCacheEnv)
+
+	config := `apiVersion: ""
+repositories:
+- name: bitnami
+  url: https://charts.bitnami.com/bitnami
+`
+	if err = ioutil.WriteFile(configPath, []byte(config), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = GetRepository("unknown")
+	if err == nil {
+		t.Errorf("expected an error for a repository not configured in Helm")
+	}
+	_, err = GetRepository("bitnami")
+	if err == nil {
+		t.Errorf("expected an error for a repository whose index is not cached")
+	}
+
+	if err = os.MkdirAll(cachePath, 0700); err != nil {
+		t.Fatal(err)
+	}
+	index := `apiVersion: v1
+entries:
+  postgresql:
+  - name: postgresql
+    version: 10.12.0
+    urls:
+    - https://charts.bitnami.com/bitnami/postgresql-10.12.0.tgz
+`
+	if err = ioutil.WriteFile(filepath.Join(cachePath, "bitnami-index.yaml"), []byte(index), 0600); err != nil {
+		t.Fatal(err)
+	}
+	repo, err := GetRepository("bitnami")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if repo.URL != "https://charts.bitnami.com/bitnami" {
+		t.Errorf("got URL %q, want %q", repo.URL, "https://charts.bitnami.com/bitnami")
+	}
+
+	// the chart is read from the cache of Helm when available
+	if err = ioutil.WriteFile(filepath.Join(cachePath, "postgresql-10.12.0.tgz"), newChartArchive(t, "postgresql", map[string]string{"Chart.yaml": "name: postgresql\nversion: 10.12.0\n"}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	version, err := repo.FindChart("postgresql", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	data, err := repo.FetchChart(version)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err = LoadArchive(data); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestRepositoryPaths(t *testing.T) {
+	envs := []string{repositoryConfigEnv, repositoryCacheEnv, configHomeEnv, cacheHomeEnv, xdgConfigHomeEnv, xdgCacheHomeEnv}
+	for _, env := range envs {
+		if value, ok := os.LookupEnv(env); ok {
+			defer os.Setenv(env, value)
+		} else {
+			defer os.Unsetenv(env)
+		}
+	}
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		env        map[string]string
+		wantConfig string
+		wantCache  string
+	}{
+		{
+			name:       "default directories of the platform",
+			wantConfig: filepath.Join(defaultConfigHome(homeDir), "helm", "repositories.yaml"),
+			wantCache:  filepath.Join(defaultCacheHome(homeDir), "helm", "repository"),
+		},
+		{
+			name:       "XDG base directories",
+			env:        map[string]string{xdgConfigHomeEnv: "/xdg/config", xdgCacheHomeEnv: "/xdg/cache"},
+			wantConfig: filepath.Join("/xdg/config", "helm", "repositories.yaml"),
+			wantCache:  filepath.Join("/xdg/cache", "helm", "repository"),
+		},
+		{
+			name:       "Helm base directories taking precedence over the XDG ones",
+			env:        map[string]string{xdgConfigHomeEnv: "/xdg/config", xdgCacheHomeEnv: "/xdg/cache", configHomeEnv: "/helm/config", cacheHomeEnv: "/helm/cache"},
+			wantConfig: filepath.Join("/helm/config", "repositories.yaml"),
+			wantCache:  filepath.Join("/helm/cache", "repository"),
+		},
+		{
+			name:       "repository paths taking precedence over the base directories",
+			env:        map[string]string{configHomeEnv: "/helm/config", cacheHomeEnv: "/helm/cache", repositoryConfigEnv: "/repositories.yaml", repositoryCacheEnv: "/repository"},
+			wantConfig: "/repositories.yaml",
+			wantCache:  "/repository",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, env := range envs {
+				os.Unsetenv(env)
+			}
+			for env, value := range tt.env {
+				os.Setenv(env, value)
+			}
+			configPath, err := repositoryConfigPath()
+			if err != nil {
+				t.Fatal(err)
+			}
+			cachePath, err := repositoryCachePath()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if configPath != tt.wantConfig || cachePath != tt.wantCache {
+				t.Errorf("got %q and %q, want %q and %q", configPath, cachePath, tt.wantConfig, tt.wantCache)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package helm
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// globalKey is the key of the values shared by a chart with all its subcharts
+const globalKey = "global"
+
+// ParseValues converts parameters expressed as <path>=<value>, as with "helm install --set", into values of a chart
+// the path is a dot-separated list of keys, and the value is converted into a boolean, an integer or null when possible,
+// or into a list of strings when expressed as {a,b}
+func ParseValues(params map[string]string) (map[string]interface{}, error) {
+	// process the parameters in a stable order, to report errors consistently
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	values := map[string]interface{}{}
+	for _, key := range keys {
+		parts := strings.Split(key, ".")
+		current := values
+		for i, part := range parts {
+			if part == "" {
+				return nil, fmt.Errorf("invalid parameter %q: empty key", key)
+			}
+			if i == len(parts)-1 {
+				current[part] = parseValue(params[key])
+				break
+			}
+			next, ok := current[part]
+			if !ok {
+				next = map[string]interface{}{}
+				current[part] = next
+			}
+			nextMap, ok := next.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("invalid parameter %q: %q is already set to a value", key, strings.Join(parts[:i+1], "."))
+			}
+			current = nextMap
+		}
+	}
+	return values, nil
+}
+
+// parseValue converts the value of a parameter into the type it represents
+func parseValue(value string) interface{} {
+	switch value {
+	case "true":
+		return true
+	case "false":
+		return false
+	case "null":
+		return nil
+	}
+	if i, err := strconv.ParseInt(value, 10, 64); err == nil && (value == "0" || !strings.HasPrefix(value, "0")) {
+		return i
+	}
+	if strings.HasPrefix(value, "{") && strings.HasSuffix(value, "}") {
+		list := []interface{}{}
+		for _, item := range strings.Split(strings.TrimSuffix(strings.TrimPrefix(value, "{"), "}"), ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				list = append(list, item)
+			}
+		}
+		return list
+	}
+	return value
+}
+
+// coalesceValues returns the values of dst completed with the values of src, recursively
+// a null value in dst removes the key
+func coalesceValues(dst, src map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(src)+len(dst))
+	for key, value := range src {
+		result[key] = copyValue(value)
+	}
+	for key, value := range dst {
+		if value == nil {
+			delete(result, key)
+			continue
+		}
+		dstMap, dstIsMap := value.(map[string]interface{})
+		srcMap, srcIsMap := result[key].(map[string]interface{})
+		if dstIsMap && srcIsMap {
+			result[key] = coalesceValues(dstMap, srcMap)
+			continue
+		}
+		result[key] = copyValue(value)
+	}
+	return result
+}
+
+// copyValue returns a deep copy of a value, so that the values of a chart are not modified by the rendering
+func copyValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			result[key] = copyValue(item)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = copyValue(item)
+		}
+		return result
+	default:
+		return v
+	}
+}
+
+// subchartValues returns the values of a subchart: its own default values completed with
+// the values set for it in the values of its parent, under its name, and the global values
+func subchartValues(parentValues map[string]interface{}, name string, subchart *Chart) map[string]interface{} {
+	values := map[string]interface{}{}
+	if v, ok := parentValues[name].(map[string]interface{}); ok {
+		values = v
+	}
+	values = coalesceValues(values, subchart.Values)
+
+	if global, ok := parentValues[globalKey].(map[string]interface{}); ok {
+		subchartGlobal, _ := values[globalKey].(map[string]interface{})
+		values[globalKey] = coalesceValues(global, subchartGlobal)
+	}
+	return values
+}
+
+// isEnabled evaluates the condition of a subchart, a comma-separated list of paths in the values of the parent chart;
+// the first path found determines whether the subchart is enabled, and the subchart is enabled when none is found
+func isEnabled(parentValues map[string]interface{}, condition string) bool {
+	for _, p := range strings.Split(condition, ",") {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		var current interface{} = parentValues
+		for _, key := range strings.Split(p, ".") {
+			m, ok := current.(map[string]interface{})
+			if !ok {
+				current = nil
+				break
+			}
+			current = m[key]
+		}
+		if enabled, ok := current.(bool); ok {
+			return enabled
+		}
+	}
+	return true
+}
@@ -0,0 +1,82 @@
+package helm
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseValues(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  map[string]string
+		want    map[string]interface{}
+		wantErr bool
+	}{
+		{
+			name: "case 1: typed values",
+			params: map[string]string{
+				"replicaCount":      "2",
+				"persistence":       "false",
+				"image.tag":         "13.4",
+				"image.pullSecrets": "{secret1, secret2}",
+				"nodeSelector":      "null",
+				"auth.password":     "0123",
+			},
+			want: map[string]interface{}{
+				"replicaCount": int64(2),
+				"persistence":  false,
+				"image": map[string]interface{}{
+					"tag":         "13.4",
+					"pullSecrets": []interface{}{"secret1", "secret2"},
+				},
+				"nodeSelector": nil,
+				"auth":         map[string]interface{}{"password": "0123"},
+			},
+		},
+		{
+			name:    "case 2: key set to a value and used as a parent",
+			params:  map[string]string{"image": "postgres", "image.tag": "13.4"},
+			wantErr: true,
+		},
+		{
+			name:    "case 3: empty key",
+			params:  map[string]string{"image..tag": "13.4"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseValues(tt.params)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseValues() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseValues() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCoalesceValues(t *testing.T) {
+	defaults := map[string]interface{}{
+		"replicaCount": 1,
+		"image":        map[string]interface{}{"repository": "postgres", "tag": "13"},
+		"nodeSelector": map[string]interface{}{"disk": "ssd"},
+	}
+	values := map[string]interface{}{
+		"image":        map[string]interface{}{"tag": "13.4"},
+		"nodeSelector": nil,
+	}
+	want := map[string]interface{}{
+		"replicaCount": 1,
+		"image":        map[string]interface{}{"repository": "postgres", "tag": "13.4"},
+	}
+
+	got := coalesceValues(values, defaults)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("coalesceValues() = %v, want %v", got, want)
+	}
+	if defaults["image"].(map[string]interface{})["tag"] != "13" {
+		t.Errorf("coalesceValues() modified the default values")
+	}
+}
@@ -0,0 +1,54 @@
+package kclient
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GetConfigMap returns the ConfigMap of the given name in the given namespace
+func (c *Client) GetConfigMap(name, namespace string) (*corev1.ConfigMap, error) {
+	configMap, err := c.KubeClient.CoreV1().ConfigMaps(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to get the configmap %s", name)
+	}
+	return configMap, nil
+}
+
+// ListConfigMaps lists the ConfigMaps of the current namespace matching the given label selector
+func (c *Client) ListConfigMaps(labelSelector string) ([]corev1.ConfigMap, error) {
+	configMapList, err := c.KubeClient.CoreV1().ConfigMaps(c.Namespace).List(context.TODO(), metav1.ListOptions{LabelSelector: labelSelector})
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get configmap list")
+	}
+	return configMapList.Items, nil
+}
+
+// CreateConfigMap creates the given ConfigMap in the current namespace
+func (c *Client) CreateConfigMap(configMap *corev1.ConfigMap) (*corev1.ConfigMap, error) {
+	created, err := c.KubeClient.CoreV1().ConfigMaps(c.Namespace).Create(context.TODO(), configMap, metav1.CreateOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to create the configmap %s", configMap.Name)
+	}
+	return created, nil
+}
+
+// UpdateConfigMap updates the given ConfigMap in the current namespace
+func (c *Client) UpdateConfigMap(configMap *corev1.ConfigMap) (*corev1.ConfigMap, error) {
+	updated, err := c.KubeClient.CoreV1().ConfigMaps(c.Namespace).Update(context.TODO(), configMap, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to update the configmap %s", configMap.Name)
+	}
+	return updated, nil
+}
+
+// DeleteConfigMap deletes the ConfigMap of the given name in the current namespace
+func (c *Client) DeleteConfigMap(name string) error {
+	err := c.KubeClient.CoreV1().ConfigMaps(c.Namespace).Delete(context.TODO(), name, metav1.DeleteOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "unable to delete the configmap %s", name)
+	}
+	return nil
+}
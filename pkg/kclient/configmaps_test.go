@@ -0,0 +1,107 @@
+package kclient
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetConfigMap(t *testing.T) {
+	tests := []struct {
+		name       string
+		configMaps []corev1.ConfigMap
+		want       map[string]string
+		wantErr    bool
+	}{
+		{
+			name: "Case 1: configmap exists",
+			configMaps: []corev1.ConfigMap{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "db-config", Namespace: "default"},
+					Data:       map[string]string{"host": "db.example.com"},
+				},
+			},
+			want: map[string]string{"host": "db.example.com"},
+		},
+		{
+			name:    "Case 2: configmap doesn't exist",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient, fakeClientSet := FakeNew()
+			for i := range tt.configMaps {
+				_, err := fakeClientSet.Kubernetes.CoreV1().ConfigMaps("default").Create(context.TODO(), &tt.configMaps[i], metav1.CreateOptions{})
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			got, err := fakeClient.GetConfigMap("db-config", "default")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetConfigMap() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if !kerrors.IsNotFound(err) {
+					t.Errorf("GetConfigMap() error = %v, want a not found error", err)
+				}
+				return
+			}
+			if got.Data["host"] != tt.want["host"] {
+				t.Errorf("GetConfigMap() data = %v, want %v", got.Data, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfigMapsCRUD(t *testing.T) {
+	fakeClient, _ := FakeNew()
+	fakeClient.Namespace = "default"
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "db-release", Labels: map[string]string{"odo.dev/helm-release": "db"}},
+		Data:       map[string]string{"chart": "bitnami/postgresql@10.2.0"},
+	}
+	_, err := fakeClient.CreateConfigMap(configMap)
+	if err != nil {
+		t.Fatalf("CreateConfigMap() unexpected error: %v", err)
+	}
+	_, err = fakeClient.CreateConfigMap(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "other"}})
+	if err != nil {
+		t.Fatalf("CreateConfigMap() unexpected error: %v", err)
+	}
+
+	list, err := fakeClient.ListConfigMaps("odo.dev/helm-release")
+	if err != nil {
+		t.Fatalf("ListConfigMaps() unexpected error: %v", err)
+	}
+	if len(list) != 1 || list[0].Name != "db-release" {
+		t.Fatalf("ListConfigMaps() = %v, want only the db-release configmap", list)
+	}
+
+	configMap.Data["chart"] = "bitnami/postgresql@10.3.0"
+	_, err = fakeClient.UpdateConfigMap(configMap)
+	if err != nil {
+		t.Fatalf("UpdateConfigMap() unexpected error: %v", err)
+	}
+	got, err := fakeClient.GetConfigMap("db-release", "default")
+	if err != nil {
+		t.Fatalf("GetConfigMap() unexpected error: %v", err)
+	}
+	if got.Data["chart"] != "bitnami/postgresql@10.3.0" {
+		t.Errorf("UpdateConfigMap() data = %v, want the updated chart", got.Data)
+	}
+
+	err = fakeClient.DeleteConfigMap("db-release")
+	if err != nil {
+		t.Fatalf("DeleteConfigMap() unexpected error: %v", err)
+	}
+	_, err = fakeClient.GetConfigMap("db-release", "default")
+	if !kerrors.IsNotFound(err) {
+		t.Errorf("GetConfigMap() error = %v, want a not found error after the deletion", err)
+	}
+}
@@ -28,3 +28,10 @@ type ServiceNotFoundError struct {
 func (e *ServiceNotFoundError) Error() string {
 	return fmt.Sprintf("service not found for the selector %q", e.Selector)
 }
+
+// VolumeSnapshotNotSupportedError returns an error if the CSI snapshot CRDs are not installed on the cluster
+type VolumeSnapshotNotSupportedError struct{}
+
+func (e *VolumeSnapshotNotSupportedError) Error() string {
+	return "volume snapshots are not supported by the cluster, please make sure the CSI snapshot CRDs (snapshot.storage.k8s.io) and a CSI driver supporting snapshots are installed"
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/go-openapi/spec"
@@ -26,6 +27,13 @@ import (
 
 type ClientInterface interface {
 
+	// configmaps.go
+	GetConfigMap(name, namespace string) (*corev1.ConfigMap, error)
+	ListConfigMaps(labelSelector string) ([]corev1.ConfigMap, error)
+	CreateConfigMap(configMap *corev1.ConfigMap) (*corev1.ConfigMap, error)
+	UpdateConfigMap(configMap *corev1.ConfigMap) (*corev1.ConfigMap, error)
+	DeleteConfigMap(name string) error
+
 	// deployment.go
 	GetDeploymentByName(name string) (*appsv1.Deployment, error)
 	GetOneDeployment(componentName, appName string) (*appsv1.Deployment, error)
@@ -97,6 +105,7 @@ type ClientInterface interface {
 	GetCSVWithCR(name string) (*olm.ClusterServiceVersion, error)
 	GetResourceSpecDefinition(group, version, kind string) (*spec.Schema, error)
 	GetCRDSpec(cr *olm.CRDDescription, resourceType string, resourceName string) (*spec.Schema, error)
+	GetCRDSchema(group, version, resource string) (*spec.Schema, error)
 	GetRestMappingFromUnstructured(unstructured.Unstructured) (*meta.RESTMapping, error)
 	GetOperatorGVRList() ([]meta.RESTMapping, error)
 
@@ -108,6 +117,8 @@ type ClientInterface interface {
 	GetPodUsingComponentName(componentName string) (*corev1.Pod, error)
 	GetOnePodFromSelector(selector string) (*corev1.Pod, error)
 	GetPodLogs(podName, containerName string, followLog bool) (io.ReadCloser, error)
+	CreatePod(pod corev1.Pod) (*corev1.Pod, error)
+	DeletePod(podName string) error
 
 	// projects.go
 	CreateNewProject(projectName string, wait bool) error
@@ -144,6 +155,12 @@ type ClientInterface interface {
 	GetOneService(componentName, appName string) (*corev1.Service, error)
 	GetOneServiceFromSelector(selector string) (*corev1.Service, error)
 
+	// snapshots.go
+	IsVolumeSnapshotSupported() (bool, error)
+	CreateVolumeSnapshot(name, pvcName, snapshotClassName string, labels map[string]string) (*unstructured.Unstructured, error)
+	GetVolumeSnapshot(name string) (*unstructured.Unstructured, error)
+	ListVolumeSnapshots(selector string) ([]unstructured.Unstructured, error)
+
 	// user.go
 	RunLogout(stdout io.Writer) error
 
@@ -153,6 +170,8 @@ type ClientInterface interface {
 	ListPVCs(selector string) ([]corev1.PersistentVolumeClaim, error)
 	ListPVCNames(selector string) ([]string, error)
 	GetPVCFromName(pvcName string) (*corev1.PersistentVolumeClaim, error)
+	UpdatePVCSize(pvcName string, size resource.Quantity) error
+	IsVolumeExpansionAllowed(storageClassName string) (bool, error)
 	UpdatePVCLabels(pvc *corev1.PersistentVolumeClaim, labels map[string]string) error
 	GetAndUpdateStorageOwnerReference(pvc *corev1.PersistentVolumeClaim, ownerReference ...metav1.OwnerReference) error
 	UpdateStorageOwnerReference(pvc *corev1.PersistentVolumeClaim, ownerReference ...metav1.OwnerReference) error
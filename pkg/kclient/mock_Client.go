@@ -19,6 +19,7 @@ import (
 	v11 "k8s.io/api/apps/v1"
 	v12 "k8s.io/api/core/v1"
 	meta "k8s.io/apimachinery/pkg/api/meta"
+	resource "k8s.io/apimachinery/pkg/api/resource"
 	v13 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	unstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	intstr "k8s.io/apimachinery/pkg/util/intstr"
@@ -94,6 +95,21 @@ func (mr *MockClientInterfaceMockRecorder) CollectEvents(selector, events, spinn
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CollectEvents", reflect.TypeOf((*MockClientInterface)(nil).CollectEvents), selector, events, spinner, quit)
 }
 
+// CreateConfigMap mocks base method.
+func (m *MockClientInterface) CreateConfigMap(configMap *v12.ConfigMap) (*v12.ConfigMap, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateConfigMap", configMap)
+	ret0, _ := ret[0].(*v12.ConfigMap)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateConfigMap indicates an expected call of CreateConfigMap.
+func (mr *MockClientInterfaceMockRecorder) CreateConfigMap(configMap interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateConfigMap", reflect.TypeOf((*MockClientInterface)(nil).CreateConfigMap), configMap)
+}
+
 // CreateDeployment mocks base method.
 func (m *MockClientInterface) CreateDeployment(deploy v11.Deployment) (*v11.Deployment, error) {
 	m.ctrl.T.Helper()
@@ -182,6 +198,21 @@ func (mr *MockClientInterfaceMockRecorder) CreatePVC(pvc interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePVC", reflect.TypeOf((*MockClientInterface)(nil).CreatePVC), pvc)
 }
 
+// CreatePod mocks base method.
+func (m *MockClientInterface) CreatePod(pod v12.Pod) (*v12.Pod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePod", pod)
+	ret0, _ := ret[0].(*v12.Pod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePod indicates an expected call of CreatePod.
+func (mr *MockClientInterfaceMockRecorder) CreatePod(pod interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePod", reflect.TypeOf((*MockClientInterface)(nil).CreatePod), pod)
+}
+
 // CreateRoute mocks base method.
 func (m *MockClientInterface) CreateRoute(name, serviceName string, portNumber intstr.IntOrString, labels map[string]string, secureURL bool, path string, ownerReference v13.OwnerReference) (*v10.Route, error) {
 	m.ctrl.T.Helper()
@@ -255,6 +286,21 @@ func (mr *MockClientInterfaceMockRecorder) CreateTLSSecret(tlsCertificate, tlsPr
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTLSSecret", reflect.TypeOf((*MockClientInterface)(nil).CreateTLSSecret), tlsCertificate, tlsPrivKey, objectMeta)
 }
 
+// CreateVolumeSnapshot mocks base method.
+func (m *MockClientInterface) CreateVolumeSnapshot(name, pvcName, snapshotClassName string, labels map[string]string) (*unstructured.Unstructured, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVolumeSnapshot", name, pvcName, snapshotClassName, labels)
+	ret0, _ := ret[0].(*unstructured.Unstructured)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVolumeSnapshot indicates an expected call of CreateVolumeSnapshot.
+func (mr *MockClientInterfaceMockRecorder) CreateVolumeSnapshot(name, pvcName, snapshotClassName, labels interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVolumeSnapshot", reflect.TypeOf((*MockClientInterface)(nil).CreateVolumeSnapshot), name, pvcName, snapshotClassName, labels)
+}
+
 // Delete mocks base method.
 func (m *MockClientInterface) Delete(labels map[string]string, wait bool) error {
 	m.ctrl.T.Helper()
@@ -269,6 +315,20 @@ func (mr *MockClientInterfaceMockRecorder) Delete(labels, wait interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockClientInterface)(nil).Delete), labels, wait)
 }
 
+// DeleteConfigMap mocks base method.
+func (m *MockClientInterface) DeleteConfigMap(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteConfigMap", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteConfigMap indicates an expected call of DeleteConfigMap.
+func (mr *MockClientInterfaceMockRecorder) DeleteConfigMap(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConfigMap", reflect.TypeOf((*MockClientInterface)(nil).DeleteConfigMap), name)
+}
+
 // DeleteDeployment mocks base method.
 func (m *MockClientInterface) DeleteDeployment(labels map[string]string) error {
 	m.ctrl.T.Helper()
@@ -339,6 +399,20 @@ func (mr *MockClientInterfaceMockRecorder) DeletePVC(pvcName interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePVC", reflect.TypeOf((*MockClientInterface)(nil).DeletePVC), pvcName)
 }
 
+// DeletePod mocks base method.
+func (m *MockClientInterface) DeletePod(podName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePod", podName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePod indicates an expected call of DeletePod.
+func (mr *MockClientInterfaceMockRecorder) DeletePod(podName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePod", reflect.TypeOf((*MockClientInterface)(nil).DeletePod), podName)
+}
+
 // DeleteProject mocks base method.
 func (m *MockClientInterface) DeleteProject(name string, wait bool) error {
 	m.ctrl.T.Helper()
@@ -456,6 +530,21 @@ func (mr *MockClientInterfaceMockRecorder) GetAndUpdateStorageOwnerReference(pvc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAndUpdateStorageOwnerReference", reflect.TypeOf((*MockClientInterface)(nil).GetAndUpdateStorageOwnerReference), varargs...)
 }
 
+// GetCRDSchema mocks base method.
+func (m *MockClientInterface) GetCRDSchema(group, version, resource string) (*spec.Schema, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCRDSchema", group, version, resource)
+	ret0, _ := ret[0].(*spec.Schema)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCRDSchema indicates an expected call of GetCRDSchema.
+func (mr *MockClientInterfaceMockRecorder) GetCRDSchema(group, version, resource interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCRDSchema", reflect.TypeOf((*MockClientInterface)(nil).GetCRDSchema), group, version, resource)
+}
+
 // GetCRDSpec mocks base method.
 func (m *MockClientInterface) GetCRDSpec(cr *v1alpha1.CRDDescription, resourceType, resourceName string) (*spec.Schema, error) {
 	m.ctrl.T.Helper()
@@ -543,6 +632,21 @@ func (mr *MockClientInterfaceMockRecorder) GetConfig() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfig", reflect.TypeOf((*MockClientInterface)(nil).GetConfig))
 }
 
+// GetConfigMap mocks base method.
+func (m *MockClientInterface) GetConfigMap(name, namespace string) (*v12.ConfigMap, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConfigMap", name, namespace)
+	ret0, _ := ret[0].(*v12.ConfigMap)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConfigMap indicates an expected call of GetConfigMap.
+func (mr *MockClientInterfaceMockRecorder) GetConfigMap(name, namespace interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfigMap", reflect.TypeOf((*MockClientInterface)(nil).GetConfigMap), name, namespace)
+}
+
 // GetCurrentNamespace mocks base method.
 func (m *MockClientInterface) GetCurrentNamespace() string {
 	m.ctrl.T.Helper()
@@ -1034,6 +1138,21 @@ func (mr *MockClientInterfaceMockRecorder) GetService(name interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetService", reflect.TypeOf((*MockClientInterface)(nil).GetService), name)
 }
 
+// GetVolumeSnapshot mocks base method.
+func (m *MockClientInterface) GetVolumeSnapshot(name string) (*unstructured.Unstructured, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolumeSnapshot", name)
+	ret0, _ := ret[0].(*unstructured.Unstructured)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolumeSnapshot indicates an expected call of GetVolumeSnapshot.
+func (mr *MockClientInterfaceMockRecorder) GetVolumeSnapshot(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeSnapshot", reflect.TypeOf((*MockClientInterface)(nil).GetVolumeSnapshot), name)
+}
+
 // IsCSVSupported mocks base method.
 func (m *MockClientInterface) IsCSVSupported() (bool, error) {
 	m.ctrl.T.Helper()
@@ -1138,6 +1257,36 @@ func (mr *MockClientInterfaceMockRecorder) IsServiceBindingSupported() *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsServiceBindingSupported", reflect.TypeOf((*MockClientInterface)(nil).IsServiceBindingSupported))
 }
 
+// IsVolumeExpansionAllowed mocks base method.
+func (m *MockClientInterface) IsVolumeExpansionAllowed(storageClassName string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsVolumeExpansionAllowed", storageClassName)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsVolumeExpansionAllowed indicates an expected call of IsVolumeExpansionAllowed.
+func (mr *MockClientInterfaceMockRecorder) IsVolumeExpansionAllowed(storageClassName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsVolumeExpansionAllowed", reflect.TypeOf((*MockClientInterface)(nil).IsVolumeExpansionAllowed), storageClassName)
+}
+
+// IsVolumeSnapshotSupported mocks base method.
+func (m *MockClientInterface) IsVolumeSnapshotSupported() (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsVolumeSnapshotSupported")
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsVolumeSnapshotSupported indicates an expected call of IsVolumeSnapshotSupported.
+func (mr *MockClientInterfaceMockRecorder) IsVolumeSnapshotSupported() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsVolumeSnapshotSupported", reflect.TypeOf((*MockClientInterface)(nil).IsVolumeSnapshotSupported))
+}
+
 // LinkSecret mocks base method.
 func (m *MockClientInterface) LinkSecret(secretName, componentName, applicationName string) error {
 	m.ctrl.T.Helper()
@@ -1167,6 +1316,21 @@ func (mr *MockClientInterfaceMockRecorder) ListClusterServiceVersions() *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusterServiceVersions", reflect.TypeOf((*MockClientInterface)(nil).ListClusterServiceVersions))
 }
 
+// ListConfigMaps mocks base method.
+func (m *MockClientInterface) ListConfigMaps(labelSelector string) ([]v12.ConfigMap, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListConfigMaps", labelSelector)
+	ret0, _ := ret[0].([]v12.ConfigMap)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListConfigMaps indicates an expected call of ListConfigMaps.
+func (mr *MockClientInterfaceMockRecorder) ListConfigMaps(labelSelector interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConfigMaps", reflect.TypeOf((*MockClientInterface)(nil).ListConfigMaps), labelSelector)
+}
+
 // ListDeployments mocks base method.
 func (m *MockClientInterface) ListDeployments(selector string) (*v11.DeploymentList, error) {
 	m.ctrl.T.Helper()
@@ -1302,6 +1466,21 @@ func (mr *MockClientInterfaceMockRecorder) ListServices(selector interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServices", reflect.TypeOf((*MockClientInterface)(nil).ListServices), selector)
 }
 
+// ListVolumeSnapshots mocks base method.
+func (m *MockClientInterface) ListVolumeSnapshots(selector string) ([]unstructured.Unstructured, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVolumeSnapshots", selector)
+	ret0, _ := ret[0].([]unstructured.Unstructured)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVolumeSnapshots indicates an expected call of ListVolumeSnapshots.
+func (mr *MockClientInterfaceMockRecorder) ListVolumeSnapshots(selector interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumeSnapshots", reflect.TypeOf((*MockClientInterface)(nil).ListVolumeSnapshots), selector)
+}
+
 // RunLogout mocks base method.
 func (m *MockClientInterface) RunLogout(stdout io.Writer) error {
 	m.ctrl.T.Helper()
@@ -1383,6 +1562,21 @@ func (mr *MockClientInterfaceMockRecorder) UnlinkSecret(secretName, componentNam
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlinkSecret", reflect.TypeOf((*MockClientInterface)(nil).UnlinkSecret), secretName, componentName, applicationName)
 }
 
+// UpdateConfigMap mocks base method.
+func (m *MockClientInterface) UpdateConfigMap(configMap *v12.ConfigMap) (*v12.ConfigMap, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateConfigMap", configMap)
+	ret0, _ := ret[0].(*v12.ConfigMap)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateConfigMap indicates an expected call of UpdateConfigMap.
+func (mr *MockClientInterfaceMockRecorder) UpdateConfigMap(configMap interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateConfigMap", reflect.TypeOf((*MockClientInterface)(nil).UpdateConfigMap), configMap)
+}
+
 // UpdateDeployment mocks base method.
 func (m *MockClientInterface) UpdateDeployment(deploy v11.Deployment) (*v11.Deployment, error) {
 	m.ctrl.T.Helper()
@@ -1426,6 +1620,20 @@ func (mr *MockClientInterfaceMockRecorder) UpdatePVCLabels(pvc, labels interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePVCLabels", reflect.TypeOf((*MockClientInterface)(nil).UpdatePVCLabels), pvc, labels)
 }
 
+// UpdatePVCSize mocks base method.
+func (m *MockClientInterface) UpdatePVCSize(pvcName string, size resource.Quantity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePVCSize", pvcName, size)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePVCSize indicates an expected call of UpdatePVCSize.
+func (mr *MockClientInterfaceMockRecorder) UpdatePVCSize(pvcName, size interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePVCSize", reflect.TypeOf((*MockClientInterface)(nil).UpdatePVCSize), pvcName, size)
+}
+
 // UpdateSecret mocks base method.
 func (m *MockClientInterface) UpdateSecret(secret *v12.Secret, namespace string) (*v12.Secret, error) {
 	m.ctrl.T.Helper()
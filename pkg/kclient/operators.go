@@ -318,3 +318,53 @@ func (client *Client) GetOperatorGVRList() ([]meta.RESTMapping, error) {
 	}
 	return operatorGVRList, nil
 }
+
+// GetCRDSchema returns the OpenAPI v3 schema of the given version of a custom resource, as defined by its CustomResourceDefinition,
+// or nil if the resource is not a custom resource; reading the definitions requires cluster wide permissions that the
+// user may not have, the Forbidden error being returned as is in this case
+func (c *Client) GetCRDSchema(group, version, resource string) (*spec.Schema, error) {
+	// the groups of the custom resources contain at least one dot, unlike the groups of the built-in resources (apps, batch, etc)
+	if !strings.Contains(group, ".") {
+		return nil, nil
+	}
+	gvr := schema.GroupVersionResource{Group: "apiextensions.k8s.io", Version: "v1", Resource: "customresourcedefinitions"}
+	crd, err := c.DynamicClient.Resource(gvr).Get(context.TODO(), resource+"."+group, v1.GetOptions{})
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, nil
+		}
+		if kerrors.IsForbidden(err) {
+			return nil, err
+		}
+		return nil, errors.Wrapf(err, "unable to get the definition of %q", resource+"."+group)
+	}
+	return getCRDVersionSchema(crd, version)
+}
+
+// getCRDVersionSchema returns the OpenAPI v3 schema of the given version from a CustomResourceDefinition
+func getCRDVersionSchema(crd *unstructured.Unstructured, version string) (*spec.Schema, error) {
+	versions, _, err := unstructured.NestedSlice(crd.Object, "spec", "versions")
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range versions {
+		crdVersion, ok := v.(map[string]interface{})
+		if !ok || crdVersion["name"] != version {
+			continue
+		}
+		openAPIV3Schema, found, err := unstructured.NestedMap(crdVersion, "schema", "openAPIV3Schema")
+		if err != nil || !found {
+			return nil, err
+		}
+		data, err := json.Marshal(openAPIV3Schema)
+		if err != nil {
+			return nil, err
+		}
+		result := new(spec.Schema)
+		if err = json.Unmarshal(data, result); err != nil {
+			return nil, errors.Wrapf(err, "invalid schema for the version %q of %q", version, crd.GetName())
+		}
+		return result, nil
+	}
+	return nil, fmt.Errorf("the version %q is not defined by %q", version, crd.GetName())
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/go-openapi/spec"
 	olm "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
 func TestGetResourceSpecDefinitionFromSwagger(t *testing.T) {
@@ -209,3 +210,52 @@ func TestToOpenAPISpec(t *testing.T) {
 		})
 	}
 }
+
+func TestGetCRDVersionSchema(t *testing.T) {
+	crd := &unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "redis.redis.opstreelabs.in"},
+		"spec": map[string]interface{}{
+			"versions": []interface{}{
+				map[string]interface{}{
+					"name": "v1alpha1",
+				},
+				map[string]interface{}{
+					"name": "v1beta1",
+					"schema": map[string]interface{}{
+						"openAPIV3Schema": map[string]interface{}{
+							"type": "object",
+							"properties": map[string]interface{}{
+								"spec": map[string]interface{}{
+									"type":     "object",
+									"required": []interface{}{"size"},
+									"properties": map[string]interface{}{
+										"size": map[string]interface{}{"type": "integer"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}}
+
+	got, err := getCRDVersionSchema(crd, "v1beta1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	specSchema := got.Properties["spec"]
+	if !reflect.DeepEqual(specSchema.Required, []string{"size"}) || !specSchema.Properties["size"].Type.Contains("integer") {
+		t.Errorf("got schema %v, want the schema of the version v1beta1", got)
+	}
+
+	got, err = getCRDVersionSchema(crd, "v1alpha1")
+	if err != nil || got != nil {
+		t.Errorf("got schema %v and error %v, want no schema for a version without schema", got, err)
+	}
+
+	_, err = getCRDVersionSchema(crd, "v1")
+	if err == nil {
+		t.Errorf("expected an error for an undefined version")
+	}
+}
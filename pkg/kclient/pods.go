@@ -222,6 +222,20 @@ func (c *Client) GetOnePodFromSelector(selector string) (*corev1.Pod, error) {
 	return &pods.Items[0], nil
 }
 
+// CreatePod creates the given pod in the cluster
+func (c *Client) CreatePod(pod corev1.Pod) (*corev1.Pod, error) {
+	createdPod, err := c.KubeClient.CoreV1().Pods(c.Namespace).Create(context.TODO(), &pod, metav1.CreateOptions{FieldManager: FieldManager})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to create pod %s", pod.Name)
+	}
+	return createdPod, nil
+}
+
+// DeletePod deletes the pod of the given name
+func (c *Client) DeletePod(podName string) error {
+	return c.KubeClient.CoreV1().Pods(c.Namespace).Delete(context.TODO(), podName, metav1.DeleteOptions{})
+}
+
 // GetPodLogs prints the log from pod to stdout
 func (c *Client) GetPodLogs(podName, containerName string, followLog bool) (io.ReadCloser, error) {
 
@@ -0,0 +1,105 @@
+package kclient
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog"
+)
+
+// constants for volume snapshots
+const (
+	VolumeSnapshotGroup    = "snapshot.storage.k8s.io"
+	VolumeSnapshotKind     = "VolumeSnapshot"
+	VolumeSnapshotResource = "volumesnapshots"
+)
+
+// volumeSnapshotVersions are the versions of the CSI snapshot API supported by odo, in order of preference
+var volumeSnapshotVersions = []string{"v1", "v1beta1"}
+
+// getVolumeSnapshotResource returns the GroupVersionResource of the VolumeSnapshot API served by the cluster
+// it returns a VolumeSnapshotNotSupportedError if the snapshot CRDs are not installed
+func (c *Client) getVolumeSnapshotResource() (schema.GroupVersionResource, error) {
+	for _, version := range volumeSnapshotVersions {
+		supported, err := c.IsResourceSupported(VolumeSnapshotGroup, version, VolumeSnapshotResource)
+		if err != nil {
+			return schema.GroupVersionResource{}, err
+		}
+		if supported {
+			return schema.GroupVersionResource{Group: VolumeSnapshotGroup, Version: version, Resource: VolumeSnapshotResource}, nil
+		}
+	}
+	return schema.GroupVersionResource{}, &VolumeSnapshotNotSupportedError{}
+}
+
+// IsVolumeSnapshotSupported checks if the CSI VolumeSnapshot resource is present on the cluster
+func (c *Client) IsVolumeSnapshotSupported() (bool, error) {
+	_, err := c.getVolumeSnapshotResource()
+	if err != nil {
+		if _, ok := err.(*VolumeSnapshotNotSupportedError); ok {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// CreateVolumeSnapshot creates a VolumeSnapshot of the given PVC
+// if snapshotClassName is empty, the default VolumeSnapshotClass of the cluster is used
+func (c *Client) CreateVolumeSnapshot(name, pvcName, snapshotClassName string, labels map[string]string) (*unstructured.Unstructured, error) {
+	gvr, err := c.getVolumeSnapshotResource()
+	if err != nil {
+		return nil, err
+	}
+
+	spec := map[string]interface{}{
+		"source": map[string]interface{}{
+			"persistentVolumeClaimName": pvcName,
+		},
+	}
+	if snapshotClassName != "" {
+		spec["volumeSnapshotClassName"] = snapshotClassName
+	}
+
+	snapshot := unstructured.Unstructured{}
+	snapshot.SetAPIVersion(gvr.GroupVersion().String())
+	snapshot.SetKind(VolumeSnapshotKind)
+	snapshot.SetName(name)
+	snapshot.SetNamespace(c.Namespace)
+	snapshot.SetLabels(labels)
+	snapshot.Object["spec"] = spec
+
+	klog.V(2).Infof("Creating a VolumeSnapshot with name %v for PVC %v", name, pvcName)
+	created, err := c.DynamicClient.Resource(gvr).Namespace(c.Namespace).Create(context.TODO(), &snapshot, metav1.CreateOptions{FieldManager: FieldManager})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to create VolumeSnapshot %s", name)
+	}
+	return created, nil
+}
+
+// GetVolumeSnapshot returns the VolumeSnapshot of the given name
+func (c *Client) GetVolumeSnapshot(name string) (*unstructured.Unstructured, error) {
+	gvr, err := c.getVolumeSnapshotResource()
+	if err != nil {
+		return nil, err
+	}
+	return c.DynamicClient.Resource(gvr).Namespace(c.Namespace).Get(context.TODO(), name, metav1.GetOptions{})
+}
+
+// ListVolumeSnapshots returns the VolumeSnapshots based on the given selector
+func (c *Client) ListVolumeSnapshots(selector string) ([]unstructured.Unstructured, error) {
+	gvr, err := c.getVolumeSnapshotResource()
+	if err != nil {
+		return nil, err
+	}
+	list, err := c.DynamicClient.Resource(gvr).Namespace(c.Namespace).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: selector,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to get VolumeSnapshots for selector: %v", selector)
+	}
+	return list.Items, nil
+}
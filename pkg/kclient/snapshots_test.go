@@ -0,0 +1,58 @@
+package kclient
+
+import (
+	"testing"
+
+	odoFake "github.com/redhat-developer/odo/pkg/kclient/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestIsVolumeSnapshotSupported(t *testing.T) {
+	tests := []struct {
+		name     string
+		versions []string
+		want     bool
+	}{
+		{
+			name:     "case 1: v1 snapshot API installed",
+			versions: []string{"v1"},
+			want:     true,
+		},
+		{
+			name:     "case 2: only v1beta1 snapshot API installed",
+			versions: []string{"v1beta1"},
+			want:     true,
+		},
+		{
+			name: "case 3: snapshot CRDs not installed",
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient, _ := FakeNew()
+
+			fd := odoFake.NewFakeDiscovery()
+			for _, version := range tt.versions {
+				gvr := metav1.GroupVersionResource{Group: VolumeSnapshotGroup, Version: version, Resource: VolumeSnapshotResource}
+				fd.AddResourceList(gvr.String(), &metav1.APIResourceList{
+					GroupVersion: VolumeSnapshotGroup + "/" + version,
+					APIResources: []metav1.APIResource{{
+						Name:       VolumeSnapshotResource,
+						Namespaced: true,
+						Kind:       VolumeSnapshotKind,
+					}},
+				})
+			}
+			fakeClient.SetDiscoveryInterface(fd)
+
+			got, err := fakeClient.IsVolumeSnapshotSupported()
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("IsVolumeSnapshotSupported() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
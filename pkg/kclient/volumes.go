@@ -2,11 +2,14 @@ package kclient
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/devfile/library/pkg/devfile/generator"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 // constants for volumes
@@ -61,6 +64,36 @@ func (c *Client) GetPVCFromName(pvcName string) (*corev1.PersistentVolumeClaim,
 	return c.KubeClient.CoreV1().PersistentVolumeClaims(c.Namespace).Get(context.TODO(), pvcName, metav1.GetOptions{})
 }
 
+// UpdatePVCSize requests the given size for the storage of the PVC of the given name
+func (c *Client) UpdatePVCSize(pvcName string, size resource.Quantity) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"resources": map[string]interface{}{
+				"requests": map[string]interface{}{
+					string(corev1.ResourceStorage): size.String(),
+				},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = c.KubeClient.CoreV1().PersistentVolumeClaims(c.Namespace).Patch(context.TODO(), pvcName, types.MergePatchType, patch, metav1.PatchOptions{FieldManager: FieldManager})
+	if err != nil {
+		return errors.Wrapf(err, "unable to update the size of PVC %s", pvcName)
+	}
+	return nil
+}
+
+// IsVolumeExpansionAllowed returns true if the volumes of the given storage class can be expanded
+func (c *Client) IsVolumeExpansionAllowed(storageClassName string) (bool, error) {
+	storageClass, err := c.KubeClient.StorageV1().StorageClasses().Get(context.TODO(), storageClassName, metav1.GetOptions{})
+	if err != nil {
+		return false, errors.Wrapf(err, "unable to get storage class %s", storageClassName)
+	}
+	return storageClass.AllowVolumeExpansion != nil && *storageClass.AllowVolumeExpansion, nil
+}
+
 // UpdatePVCLabels updates the given PVC with the given labels
 func (c *Client) UpdatePVCLabels(pvc *corev1.PersistentVolumeClaim, labels map[string]string) error {
 	pvc.Labels = labels
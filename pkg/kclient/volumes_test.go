@@ -10,6 +10,7 @@ import (
 	"github.com/pkg/errors"
 
 	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ktesting "k8s.io/client-go/testing"
 
@@ -402,3 +403,71 @@ func TestUpdateStorageOwnerReference(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdatePVCSize(t *testing.T) {
+	fakeClient, fakeClientSet := FakeNew()
+
+	fakeClientSet.Kubernetes.PrependReactor("patch", "persistentvolumeclaims", func(action ktesting.Action) (bool, runtime.Object, error) {
+		return true, nil, nil
+	})
+
+	err := fakeClient.UpdatePVCSize("mypvc", resource.MustParse("5Gi"))
+	if err != nil {
+		t.Errorf("client.UpdatePVCSize() unexpected error %v", err)
+	}
+
+	if len(fakeClientSet.Kubernetes.Actions()) != 1 {
+		t.Fatalf("expected 1 action in UpdatePVCSize got: %v", fakeClientSet.Kubernetes.Actions())
+	}
+	patchAction := fakeClientSet.Kubernetes.Actions()[0].(ktesting.PatchAction)
+	if patchAction.GetName() != "mypvc" {
+		t.Errorf("patch action is performed with wrong pvcName, expected: mypvc, got %s", patchAction.GetName())
+	}
+	wantPatch := `{"spec":{"resources":{"requests":{"storage":"5Gi"}}}}`
+	if string(patchAction.GetPatch()) != wantPatch {
+		t.Errorf("patch action is performed with wrong patch, expected: %s, got %s", wantPatch, string(patchAction.GetPatch()))
+	}
+}
+
+func TestIsVolumeExpansionAllowed(t *testing.T) {
+	tests := []struct {
+		name                 string
+		allowVolumeExpansion *bool
+		want                 bool
+	}{
+		{
+			name:                 "Case 1: expansion allowed",
+			allowVolumeExpansion: util.GetBoolPtr(true),
+			want:                 true,
+		},
+		{
+			name:                 "Case 2: expansion not allowed",
+			allowVolumeExpansion: util.GetBoolPtr(false),
+			want:                 false,
+		},
+		{
+			name: "Case 3: expansion not set",
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient, fakeClientSet := FakeNew()
+
+			fakeClientSet.Kubernetes.PrependReactor("get", "storageclasses", func(action ktesting.Action) (bool, runtime.Object, error) {
+				return true, &storagev1.StorageClass{
+					ObjectMeta:           metav1.ObjectMeta{Name: "standard"},
+					AllowVolumeExpansion: tt.allowVolumeExpansion,
+				}, nil
+			})
+
+			got, err := fakeClient.IsVolumeExpansionAllowed("standard")
+			if err != nil {
+				t.Errorf("client.IsVolumeExpansionAllowed() unexpected error %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("client.IsVolumeExpansionAllowed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -42,6 +42,10 @@ type LocalStorage struct {
 	Ephemeral *bool `yaml:"Ephemeral,omitempty"`
 	// Path of the storage to which it will be mounted on the container
 	Path string `yaml:"Path,omitempty"`
+	// StorageClass is the name of the storage class of the volume, the default class of the cluster is used if empty
+	StorageClass string `yaml:"StorageClass,omitempty"`
+	// AccessMode is the access mode of the volume, ReadWriteOnce is used if empty
+	AccessMode string `yaml:"AccessMode,omitempty"`
 	// Container is the container name on which this storage is mounted
 	Container string `yaml:"-" json:"-"`
 }
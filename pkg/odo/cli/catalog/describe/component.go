@@ -2,9 +2,11 @@ package describe
 
 import (
 	"fmt"
+	"io"
 	"net/url"
 	"os"
 	"path"
+	"path/filepath"
 	"strings"
 	"text/tabwriter"
 
@@ -16,6 +18,7 @@ import (
 	"github.com/redhat-developer/odo/pkg/devfile/validate"
 	"github.com/redhat-developer/odo/pkg/log"
 	"github.com/redhat-developer/odo/pkg/machineoutput"
+	registryUtil "github.com/redhat-developer/odo/pkg/odo/cli/registry/util"
 	"github.com/redhat-developer/odo/pkg/odo/cmdline"
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
 
@@ -32,10 +35,14 @@ const componentRecommendedCommandName = "component"
 
 var (
 	componentExample = ktemplates.Examples(`  # Describe a component
-    %[1]s nodejs`)
+    %[1]s nodejs
+
+    # Describe a specific version of a component
+    %[1]s java-springboot@1.2.0`)
 
 	componentLongDesc = ktemplates.LongDesc(`Describe a component type.
-This describes the component and its associated starter projects.
+This describes the component, its available versions and its associated starter projects.
+The default version of the component is described, unless a version is specified with <component type>@<version>.
 `)
 )
 
@@ -46,9 +53,12 @@ type DescribeComponentOptions struct {
 
 	// Parameters
 	componentName string
+	version       string
 
 	// devfile components with name that matches componentName
 	devfileComponents []catalog.DevfileComponentType
+	// stackVersions are the described versions of the devfile components
+	stackVersions []catalog.StackVersion
 }
 
 // NewDescribeComponentOptions creates a new DescribeComponentOptions instance
@@ -58,7 +68,7 @@ func NewDescribeComponentOptions() *DescribeComponentOptions {
 
 // Complete completes DescribeComponentOptions after they've been created
 func (o *DescribeComponentOptions) Complete(cmdline cmdline.Cmdline, args []string) (err error) {
-	o.componentName = args[0]
+	o.componentName, o.version = catalog.ParseStackName(args[0])
 
 	o.Context, err = genericclioptions.New(genericclioptions.NewCreateParameters(cmdline).IsOffline())
 	if err != nil {
@@ -73,7 +83,19 @@ func (o *DescribeComponentOptions) Complete(cmdline cmdline.Cmdline, args []stri
 		return err
 	}
 
-	o.devfileComponents = listDevfileComponentsByName(catalogDevfileList, o.componentName)
+	// only the components providing the requested version are described
+	for _, devfileComponent := range listDevfileComponentsByName(catalogDevfileList, o.componentName) {
+		stackVersion, versionErr := devfileComponent.GetStackVersion(o.version)
+		if versionErr != nil {
+			err = versionErr
+			continue
+		}
+		o.devfileComponents = append(o.devfileComponents, devfileComponent)
+		o.stackVersions = append(o.stackVersions, stackVersion)
+	}
+	if len(o.devfileComponents) == 0 && err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -89,8 +111,10 @@ func (o *DescribeComponentOptions) Validate() (err error) {
 // DevfileComponentDescription represents the JSON output of Devfile component description
 // used in odo catalog describe component <name> -o json
 type DevfileComponentDescription struct {
-	RegistryName string           `json:"RegistryName"`
-	Devfile      data.DevfileData `json:"Devfile"`
+	RegistryName string                 `json:"RegistryName"`
+	Version      string                 `json:"Version,omitempty"`
+	Versions     []catalog.StackVersion `json:"Versions,omitempty"`
+	Devfile      data.DevfileData       `json:"Devfile"`
 }
 
 // Run contains the logic for the command associated with DescribeComponentOptions
@@ -100,12 +124,17 @@ func (o *DescribeComponentOptions) Run() (err error) {
 		if len(o.devfileComponents) > 0 {
 			out := []DevfileComponentDescription{}
 
-			for _, devfileComponent := range o.devfileComponents {
-				devObj, err := GetDevfile(devfileComponent)
+			for i, devfileComponent := range o.devfileComponents {
+				devObj, err := GetDevfile(devfileComponent, o.stackVersions[i])
 				if err != nil {
 					return err
 				}
-				out = append(out, DevfileComponentDescription{RegistryName: devfileComponent.Registry.Name, Devfile: devObj.Data})
+				out = append(out, DevfileComponentDescription{
+					RegistryName: devfileComponent.Registry.Name,
+					Version:      o.stackVersions[i].Version,
+					Versions:     devfileComponent.Versions,
+					Devfile:      devObj.Data,
+				})
 			}
 			machineoutput.OutputSuccess(out)
 		}
@@ -116,10 +145,11 @@ func (o *DescribeComponentOptions) Run() (err error) {
 		if len(o.devfileComponents) > 0 {
 			fmt.Fprintln(w, "Devfile Component(s):")
 
-			for _, devfileComponent := range o.devfileComponents {
+			for i, devfileComponent := range o.devfileComponents {
 				fmt.Fprintln(w, "\n* Registry: "+devfileComponent.Registry.Name)
+				printStackVersions(w, devfileComponent, o.stackVersions[i])
 
-				devObj, err := GetDevfile(devfileComponent)
+				devObj, err := GetDevfile(devfileComponent, o.stackVersions[i])
 				if err != nil {
 					return err
 				}
@@ -146,7 +176,7 @@ func (o *DescribeComponentOptions) Run() (err error) {
 func NewCmdCatalogDescribeComponent(name, fullName string) *cobra.Command {
 	o := NewDescribeComponentOptions()
 	command := &cobra.Command{
-		Use:         name,
+		Use:         fmt.Sprintf("%s <component type>[@<version>]", name),
 		Short:       "Describe a component",
 		Long:        componentLongDesc,
 		Example:     fmt.Sprintf(componentExample, fullName),
@@ -171,9 +201,29 @@ func listDevfileComponentsByName(catalogDevfileList catalog.DevfileComponentType
 	return components
 }
 
-// GetDevfile downloads the devfile in memory and return the devfile object
-func GetDevfile(devfileComponent catalog.DevfileComponentType) (parser.DevfileObj, error) {
-	devObj, err := getDevFileNoValidation(devfileComponent)
+// printStackVersions prints the versions of the devfile component, and the described version
+func printStackVersions(w io.Writer, devfileComponent catalog.DevfileComponentType, stackVersion catalog.StackVersion) {
+	if len(devfileComponent.Versions) == 0 {
+		if stackVersion.Version != "" {
+			fmt.Fprintln(w, "  Version: "+stackVersion.Version)
+		}
+		return
+	}
+	var versions []string
+	for _, version := range devfileComponent.Versions {
+		if version.Version == devfileComponent.Version {
+			versions = append(versions, version.Version+" (default)")
+		} else {
+			versions = append(versions, version.Version)
+		}
+	}
+	fmt.Fprintln(w, "  Available versions: "+strings.Join(versions, ", "))
+	fmt.Fprintln(w, "  Described version: "+stackVersion.Version)
+}
+
+// GetDevfile downloads the version of the devfile in memory and return the devfile object
+func GetDevfile(devfileComponent catalog.DevfileComponentType, stackVersion catalog.StackVersion) (parser.DevfileObj, error) {
+	devObj, err := getDevFileNoValidation(devfileComponent, stackVersion)
 	if err != nil {
 		return devObj, err
 	}
@@ -185,9 +235,14 @@ func GetDevfile(devfileComponent catalog.DevfileComponentType) (parser.DevfileOb
 	return devObj, nil
 }
 
-func getDevFileNoValidation(devfileComponent catalog.DevfileComponentType) (parser.DevfileObj, error) {
+func getDevFileNoValidation(devfileComponent catalog.DevfileComponentType, stackVersion catalog.StackVersion) (parser.DevfileObj, error) {
+	if registryUtil.IsFileBasedRegistry(devfileComponent.Registry.URL) {
+		devfilePath := filepath.Join(catalog.GetFileRegistryStackDir(devfileComponent.Registry, devfileComponent.Name, stackVersion.Version), "devfile.yaml")
+		devObj, err := devfile.ParseAndValidateFromFile(devfilePath)
+		return devObj, errors.Wrapf(err, "Failed to read devfile.yaml from file-based registry for devfile component: %s", devfileComponent.Name)
+	}
 	if strings.Contains(devfileComponent.Registry.URL, "github") {
-		devObj, err := devfile.ParseAndValidateFromURL(devfileComponent.Registry.URL + devfileComponent.Link)
+		devObj, err := devfile.ParseAndValidateFromURL(devfileComponent.Registry.URL + stackVersion.Link)
 		return devObj, errors.Wrapf(err, "Failed to download devfile.yaml from Github-based registry for devfile component: %s", devfileComponent.Name)
 	}
 	registryURL, err := url.Parse(devfileComponent.Registry.URL)
@@ -195,6 +250,9 @@ func getDevFileNoValidation(devfileComponent catalog.DevfileComponentType) (pars
 		return parser.DevfileObj{}, errors.Wrapf(err, "Failed to parse registry URL for devfile component: %s", devfileComponent.Name)
 	}
 	registryURL.Path = path.Join(registryURL.Path, "devfiles", devfileComponent.Name)
+	if !stackVersion.Default {
+		registryURL.Path = path.Join(registryURL.Path, stackVersion.Version)
+	}
 	devObj, err := devfile.ParseAndValidateFromURL(registryURL.String())
 	return devObj, errors.Wrapf(err, "Failed to download devfile.yaml from OCI-based registry for devfile component: %s", devfileComponent.Name)
 }
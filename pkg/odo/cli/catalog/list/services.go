@@ -19,7 +19,10 @@ import (
 const servicesRecommendedCommandName = "services"
 
 var servicesExample = `  # Get the supported services
-  %[1]s`
+  %[1]s
+
+  # Get the service templates stored locally or provided by the registries
+  %[1]s --templates`
 
 // ServiceOptions encapsulates the options for the odo catalog list services command
 type ServiceOptions struct {
@@ -28,6 +31,11 @@ type ServiceOptions struct {
 
 	// list of clusterserviceversions (installed by Operators)
 	csvs *olm.ClusterServiceVersionList
+	// list of service templates
+	templates []catalog.ServiceTemplate
+
+	// Flags
+	templatesFlag bool
 }
 
 // NewServiceOptions creates a new ListServicesOptions instance
@@ -37,6 +45,16 @@ func NewServiceOptions() *ServiceOptions {
 
 // Complete completes ListServicesOptions after they've been created
 func (o *ServiceOptions) Complete(cmdline cmdline.Cmdline, args []string) (err error) {
+	if o.templatesFlag {
+		// the templates are not stored on the cluster
+		o.Context, err = genericclioptions.New(genericclioptions.NewCreateParameters(cmdline).IsOffline())
+		if err != nil {
+			return err
+		}
+		o.templates, err = catalog.ListServiceTemplates()
+		return err
+	}
+
 	o.Context, err = genericclioptions.New(genericclioptions.NewCreateParameters(cmdline))
 	if err != nil {
 		return err
@@ -56,6 +74,17 @@ func (o *ServiceOptions) Validate() error {
 
 // Run contains the logic for the command associated with ListServicesOptions
 func (o *ServiceOptions) Run() error {
+	if o.templatesFlag {
+		if log.IsJSON() {
+			machineoutput.OutputSuccess(newCatalogListTemplatesOutput(o.templates))
+		} else if len(o.templates) == 0 {
+			log.Info("no service templates found")
+		} else {
+			util.DisplayServiceTemplates(o.templates)
+		}
+		return nil
+	}
+
 	if log.IsJSON() {
 		machineoutput.OutputSuccess(newCatalogListOutput(o.csvs))
 	} else {
@@ -74,7 +103,7 @@ func (o *ServiceOptions) Run() error {
 // NewCmdCatalogListServices implements the odo catalog list services command
 func NewCmdCatalogListServices(name, fullName string) *cobra.Command {
 	o := NewServiceOptions()
-	return &cobra.Command{
+	servicesCmd := &cobra.Command{
 		Use:         name,
 		Short:       "Lists all available services",
 		Long:        "Lists all available services",
@@ -85,6 +114,8 @@ func NewCmdCatalogListServices(name, fullName string) *cobra.Command {
 			genericclioptions.GenericRun(o, cmd, args)
 		},
 	}
+	servicesCmd.Flags().BoolVar(&o.templatesFlag, "templates", false, "List the service templates stored locally and provided by the registries, to use with \"odo service create --template\"")
+	return servicesCmd
 }
 
 type catalogListOutput struct {
@@ -92,6 +123,8 @@ type catalogListOutput struct {
 	v1.ObjectMeta `json:"metadata,omitempty"`
 	// list of clusterserviceversions (installed by Operators)
 	Operators *olm.ClusterServiceVersionList `json:"operators,omitempty"`
+	// list of service templates
+	Templates []catalog.ServiceTemplate `json:"templates,omitempty"`
 }
 
 func newCatalogListOutput(operators *olm.ClusterServiceVersionList) catalogListOutput {
@@ -103,3 +136,13 @@ func newCatalogListOutput(operators *olm.ClusterServiceVersionList) catalogListO
 		Operators: operators,
 	}
 }
+
+func newCatalogListTemplatesOutput(templates []catalog.ServiceTemplate) catalogListOutput {
+	return catalogListOutput{
+		TypeMeta: v1.TypeMeta{
+			Kind:       "List",
+			APIVersion: machineoutput.APIVersion,
+		},
+		Templates: templates,
+	}
+}
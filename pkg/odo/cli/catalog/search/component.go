@@ -2,30 +2,56 @@ package search
 
 import (
 	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
 
+	"github.com/pkg/errors"
 	"github.com/redhat-developer/odo/pkg/catalog"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/machineoutput"
 	"github.com/redhat-developer/odo/pkg/odo/cmdline"
-
-	"github.com/redhat-developer/odo/pkg/odo/cli/catalog/util"
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/util"
 	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
 )
 
 const componentRecommendedCommandName = "component"
 
-var componentExample = `  # Search for a component
-  %[1]s python`
+var componentLongDesc = ktemplates.LongDesc(`Search component type in catalog.
+
+This searches the components of all the configured registries for the given search term. The name, display name,
+tags and description of the components are searched, and the components are listed from the most relevant to the
+least relevant. Small typos and abbreviations in the search term are tolerated.
+
+The components can be filtered by language, tag, project type and registry, in which case the search term is optional.`)
+
+var componentExample = ktemplates.Examples(`  # Search for a component
+  %[1]s python
+
+  # Search for a Java component using Maven
+  %[1]s --language java --project-type maven
+
+  # Search for a component with the tags Python and Django in the DefaultDevfileRegistry registry
+  %[1]s web --tag python --tag django --registry DefaultDevfileRegistry`)
 
-// SearchComponentOptions encapsulates the options for the odo catalog describe service command
+// SearchComponentOptions encapsulates the options for the odo catalog search component command
 type SearchComponentOptions struct {
-	// Context
-	*genericclioptions.Context
+	// No context needed
 
 	// Parameters
 	searchTerm string
 
+	// Flags
+	languageFlag    string
+	tagFlag         []string
+	projectTypeFlag string
+	registryFlag    string
+
 	// components matching the search query
-	components []string
+	components []catalog.ComponentSearchResult
 }
 
 // NewSearchComponentOptions creates a new SearchComponentOptions instance
@@ -35,46 +61,98 @@ func NewSearchComponentOptions() *SearchComponentOptions {
 
 // Complete completes SearchComponentOptions after they've been created
 func (o *SearchComponentOptions) Complete(cmdline cmdline.Cmdline, args []string) (err error) {
-	o.Context, err = genericclioptions.New(genericclioptions.NewCreateParameters(cmdline))
-	if err != nil {
-		return err
+	if len(args) == 1 {
+		o.searchTerm = args[0]
+	}
+	if o.searchTerm == "" && o.languageFlag == "" && len(o.tagFlag) == 0 && o.projectTypeFlag == "" && o.registryFlag == "" {
+		return errors.New("please provide a search term or at least one of --language, --tag, --project-type or --registry")
 	}
-	o.searchTerm = args[0]
 
-	o.components, err = catalog.SearchComponent(o.KClient, o.searchTerm)
+	o.components, err = catalog.SearchComponent(o.searchTerm, catalog.ComponentSearchFilter{
+		Registry:    o.registryFlag,
+		Language:    o.languageFlag,
+		Tags:        o.tagFlag,
+		ProjectType: o.projectTypeFlag,
+	})
 	return err
 }
 
 // Validate validates the SearchComponentOptions based on completed values
 func (o *SearchComponentOptions) Validate() error {
-	if len(o.components) == 0 {
-		return fmt.Errorf("no component matched the query: %s", o.searchTerm)
+	if len(o.components) == 0 && !log.IsJSON() {
+		return fmt.Errorf("no component matched the query: %s", o.query())
 	}
 
 	return nil
 }
 
+type searchResultList struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Items             []catalog.ComponentSearchResult `json:"items"`
+}
+
 // Run contains the logic for the command associated with SearchComponentOptions
 func (o *SearchComponentOptions) Run() error {
-	util.DisplayComponents(o.components)
+	if log.IsJSON() {
+		machineoutput.OutputSuccess(searchResultList{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "List",
+				APIVersion: "odo.dev/v1alpha1",
+			},
+			Items: o.components,
+		})
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 5, 2, 3, ' ', tabwriter.TabIndent)
+	fmt.Fprintln(w, "NAME", "\t", "DESCRIPTION", "\t", "REGISTRY", "\t", "LANGUAGE")
+	for _, component := range o.components {
+		fmt.Fprintln(w, component.Name, "\t", util.TruncateString(component.Description, 60, "..."), "\t", component.Registry.Name, "\t", component.Language)
+	}
+	w.Flush()
 	return nil
 }
 
+// query returns the search term and the filters, for the messages of the command
+func (o *SearchComponentOptions) query() string {
+	query := []string{}
+	if o.searchTerm != "" {
+		query = append(query, o.searchTerm)
+	}
+	if o.languageFlag != "" {
+		query = append(query, "language="+o.languageFlag)
+	}
+	for _, tag := range o.tagFlag {
+		query = append(query, "tag="+tag)
+	}
+	if o.projectTypeFlag != "" {
+		query = append(query, "project-type="+o.projectTypeFlag)
+	}
+	if o.registryFlag != "" {
+		query = append(query, "registry="+o.registryFlag)
+	}
+	return strings.Join(query, " ")
+}
+
 // NewCmdCatalogSearchComponent implements the odo catalog search component command
 func NewCmdCatalogSearchComponent(name, fullName string) *cobra.Command {
 	o := NewSearchComponentOptions()
-	return &cobra.Command{
-		Use:   name,
-		Short: "Search component type in catalog",
-		Long: `Search component type in catalog.
-
-This searches for a partial match for the given search term in all the available
-components.
-`,
-		Args:    cobra.ExactArgs(1),
-		Example: fmt.Sprintf(componentExample, fullName),
+	componentSearchCmd := &cobra.Command{
+		Use:         fmt.Sprintf("%s [search term]", name),
+		Short:       "Search component type in catalog",
+		Long:        componentLongDesc,
+		Args:        cobra.MaximumNArgs(1),
+		Example:     fmt.Sprintf(componentExample, fullName),
+		Annotations: map[string]string{"machineoutput": "json"},
 		Run: func(cmd *cobra.Command, args []string) {
 			genericclioptions.GenericRun(o, cmd, args)
 		},
 	}
+	componentSearchCmd.Flags().StringVar(&o.languageFlag, "language", "", "Search the components of the given language")
+	componentSearchCmd.Flags().StringSliceVar(&o.tagFlag, "tag", []string{}, "Search the components with the given tag, can be specified multiple times")
+	componentSearchCmd.Flags().StringVar(&o.projectTypeFlag, "project-type", "", "Search the components of the given project type")
+	componentSearchCmd.Flags().StringVar(&o.registryFlag, "registry", "", "Search the components of the given registry")
+
+	return componentSearchCmd
 }
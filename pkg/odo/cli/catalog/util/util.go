@@ -23,16 +23,6 @@ func DisplayServices(services catalog.ServiceTypeList) {
 	w.Flush()
 }
 
-// DisplayComponents displays the specified  components
-func DisplayComponents(components []string) {
-	w := tabwriter.NewWriter(os.Stdout, 5, 2, 3, ' ', tabwriter.TabIndent)
-	fmt.Fprintln(w, "NAME")
-	for _, component := range components {
-		fmt.Fprintln(w, component)
-	}
-	w.Flush()
-}
-
 // DisplayClusterServiceVersions displays installed Operators in a human friendly manner
 func DisplayClusterServiceVersions(csvs *olm.ClusterServiceVersionList) {
 	w := tabwriter.NewWriter(os.Stdout, 5, 2, 3, ' ', tabwriter.TabIndent)
@@ -52,3 +42,18 @@ func CsvOperators(crds olm.CustomResourceDefinitions) string {
 	}
 	return strings.Join(crdsSlice, ", ")
 }
+
+// DisplayServiceTemplates displays the service templates in a human friendly manner
+func DisplayServiceTemplates(templates []catalog.ServiceTemplate) {
+	w := tabwriter.NewWriter(os.Stdout, 5, 2, 3, ' ', tabwriter.TabIndent)
+	log.Info("Service templates")
+	fmt.Fprintln(w, "NAME", "\t", "KIND", "\t", "PARAMETERS", "\t", "DESCRIPTION")
+	for _, t := range templates {
+		params := make([]string, 0, len(t.Parameters))
+		for _, p := range t.Parameters {
+			params = append(params, p.Name)
+		}
+		fmt.Fprintln(w, t.FullName(), "\t", t.Kind(), "\t", strings.Join(params, ", "), "\t", t.Description)
+	}
+	w.Flush()
+}
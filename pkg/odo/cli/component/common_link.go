@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	componentlabels "github.com/redhat-developer/odo/pkg/component/labels"
 	"github.com/redhat-developer/odo/pkg/devfile"
 	"github.com/redhat-developer/odo/pkg/kclient"
 	"github.com/redhat-developer/odo/pkg/log"
@@ -27,8 +28,10 @@ const unlink = "unlink"
 type commonLinkOptions struct {
 	secretName       string
 	isTargetAService bool
-	name             string
-	bindAsFiles      bool
+	// isTargetADirectResource indicates that the target is a plain Secret or ConfigMap, linked without an operator
+	isTargetADirectResource bool
+	name                    string
+	bindAsFiles             bool
 
 	devfilePath string
 
@@ -45,6 +48,8 @@ type commonLinkOptions struct {
 	csvSupport bool
 
 	inlined bool
+	// refresh indicates to re-run the binding of an existing link
+	refresh bool
 	// mappings is an array of strings representing the custom binding data that user wants to inject into the component
 	mappings []string
 }
@@ -57,6 +62,8 @@ func (o *commonLinkOptions) getLinkType() string {
 	linkType := "component"
 	if o.isTargetAService {
 		linkType = "service"
+	} else if o.isTargetADirectResource {
+		linkType = strings.ToLower(o.serviceType)
 	}
 	return linkType
 }
@@ -92,6 +99,10 @@ func (o *commonLinkOptions) complete(cmdline cmdline.Cmdline, args []string, con
 			return err
 		}
 		o.serviceName = s.Name
+	} else if kind, ok := svc.GetDirectLinkKind(o.serviceType); ok {
+		// a plain Secret or ConfigMap, e.g. representing an external service
+		o.serviceType = kind
+		o.isTargetADirectResource = true
 	} else {
 		o.isTargetAService = true
 	}
@@ -155,12 +166,16 @@ func (o *commonLinkOptions) complete(cmdline cmdline.Cmdline, args []string, con
 			},
 		}
 	} else {
+		kind := "Service"
+		if o.isTargetADirectResource {
+			kind = o.serviceType
+		}
 		service = servicebinding.Service{
 			Id: &o.serviceName, // Id field is helpful if user wants to inject mappings (custom binding data)
 			NamespacedRef: servicebinding.NamespacedRef{
 				Ref: servicebinding.Ref{
 					Version: "v1",
-					Kind:    "Service",
+					Kind:    kind,
 					Name:    o.serviceName,
 				},
 			},
@@ -211,6 +226,14 @@ func (o *commonLinkOptions) validate() (err error) {
 		if !svcExists {
 			return fmt.Errorf("couldn't find service named %q. Refer %q to see list of running services", svcFullName, "odo service list")
 		}
+	} else if o.isTargetADirectResource {
+		svcFullName = strings.Join([]string{o.serviceType, o.serviceName}, "/")
+		if o.operationName != unlink {
+			err = o.validateDirectResourceExists()
+			if err != nil {
+				return err
+			}
+		}
 	} else {
 		svcFullName = o.serviceName
 		if o.suppliedName == o.EnvSpecificInfo.GetName() {
@@ -222,16 +245,20 @@ func (o *commonLinkOptions) validate() (err error) {
 		}
 	}
 
-	if o.operationName == unlink {
+	if o.operationName == unlink || o.refresh {
 		_, found, err := svc.FindDevfileServiceBinding(o.EnvSpecificInfo.GetDevfileObj(), o.serviceType, o.serviceName, o.GetComponentContext())
 		if err != nil {
 			return err
 		}
 		if !found {
-			if o.getLinkType() == "service" {
-				return fmt.Errorf("failed to unlink the %s %q since no link was found in the configuration referring this %s", o.getLinkType(), svcFullName, o.getLinkType())
+			action := "unlink"
+			if o.refresh {
+				action = "refresh the link to"
+			}
+			if o.getLinkType() != "component" {
+				return fmt.Errorf("failed to %s the %s %q since no link was found in the configuration referring this %s", action, o.getLinkType(), svcFullName, o.getLinkType())
 			}
-			return fmt.Errorf("failed to unlink the %s %q since no link was found in the configuration referring this %s", o.getLinkType(), o.suppliedName, o.getLinkType())
+			return fmt.Errorf("failed to %s the %s %q since no link was found in the configuration referring this %s", action, o.getLinkType(), o.suppliedName, o.getLinkType())
 		}
 		return nil
 	}
@@ -239,11 +266,29 @@ func (o *commonLinkOptions) validate() (err error) {
 	return nil
 }
 
+// validateDirectResourceExists checks that the Secret or ConfigMap to link exists in the current project
+func (o *commonLinkOptions) validateDirectResourceExists() (err error) {
+	namespace := o.KClient.GetCurrentNamespace()
+	switch o.serviceType {
+	case svc.SecretKind:
+		_, err = o.KClient.GetSecret(o.serviceName, namespace)
+	case svc.ConfigMapKind:
+		_, err = o.KClient.GetConfigMap(o.serviceName, namespace)
+	}
+	if kerrors.IsNotFound(err) {
+		return fmt.Errorf("couldn't find %s named %q in the project %q", strings.ToLower(o.serviceType), o.serviceName, namespace)
+	}
+	return err
+}
+
 func (o *commonLinkOptions) run() (err error) {
 	if o.Context.EnvSpecificInfo != nil {
 		if o.operationName == unlink {
 			return o.unlinkOperator()
 		}
+		if o.refresh {
+			return o.refreshLink()
+		}
 		return o.linkOperator()
 	}
 
@@ -314,7 +359,7 @@ func (o *commonLinkOptions) getServiceBindingName(componentName string) string {
 	if len(o.name) > 0 {
 		return o.name
 	}
-	if !o.isTargetAService {
+	if !o.isTargetAService && !o.isTargetADirectResource {
 		return strings.Join([]string{componentName, o.serviceName}, "-")
 	}
 	return strings.Join([]string{componentName, strings.ToLower(o.serviceType), o.serviceName}, "-")
@@ -388,3 +433,38 @@ func (o *commonLinkOptions) unlinkOperator() (err error) {
 	log.Italic("To apply the changes, please use `odo push`")
 	return nil
 }
+
+// refreshLink re-runs the binding of the link, so that the component gets the current state of the service
+func (o *commonLinkOptions) refreshLink() (err error) {
+	linkComponent, err := svc.FindDevfileLinkComponent(o.EnvSpecificInfo.GetDevfileObj(), o.serviceType, o.serviceName, o.GetComponentContext())
+	if err != nil {
+		return err
+	}
+
+	componentName := o.EnvSpecificInfo.GetName()
+	deployment, err := o.KClient.GetOneDeployment(componentName, o.EnvSpecificInfo.GetApplication())
+	if err != nil {
+		return err
+	}
+
+	labels := componentlabels.GetLabels(componentName, o.EnvSpecificInfo.GetApplication(), true)
+	refreshed, err := svc.RefreshLink(o.KClient, linkComponent, labels, deployment, o.GetComponentContext())
+	if err != nil {
+		return err
+	}
+	if !refreshed {
+		log.Infof("The link between component %q and %s %q is kept in sync with the service by the Service Binding Operator, no refresh is required", componentName, o.getLinkType(), o.suppliedName)
+		return nil
+	}
+
+	s := log.Spinner("Restarting the component")
+	defer s.End(false)
+	_, err = o.KClient.WaitForDeploymentRollout(deployment.Name)
+	if err != nil {
+		return err
+	}
+	s.End(true)
+
+	log.Successf("Successfully refreshed the link between component %q and %s %q", componentName, o.getLinkType(), o.suppliedName)
+	return nil
+}
@@ -53,6 +53,8 @@ func NewCmdComponent(name, fullName string) *cobra.Command {
 	testCmd := NewCmdTest(TestRecommendedCommandName, odoutil.GetFullName(fullName, TestRecommendedCommandName))
 	execCmd := NewCmdExec(ExecRecommendedCommandName, odoutil.GetFullName(fullName, ExecRecommendedCommandName))
 	statusCmd := NewCmdStatus(StatusRecommendedCommandName, odoutil.GetFullName(fullName, StatusRecommendedCommandName))
+	importCmd := NewCmdImport(ImportRecommendedCommandName, odoutil.GetFullName(fullName, ImportRecommendedCommandName))
+	upgradeCmd := NewCmdUpgrade(UpgradeRecommendedCommandName, odoutil.GetFullName(fullName, UpgradeRecommendedCommandName))
 
 	// componentCmd represents the component command
 	var componentCmd = &cobra.Command{
@@ -69,7 +71,7 @@ func NewCmdComponent(name, fullName string) *cobra.Command {
 	componentCmd.Flags().AddFlagSet(componentGetCmd.Flags())
 
 	componentCmd.AddCommand(componentGetCmd, createCmd, deleteCmd, describeCmd, linkCmd, unlinkCmd, listCmd, logCmd, pushCmd, watchCmd, execCmd)
-	componentCmd.AddCommand(testCmd, statusCmd)
+	componentCmd.AddCommand(testCmd, statusCmd, importCmd, upgradeCmd)
 
 	// Add a defined annotation in order to appear in the help menu
 	componentCmd.Annotations = map[string]string{"command": "main"}
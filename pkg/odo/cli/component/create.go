@@ -11,7 +11,6 @@ import (
 	"github.com/redhat-developer/odo/pkg/odo/cmdline"
 	"github.com/redhat-developer/odo/pkg/preference"
 	"github.com/redhat-developer/odo/pkg/project"
-	"github.com/zalando/go-keyring"
 
 	"github.com/devfile/library/pkg/devfile"
 	"github.com/devfile/library/pkg/devfile/parser"
@@ -44,6 +43,9 @@ type CreateOptions struct {
 	envFlag     []string
 	nowFlag     bool
 	appFlag     string
+	autoFlag    bool
+	// insecureSkipVerifyFlag skips the verification of the checksums and signatures of the stack and the starter project
+	insecureSkipVerifyFlag bool
 
 	interactive bool
 
@@ -63,16 +65,18 @@ type devfilePath struct {
 
 // DevfileMetadata includes devfile component metadata
 type DevfileMetadata struct {
-	componentType      string
-	componentName      string
-	componentNamespace string
-	devfileLink        string
-	devfileRegistry    catalog.Registry
-	devfilePath        devfilePath
-	userCreatedDevfile bool
-	starter            string
-	token              string
-	starterToken       string
+	componentType       string
+	componentName       string
+	componentNamespace  string
+	stackVersion        catalog.StackVersion
+	devfileRegistry     catalog.Registry
+	devfilePath         devfilePath
+	userCreatedDevfile  bool
+	starter             string
+	starterParams       []string
+	token               string
+	starterToken        string
+	registryCredentials util.HTTPCredentials
 }
 
 // CreateRecommendedCommandName is the recommended watch command name
@@ -99,9 +103,18 @@ var createExample = ktemplates.Examples(`# Create a new Node.JS component with e
 odo catalog list components
 %[1]s java-quarkus
 
+# Create a new Java Spring Boot component from a specific version of the stack
+%[1]s java-springboot@1.2.0
+
+# Create a new component from the stack matching the existing source code
+%[1]s --auto
+
 # Download an example devfile and application before deploying
 %[1]s nodejs --starter
 
+# Download a starter project declaring parameters, and set the values of its parameters
+%[1]s java-maven --starter springbootproject --starter-param artifactId=orders --starter-param packageName=com.example.orders
+
 # Using a specific devfile
 %[1]s mynodejs --devfile ./devfile.yaml
 %[1]s mynodejs --devfile https://raw.githubusercontent.com/odo-devfiles/registry/master/devfiles/nodejs/devfile.yaml
@@ -141,8 +154,8 @@ func (co *CreateOptions) Complete(cmdline cmdline.Cmdline, args []string) (err e
 	envFilePath := getEnvFilePath(co.contextFlag)
 	// This is required so that .odo is created in the correct context
 	co.PushOptions.componentContext = co.contextFlag
-	// Use Interactive mode if: 1) no args are passed || 2) the devfile exists || 3) --devfile is used
-	if len(args) == 0 && !util.CheckPathExists(co.DevfilePath) && co.devfileMetadata.devfilePath.value == "" {
+	// Use Interactive mode if: 1) no args are passed || 2) the devfile exists || 3) --devfile is used || 4) --auto is used
+	if len(args) == 0 && !util.CheckPathExists(co.DevfilePath) && co.devfileMetadata.devfilePath.value == "" && !co.autoFlag {
 		co.interactive = true
 	}
 	// CONFLICT CHECK
@@ -164,6 +177,11 @@ func (co *CreateOptions) Complete(cmdline cmdline.Cmdline, args []string) (err e
 		return errors.New("this directory already contains a devfile, you can't specify devfile via --devfile")
 	}
 
+	//Check if the component type can be detected when --auto flag is passed
+	if co.autoFlag && (co.devfileMetadata.userCreatedDevfile || co.devfileMetadata.devfilePath.value != "") {
+		return errors.New("the component type can't be detected with --auto when a devfile is used")
+	}
+
 	// Initialize envinfo
 	err = co.InitEnvInfoFromContext()
 	if err != nil {
@@ -186,6 +204,8 @@ func (co *CreateOptions) Complete(cmdline cmdline.Cmdline, args []string) (err e
 		} else if urlErr == nil {
 			co.createMethod = HTTPCreateMethod{}
 		}
+	case co.autoFlag:
+		co.createMethod = AutoDetectCreateMethod{}
 	case co.interactive:
 		co.createMethod = InteractiveCreateMethod{}
 	default:
@@ -207,16 +227,11 @@ func (co *CreateOptions) Complete(cmdline cmdline.Cmdline, args []string) (err e
 			co.createMethod.Rollback(co.DevfilePath, co.contextFlag)
 		}
 	}()
-	// Set the starter project token if required
-	if co.devfileMetadata.starter != "" {
-		secure := registryUtil.IsSecure(co.prefClient, co.devfileMetadata.devfileRegistry.Name)
-		if co.devfileMetadata.starterToken == "" && secure {
-			var token string
-			token, err = keyring.Get(fmt.Sprintf("%s%s", util.CredentialPrefix, co.devfileMetadata.devfileRegistry.Name), registryUtil.RegistryUser)
-			if err != nil {
-				return errors.Wrap(err, "unable to get secure registry credential from keyring")
-			}
-			co.devfileMetadata.starterToken = token
+	// Set the credentials of the registry if required, they are only used for the starter projects hosted by the registry host
+	if co.devfileMetadata.starterToken == "" && (co.devfileMetadata.starter != "" || co.interactive) {
+		co.devfileMetadata.registryCredentials, err = registryUtil.GetRegistryCredentials(co.prefClient, co.devfileMetadata.devfileRegistry.Name, co.devfileMetadata.devfileRegistry.URL)
+		if err != nil {
+			return err
 		}
 	}
 
@@ -234,6 +249,14 @@ func (co *CreateOptions) Validate() (err error) {
 		}
 	}()
 	log.Info("Validation")
+	// Validate the parameters of the starter project
+	if len(co.devfileMetadata.starterParams) > 0 && co.devfileMetadata.starter == "" && !co.interactive {
+		return errors.New("the parameters of the starter project can only be passed with --starter-param when a starter project is downloaded with --starter")
+	}
+	if _, err = parseStarterParams(co.devfileMetadata.starterParams); err != nil {
+		return err
+	}
+
 	// Validate if the devfile component name that user wants to create adheres to the k8s naming convention
 	spinner := log.Spinner("Validating if devfile name is correct")
 	defer spinner.End(false)
@@ -274,7 +297,15 @@ func (co *CreateOptions) Run() (err error) {
 		return err
 	}
 	// WARN: Starter Project uses go-git that overrides the directory content, there by deleting the existing devfile.
-	err = decideAndDownloadStarterProject(devObj, co.devfileMetadata.starter, co.devfileMetadata.starterToken, co.interactive, co.contextFlag)
+	starterParams, err := parseStarterParams(co.devfileMetadata.starterParams)
+	if err != nil {
+		return err
+	}
+	var starterChecksums map[string]string
+	if !co.insecureSkipVerifyFlag {
+		starterChecksums = co.devfileMetadata.stackVersion.StarterProjectChecksums
+	}
+	err = decideAndDownloadStarterProject(devObj, co.devfileMetadata.starter, co.devfileMetadata.registryCredentials, co.devfileMetadata.starterToken, starterChecksums, co.interactive, co.contextFlag, co.devfileMetadata.devfileRegistry, co.devfileMetadata.componentType, starterParams)
 	if err != nil {
 		return errors.Wrap(err, "failed to download project for devfile component")
 	}
@@ -305,6 +336,7 @@ func (co *CreateOptions) Run() (err error) {
 		Project:            co.devfileMetadata.componentNamespace,
 		AppName:            co.appFlag,
 		UserCreatedDevfile: co.devfileMetadata.userCreatedDevfile,
+		Stack:              co.getStack(),
 	})
 	if err != nil {
 		return errors.Wrap(err, "failed to create env file for devfile component")
@@ -352,7 +384,7 @@ func NewCmdCreate(name, fullName string) *cobra.Command {
 	}
 	co := NewCreateOptions(project.NewClient(kubclient), prefClient)
 	var componentCreateCmd = &cobra.Command{
-		Use:         fmt.Sprintf("%s <component_type> [component_name] [flags]", name),
+		Use:         fmt.Sprintf("%s <component_type>[@<version>] [component_name] [flags]", name),
 		Short:       "Create a new component",
 		Long:        createLongDesc,
 		Example:     fmt.Sprintf(createExample, fullName),
@@ -367,10 +399,13 @@ func NewCmdCreate(name, fullName string) *cobra.Command {
 	componentCreateCmd.Flags().StringSliceVar(&co.envFlag, "env", []string{}, "Environmental variables for the component. For example --env VariableName=Value")
 
 	componentCreateCmd.Flags().StringVar(&co.devfileMetadata.starter, "starter", "", "Download a project specified in the devfile")
+	componentCreateCmd.Flags().StringArrayVar(&co.devfileMetadata.starterParams, "starter-param", []string{}, "Parameter of the starter project, expressed as <key>=<value>, can be specified multiple times")
 	componentCreateCmd.Flags().StringVar(&co.devfileMetadata.devfileRegistry.Name, "registry", "", "Create devfile component from specific registry")
 	componentCreateCmd.Flags().StringVar(&co.devfileMetadata.devfilePath.value, "devfile", "", "Path to the user specified devfile")
 	componentCreateCmd.Flags().StringVar(&co.devfileMetadata.token, "token", "", "Token to be used when downloading devfile from the devfile path that is specified via --devfile")
 	componentCreateCmd.Flags().StringVar(&co.devfileMetadata.starterToken, "starter-token", "", "Token to be used when downloading starter project")
+	componentCreateCmd.Flags().BoolVar(&co.autoFlag, "auto", false, "Create the component from the stack matching the source code in the context directory, the only argument being the component name")
+	componentCreateCmd.Flags().BoolVar(&co.insecureSkipVerifyFlag, "insecure-skip-verify", false, "Skip the verification of the checksums and signatures of the stack and the starter project listed in the index of the registry")
 	componentCreateCmd.SetFlagErrorFunc(func(command *cobra.Command, err error) error {
 		if strings.Contains(err.Error(), "flag needs an argument: --starter") {
 			return fmt.Errorf("%w: you can get the list of possible values with the command `odo catalog describe component <type>`", err)
@@ -1,18 +1,29 @@
 package component
 
 import (
+	"path/filepath"
+	"strings"
+
 	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	"github.com/devfile/library/pkg/devfile/parser"
 	parsercommon "github.com/devfile/library/pkg/devfile/parser/data/v2/common"
+	"github.com/pkg/errors"
+	"github.com/redhat-developer/odo/pkg/catalog"
 	"github.com/redhat-developer/odo/pkg/component"
 	"github.com/redhat-developer/odo/pkg/envinfo"
 	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/log"
 	"github.com/redhat-developer/odo/pkg/machineoutput"
+	"github.com/redhat-developer/odo/pkg/odo/cli/component/ui"
+	"github.com/redhat-developer/odo/pkg/util"
 )
 
 // decideAndDownloadStarterProject decides the starter project from the value passed by the user and
-// downloads it
-func decideAndDownloadStarterProject(devObj parser.DevfileObj, projectPassed string, token string, interactive bool, contextDir string) error {
+// downloads it, from the archive of the project when the stack comes from a mirrored registry;
+// the parameters of the starter project are then substituted; the archive of the starter project is verified
+// against its checksum in checksums, indexed by the names of the starter projects; the starter project is downloaded
+// with the token passed by the user when set, otherwise with the credentials of the registry when it is hosted by the registry host
+func decideAndDownloadStarterProject(devObj parser.DevfileObj, projectPassed string, registryCredentials util.HTTPCredentials, starterToken string, checksums map[string]string, interactive bool, contextDir string, registry catalog.Registry, stackName string, starterParams map[string]string) error {
 	if projectPassed == "" && !interactive {
 		return nil
 	}
@@ -37,7 +48,85 @@ func decideAndDownloadStarterProject(devObj parser.DevfileObj, projectPassed str
 		return nil
 	}
 
-	return component.DownloadStarterProject(starterProject, token, contextDir)
+	starterProject = catalog.UseMirroredStarterProject(registry, stackName, starterProject)
+	credentials := util.HTTPCredentials{Token: starterToken}
+	if starterToken == "" {
+		credentials = component.GetStarterProjectCredentials(starterProject, registry.URL, registryCredentials)
+	}
+	err = component.DownloadStarterProject(starterProject, credentials, checksums[starterProject.Name], contextDir)
+	if err != nil {
+		return withSkipVerifyHint(err)
+	}
+	return renderStarterProject(contextDir, starterParams, interactive)
+}
+
+// withSkipVerifyHint suggests the --insecure-skip-verify flag when the error is a verification failure; the message
+// of the error is flattened, as only the cause of the errors is displayed to the user
+func withSkipVerifyHint(err error) error {
+	if _, ok := errors.Cause(err).(*util.VerificationError); ok {
+		return errors.Errorf("%v, use --insecure-skip-verify to skip the verification", err)
+	}
+	return err
+}
+
+// renderStarterProject substitutes the parameters of the starter project downloaded in contextDir,
+// the user being prompted for the values of the parameters not passed with --starter-param in interactive mode
+func renderStarterProject(contextDir string, starterParams map[string]string, interactive bool) error {
+	if contextDir == "" {
+		contextDir = LocalDirectoryDefaultLocation
+	}
+	contextDir, err := filepath.Abs(contextDir)
+	if err != nil {
+		return err
+	}
+	parameters, err := component.GetStarterParameters(contextDir)
+	if err != nil {
+		return err
+	}
+	if len(parameters) == 0 {
+		if len(starterParams) > 0 {
+			log.Warning("The starter project has no parameters, the values passed with --starter-param are ignored")
+		}
+		return nil
+	}
+
+	values := make(map[string]string, len(parameters))
+	for name, value := range starterParams {
+		values[name] = value
+	}
+	if interactive {
+		for _, parameter := range parameters {
+			if _, ok := values[parameter.Name]; !ok {
+				values[parameter.Name] = ui.EnterStarterParameter(parameter)
+			}
+		}
+	}
+	values, err = component.ResolveStarterParameters(parameters, values)
+	if err != nil {
+		return err
+	}
+
+	spinner := log.Spinner("Substituting the parameters of the starter project")
+	defer spinner.End(false)
+	err = component.RenderStarterProject(contextDir, values)
+	if err != nil {
+		return errors.Wrap(err, "unable to substitute the parameters of the starter project")
+	}
+	spinner.End(true)
+	return nil
+}
+
+// parseStarterParams parses the values of the parameters of the starter project passed as key=value with --starter-param
+func parseStarterParams(starterParams []string) (map[string]string, error) {
+	values := make(map[string]string, len(starterParams))
+	for _, param := range starterParams {
+		kv := strings.SplitN(param, "=", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return nil, errors.Errorf("invalid starter parameter %q, the parameters of the starter project must be passed as key=value", param)
+		}
+		values[kv[0]] = kv[1]
+	}
+	return values, nil
 }
 
 // DevfileJSON creates the full json description of a devfile component is prints it
@@ -60,3 +149,16 @@ func (co *CreateOptions) DevfileJSON() error {
 	machineoutput.OutputSuccess(cfd.GetComponent())
 	return nil
 }
+
+// getStack returns the registry stack the devfile of the component is created from, nil if the devfile
+// is not downloaded from a registry
+func (co *CreateOptions) getStack() *envinfo.Stack {
+	if co.devfileMetadata.devfileRegistry.URL == "" {
+		return nil
+	}
+	return &envinfo.Stack{
+		Registry: co.devfileMetadata.devfileRegistry.Name,
+		Name:     co.devfileMetadata.componentType,
+		Version:  co.devfileMetadata.stackVersion.Version,
+	}
+}
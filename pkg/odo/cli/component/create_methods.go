@@ -5,15 +5,13 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/redhat-developer/odo/pkg/kclient"
 
-	"github.com/redhat-developer/odo/pkg/segment"
-
 	"github.com/redhat-developer/odo/pkg/odo/cli/component/ui"
 	"github.com/redhat-developer/odo/pkg/odo/cmdline"
 
-	registryLibrary "github.com/devfile/registry-support/registry-library/library"
 	"github.com/pkg/errors"
 	"github.com/redhat-developer/odo/pkg/catalog"
 	"github.com/redhat-developer/odo/pkg/component"
@@ -21,7 +19,6 @@ import (
 	registryUtil "github.com/redhat-developer/odo/pkg/odo/cli/registry/util"
 	"github.com/redhat-developer/odo/pkg/preference"
 	"github.com/redhat-developer/odo/pkg/util"
-	"github.com/zalando/go-keyring"
 	"k8s.io/klog"
 )
 
@@ -49,8 +46,15 @@ func (icm InteractiveCreateMethod) FetchDevfileAndCreateComponent(co *CreateOpti
 	}
 
 	//SET METADATA
-	// Component type: We provide devfile component list to let user choose
-	componentType := ui.SelectDevfileComponentType(catalogDevfileList.Items)
+	// Component type: We provide devfile component list to let user choose, proposing the stack matching the source code
+	var detectedComponentType string
+	detectedStacks, err := detectStacks(co.contextFlag, catalogDevfileList)
+	if err != nil {
+		klog.V(4).Infof("unable to detect the stack matching the source code: %v", err)
+	} else if len(detectedStacks) > 0 {
+		detectedComponentType = detectedStacks[0].Name
+	}
+	componentType := ui.SelectDevfileComponentType(catalogDevfileList.Items, detectedComponentType)
 
 	// Component name: User needs to specify the component name, by default it is component type that user chooses
 	componentName := ui.EnterDevfileComponentName(componentType)
@@ -84,11 +88,20 @@ func (icm InteractiveCreateMethod) FetchDevfileAndCreateComponent(co *CreateOpti
 	co.devfileMetadata.componentName = componentName
 	co.devfileMetadata.componentNamespace = componentNamespace
 
-	co.devfileMetadata.devfileLink, co.devfileMetadata.devfileRegistry, err = findDevfileFromRegistry(catalogDevfileList, co.devfileMetadata.devfileRegistry.Name, co.devfileMetadata.componentType)
+	devfileComponent, stackVersion, err := findDevfileFromRegistry(catalogDevfileList, co.devfileMetadata.devfileRegistry.Name, co.devfileMetadata.componentType, "")
 	if err != nil {
 		return err
 	}
-	return fetchDevfileFromRegistry(co.devfileMetadata.devfileRegistry, co.devfileMetadata.devfileLink, co.DevfilePath, co.devfileMetadata.componentType, co.contextFlag, co.prefClient)
+	// Stack version: User needs to choose the version of the stack when the registry lists multiple versions
+	if len(devfileComponent.Versions) > 1 {
+		stackVersion, err = devfileComponent.GetStackVersion(ui.SelectStackVersion(devfileComponent.Versions, stackVersion.Version))
+		if err != nil {
+			return err
+		}
+	}
+	co.devfileMetadata.devfileRegistry = devfileComponent.Registry
+	co.devfileMetadata.stackVersion = stackVersion
+	return fetchDevfileFromRegistry(co.devfileMetadata.devfileRegistry, co.devfileMetadata.stackVersion, co.DevfilePath, co.devfileMetadata.componentType, co.insecureSkipVerifyFlag)
 }
 
 func (icm InteractiveCreateMethod) Rollback(devfile, componentContext string) {
@@ -109,9 +122,10 @@ func (dcm DirectCreateMethod) FetchDevfileAndCreateComponent(co *CreateOptions,
 		return err
 	}
 	// SET METADATA
-	// The first argument passed will always be considered as component type
-	co.devfileMetadata.componentType = args[0]
-	co.devfileName = args[0]
+	// The first argument passed will always be considered as component type, optionally followed by the version of the stack
+	var version string
+	co.devfileMetadata.componentType, version = catalog.ParseStackName(args[0])
+	co.devfileName = co.devfileMetadata.componentType
 
 	var componentName string
 	if len(args) == 2 {
@@ -129,11 +143,13 @@ func (dcm DirectCreateMethod) FetchDevfileAndCreateComponent(co *CreateOptions,
 		}
 	}
 	co.devfileMetadata.componentName = componentName
-	co.devfileMetadata.devfileLink, co.devfileMetadata.devfileRegistry, err = findDevfileFromRegistry(catalogDevfileList, co.devfileMetadata.devfileRegistry.Name, co.devfileMetadata.componentType)
+	devfileComponent, stackVersion, err := findDevfileFromRegistry(catalogDevfileList, co.devfileMetadata.devfileRegistry.Name, co.devfileMetadata.componentType, version)
 	if err != nil {
 		return err
 	}
-	return fetchDevfileFromRegistry(co.devfileMetadata.devfileRegistry, co.devfileMetadata.devfileLink, co.DevfilePath, co.devfileMetadata.componentType, co.contextFlag, co.prefClient)
+	co.devfileMetadata.devfileRegistry = devfileComponent.Registry
+	co.devfileMetadata.stackVersion = stackVersion
+	return fetchDevfileFromRegistry(co.devfileMetadata.devfileRegistry, co.devfileMetadata.stackVersion, co.DevfilePath, co.devfileMetadata.componentType, co.insecureSkipVerifyFlag)
 }
 
 func (dcm DirectCreateMethod) Rollback(devfile, componentContext string) {
@@ -141,6 +157,58 @@ func (dcm DirectCreateMethod) Rollback(devfile, componentContext string) {
 	deleteOdoDir(componentContext)
 }
 
+// AutoDetectCreateMethod is used when the component type is detected from the source code; `odo create --auto`
+type AutoDetectCreateMethod struct{}
+
+func (adcm AutoDetectCreateMethod) CheckConflicts(co *CreateOptions, args []string) error {
+	// Only the component name can be passed, the component type being detected
+	if len(args) > 1 {
+		return errors.Errorf("the component type is detected when using --auto, only the component name can be specified, but %d arguments were passed", len(args))
+	}
+	return nil
+}
+
+func (adcm AutoDetectCreateMethod) FetchDevfileAndCreateComponent(co *CreateOptions, cmdline cmdline.Cmdline, args []string) error {
+	catalogDevfileList, err := validateAndFetchRegistry(co.devfileMetadata.devfileRegistry.Name)
+	if err != nil {
+		return err
+	}
+	detectedStacks, err := detectStacks(co.contextFlag, catalogDevfileList)
+	if err != nil {
+		return errors.Wrap(err, "unable to detect the stack matching the source code")
+	}
+	if len(detectedStacks) == 0 {
+		return errors.New("no stack matches the source code, please specify the component type, or run `odo catalog list components` for a list of supported devfile component types")
+	}
+	devfileComponent := detectedStacks[0].DevfileComponentType
+	log.Infof("Using the stack %q from registry %q", devfileComponent.Name, devfileComponent.Registry.Name)
+
+	// SET METADATA
+	co.devfileMetadata.componentType = devfileComponent.Name
+	co.devfileName = devfileComponent.Name
+	if len(args) == 1 {
+		// The only argument passed is the component name
+		co.devfileMetadata.componentName = args[0]
+	} else {
+		co.devfileMetadata.componentName, err = createDefaultComponentName(devfileComponent.Name, co.contextFlag, co.prefClient)
+		if err != nil {
+			return err
+		}
+	}
+	stackVersion, err := devfileComponent.GetStackVersion("")
+	if err != nil {
+		return err
+	}
+	co.devfileMetadata.devfileRegistry = devfileComponent.Registry
+	co.devfileMetadata.stackVersion = stackVersion
+	return fetchDevfileFromRegistry(co.devfileMetadata.devfileRegistry, co.devfileMetadata.stackVersion, co.DevfilePath, co.devfileMetadata.componentType, co.insecureSkipVerifyFlag)
+}
+
+func (adcm AutoDetectCreateMethod) Rollback(devfile, componentContext string) {
+	deleteDevfile(devfile)
+	deleteOdoDir(componentContext)
+}
+
 // UserCreatedDevfileMethod is used when a devfile is present in the context directory
 type UserCreatedDevfileMethod struct{}
 
@@ -185,8 +253,8 @@ func (hcm HTTPCreateMethod) FetchDevfileAndCreateComponent(co *CreateOptions, cm
 	defer devfileSpinner.End(false)
 
 	params := util.HTTPRequestParams{
-		URL:   co.devfileMetadata.devfilePath.value,
-		Token: co.devfileMetadata.token,
+		URL:             co.devfileMetadata.devfilePath.value,
+		HTTPCredentials: util.HTTPCredentials{Token: co.devfileMetadata.token},
 	}
 	devfileData, err := util.DownloadFileInMemory(params)
 	if err != nil {
@@ -291,8 +359,9 @@ func validateAndFetchRegistry(registryName string) (catalog.DevfileComponentType
 	return catalogDevfileList, nil
 }
 
-// findDevfileFromRegistry finds the devfile and returns necessary information related to it
-func findDevfileFromRegistry(catalogDevfileList catalog.DevfileComponentTypeList, registryName, componentType string) (devfileLink string, devfileRegistry catalog.Registry, err error) {
+// findDevfileFromRegistry finds the devfile and returns necessary information related to it, along with the requested
+// version of the stack; the default version of the stack is returned when version is empty
+func findDevfileFromRegistry(catalogDevfileList catalog.DevfileComponentTypeList, registryName, componentType, version string) (devfileComponent catalog.DevfileComponentType, stackVersion catalog.StackVersion, err error) {
 	devfileExistSpinner := log.Spinnerf("Checking if the devfile for %q exists on available registries", componentType)
 	defer devfileExistSpinner.End(false)
 	if registryName != "" {
@@ -302,53 +371,75 @@ func findDevfileFromRegistry(catalogDevfileList catalog.DevfileComponentTypeList
 	// Find the request devfile from the registry
 	for _, devfileComponent := range catalogDevfileList.Items {
 		if componentType == devfileComponent.Name {
+			stackVersion, err = devfileComponent.GetStackVersion(version)
+			if err != nil {
+				return devfileComponent, stackVersion, err
+			}
 			devfileExistSpinner.End(true)
-			return devfileComponent.Link, devfileComponent.Registry, nil
+			return devfileComponent, stackVersion, nil
 		}
 	}
-	return "", catalog.Registry{}, fmt.Errorf("devfile component type %q is not supported, please run `odo catalog list components` for a list of supported devfile component types", componentType)
+	return catalog.DevfileComponentType{}, catalog.StackVersion{}, fmt.Errorf("devfile component type %q is not supported, please run `odo catalog list components` for a list of supported devfile component types", componentType)
+}
+
+// detectStacks returns the stacks of catalogDevfileList matching the source code in the component context,
+// sorted from the most relevant to the least relevant
+func detectStacks(componentContext string, catalogDevfileList catalog.DevfileComponentTypeList) ([]catalog.DetectedStack, error) {
+	if componentContext == "" {
+		componentContext = LocalDirectoryDefaultLocation
+	}
+	detectSpinner := log.Spinner("Detecting the stack matching the source code")
+	defer detectSpinner.End(false)
+	detection, detectedStacks, err := catalog.DetectStacks(componentContext, catalogDevfileList.Items)
+	if err != nil {
+		return nil, err
+	}
+	detectSpinner.End(true)
+	if len(detection.Languages) > 0 {
+		log.Infof("Detected %s source code (%s)", strings.Join(detection.Languages, ", "), strings.Join(detection.ProjectTypes, ", "))
+	}
+	return detectedStacks, nil
 }
 
-// fetchDevfileFromRegistry fetches the required devfile from the list catalogDevfileList
-func fetchDevfileFromRegistry(registry catalog.Registry, devfileLink, devfilePath, componentType, componentContext string, prefClient preference.Client) (err error) {
+// fetchDevfileFromRegistry fetches the required devfile from the list catalogDevfileList,
+// verifying the checksums and signatures of the stack unless skipVerify is set
+func fetchDevfileFromRegistry(registry catalog.Registry, stackVersion catalog.StackVersion, devfilePath, componentType string, skipVerify bool) (err error) {
 	// Download devfile from registry
 	registrySpinner := log.Spinnerf("Creating a devfile component from registry %q", registry.Name)
 	defer registrySpinner.End(false)
 
-	// For GitHub based registries
 	if registryUtil.IsGitBasedRegistry(registry.URL) {
 		registryUtil.PrintGitRegistryDeprecationWarning()
-
-		params := util.HTTPRequestParams{
-			URL: registry.URL + devfileLink,
-		}
-
-		secure := registryUtil.IsSecure(prefClient, registry.Name)
-		if secure {
-			var token string
-			token, err = keyring.Get(fmt.Sprintf("%s%s", util.CredentialPrefix, registry.Name), registryUtil.RegistryUser)
-			if err != nil {
-				return errors.Wrap(err, "unable to get secure registry credential from keyring")
-			}
-			params.Token = token
-		}
-
-		devfileData, err := util.DownloadFileInMemoryWithCache(params, prefClient.GetRegistryCacheTime())
-		if err != nil {
-			return err
-		}
-
-		err = ioutil.WriteFile(devfilePath, devfileData, 0644) // #nosec G306
-		if err != nil {
-			return err
-		}
-	} else {
-		err := registryLibrary.PullStackFromRegistry(registry.URL, componentType, componentContext, segment.GetRegistryOptions())
+	}
+	// the stack is downloaded and verified in a temporary directory, so that the files failing the verification
+	// are never written into the component directory
+	tmpDir, err := ioutil.TempDir("", "odo-stack")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+	err = catalog.DownloadStack(registry, componentType, stackVersion, tmpDir)
+	if err != nil {
+		return err
+	}
+	if !skipVerify {
+		err = catalog.VerifyStack(registry, componentType, stackVersion, tmpDir)
 		if err != nil {
-			return err
+			return withSkipVerifyHint(errors.Wrapf(err, "unable to verify the stack %s of registry %s", componentType, registry.Name))
 		}
 	}
+	err = os.MkdirAll(filepath.Dir(devfilePath), 0750)
+	if err != nil {
+		return err
+	}
+	err = util.CopyDirWithFS(tmpDir, filepath.Dir(devfilePath))
+	if err != nil {
+		return err
+	}
 	registrySpinner.End(true)
+	if skipVerify && (len(stackVersion.Checksums) > 0 || registry.PublicKey != "") {
+		log.Warningf("The checksums and signatures of the stack %s of registry %s were not verified", componentType, registry.Name)
+	}
 	return nil
 }
 
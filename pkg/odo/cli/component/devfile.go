@@ -1,6 +1,7 @@
 package component
 
 import (
+	"fmt"
 	"os"
 	"reflect"
 	"strings"
@@ -8,10 +9,13 @@ import (
 	"github.com/redhat-developer/odo/pkg/devfile"
 
 	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/pkg/devfile/parser"
 	"github.com/pkg/errors"
 	"github.com/redhat-developer/odo/pkg/envinfo"
 	"github.com/redhat-developer/odo/pkg/machineoutput"
+	"github.com/redhat-developer/odo/pkg/odo/cli/ui"
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/service"
 	"github.com/redhat-developer/odo/pkg/util"
 
 	componentlabels "github.com/redhat-developer/odo/pkg/component/labels"
@@ -70,6 +74,11 @@ func (po *PushOptions) devfilePushInner() (err error) {
 		return errors.Wrap(err, "unable to apply ignore information")
 	}
 
+	err = po.confirmServicesChanges(devObj)
+	if err != nil {
+		return err
+	}
+
 	var platformContext interface{}
 	kc := kubernetes.KubernetesContext{
 		Namespace: po.KClient.GetCurrentNamespace(),
@@ -92,6 +101,7 @@ func (po *PushOptions) devfilePushInner() (err error) {
 		DevfileDebugCmd: strings.ToLower(po.debugCommandFlag),
 		Debug:           po.debugFlag,
 		DebugPort:       po.EnvSpecificInfo.GetDebugPort(),
+		WaitServices:    po.waitServicesFlag,
 	}
 
 	_, err = po.EnvSpecificInfo.ListURLs()
@@ -114,6 +124,45 @@ func (po *PushOptions) devfilePushInner() (err error) {
 	return
 }
 
+// confirmServicesChanges displays the changes of the services already deployed on the cluster,
+// and asks for a confirmation when fields marked as immutable by their Operators are changed, unless --yes is used;
+// the changes are not displayed when they cannot be computed, without preventing the push
+func (po *PushOptions) confirmServicesChanges(devObj parser.DevfileObj) error {
+	k8sComponents, err := devfile.GetKubernetesComponentsToPush(devObj)
+	if err != nil {
+		return err
+	}
+	servicesChanges, err := service.GetServicesChanges(po.KClient, k8sComponents, po.componentContext)
+	if err != nil {
+		log.Warningf("unable to compare the services with the ones deployed on the cluster: %v", err)
+		return nil
+	}
+
+	var immutable []string
+	for _, s := range servicesChanges {
+		log.Infof("\nChanges to the service %q:", s.Name)
+		for _, change := range s.Changes {
+			log.Infof("  %s", change)
+		}
+		if s.HasImmutableChanges() {
+			immutable = append(immutable, s.Name)
+		}
+	}
+	if len(immutable) == 0 || po.yesFlag {
+		return nil
+	}
+
+	message := fmt.Sprintf("Fields marked as immutable by the Operator of %s are changed; the Operator may reject the changes or recreate the service", strings.Join(immutable, ", "))
+	if log.IsJSON() {
+		return fmt.Errorf("%s; use %q to apply them", message, "--yes")
+	}
+	log.Warning(message)
+	if !ui.Proceed("Are you sure you want to apply the changes") {
+		return fmt.Errorf("aborting the push of the component")
+	}
+	return nil
+}
+
 // DevfileComponentLog fetch and display log from devfile components
 func (lo LogOptions) DevfileComponentLog() error {
 	devObj, err := devfile.ParseAndValidateFromFile(lo.GetDevfilePath())
@@ -0,0 +1,184 @@
+package component
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/devfile/library/pkg/devfile/parser"
+	devfileCtx "github.com/devfile/library/pkg/devfile/parser/context"
+	"github.com/pkg/errors"
+	"github.com/redhat-developer/odo/pkg/component"
+	"github.com/redhat-developer/odo/pkg/devfile/location"
+	"github.com/redhat-developer/odo/pkg/envinfo"
+	"github.com/redhat-developer/odo/pkg/log"
+	appCmd "github.com/redhat-developer/odo/pkg/odo/cli/application"
+	projectCmd "github.com/redhat-developer/odo/pkg/odo/cli/project"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+	"github.com/redhat-developer/odo/pkg/odo/util/completion"
+	"github.com/redhat-developer/odo/pkg/util"
+	"github.com/spf13/cobra"
+
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+)
+
+// ImportRecommendedCommandName is the recommended import command name
+const ImportRecommendedCommandName = "import"
+
+var importLongDesc = ktemplates.LongDesc(`Create a component from a Deployment running in the cluster.
+
+The devfile of the component contains a container component for each container of the Deployment, with its image,
+environment variables and resources, the endpoints of the ports exposed by Services and Ingresses, and the volumes
+of its PersistentVolumeClaims. The command of the first container becomes the run command of the devfile.
+
+The Deployment itself is not modified: "odo push" deploys a copy of it, with new volumes, named after the component.`)
+
+var importExample = ktemplates.Examples(`  # Create a component from the Deployment "nodejs"
+  %[1]s deployment/nodejs
+
+  # Create a component named "frontend" in the "./frontend" directory from the Deployment "nodejs"
+  %[1]s deployment/nodejs frontend --context ./frontend`)
+
+// ImportOptions encapsulates the options for the odo component import command
+type ImportOptions struct {
+	// Context
+	*genericclioptions.Context
+
+	// Flags
+	contextFlag string
+
+	deploymentName string
+	componentName  string
+	devfilePath    string
+}
+
+// NewImportOptions returns new instance of ImportOptions
+func NewImportOptions() *ImportOptions {
+	return &ImportOptions{}
+}
+
+// Complete completes import args
+func (io *ImportOptions) Complete(cmdline cmdline.Cmdline, args []string) (err error) {
+	io.Context, err = genericclioptions.New(genericclioptions.NewCreateParameters(cmdline))
+	if err != nil {
+		return err
+	}
+
+	kind, name := "", args[0]
+	if i := strings.Index(args[0], "/"); i >= 0 {
+		kind, name = args[0][:i], args[0][i+1:]
+	}
+	if kind != "deployment" && kind != "deployments" && kind != "deploy" {
+		return fmt.Errorf("%q is not a deployment, the resource to import must be of the form deployment/<name>", args[0])
+	}
+	io.deploymentName = name
+
+	io.componentName = io.deploymentName
+	if len(args) == 2 {
+		io.componentName = args[1]
+	}
+	io.devfilePath = location.DevfileLocation(io.contextFlag)
+	return nil
+}
+
+// Validate validates the import parameters
+func (io *ImportOptions) Validate() error {
+	if io.deploymentName == "" {
+		return errors.New("the name of the deployment to import is missing")
+	}
+	if util.CheckPathExists(io.devfilePath) || util.CheckPathExists(getEnvFilePath(io.contextFlag)) {
+		return errors.New("this directory already contains a component")
+	}
+	return util.ValidateK8sResourceName("component name", io.componentName)
+}
+
+// Run imports the deployment, and writes the devfile and the env file of the component
+func (io *ImportOptions) Run() error {
+	spinner := log.Spinnerf("Importing deployment %q", io.deploymentName)
+	defer spinner.End(false)
+	imported, err := component.ImportDeployment(io.KClient, io.deploymentName, io.componentName)
+	if err != nil {
+		return err
+	}
+	spinner.End(true)
+
+	for _, warning := range imported.Warnings {
+		log.Warningf("Import: %s", warning)
+	}
+
+	// the context directory is created when it doesn't exist yet
+	err = os.MkdirAll(filepath.Dir(io.devfilePath), 0750)
+	if err != nil {
+		return errors.Wrapf(err, "unable to create the directory of the component")
+	}
+
+	ctx := devfileCtx.NewDevfileCtx(io.devfilePath)
+	err = ctx.SetAbsPath()
+	if err != nil {
+		return err
+	}
+	devObj := parser.DevfileObj{Ctx: ctx, Data: imported.Devfile}
+	err = devObj.WriteYamlDevfile()
+	if err != nil {
+		return err
+	}
+
+	envInfo, err := envinfo.NewEnvSpecificInfo(io.contextFlag)
+	if err != nil {
+		return err
+	}
+	settings := envinfo.ComponentSettings{
+		Name:    io.componentName,
+		Project: io.GetProject(),
+		AppName: io.GetApplication(),
+	}
+	if len(imported.URLs) > 0 {
+		settings.URL = &imported.URLs
+	}
+	err = envInfo.SetComponentSettings(settings)
+	if err != nil {
+		return errors.Wrap(err, "failed to create env file for the component")
+	}
+
+	sourcePath, err := util.GetAbsPath(io.contextFlag)
+	if err != nil {
+		return errors.Wrap(err, "unable to get source path")
+	}
+	ignoreFile, err := util.TouchGitIgnoreFile(sourcePath)
+	if err != nil {
+		return err
+	}
+	err = util.AddFileToIgnoreFile(ignoreFile, filepath.Join(io.contextFlag, EnvDirectory))
+	if err != nil {
+		return err
+	}
+
+	log.Successf("Component %q created from deployment %q", io.componentName, io.deploymentName)
+	log.Italic("\nPlease use `odo push` to deploy a copy of the deployment with the sources of the component")
+	return nil
+}
+
+// NewCmdImport implements the odo component import command
+func NewCmdImport(name, fullName string) *cobra.Command {
+	io := NewImportOptions()
+	var importCmd = &cobra.Command{
+		Use:         fmt.Sprintf("%s deployment/<name> [component_name]", name),
+		Short:       "Create a component from an existing Deployment",
+		Long:        importLongDesc,
+		Example:     fmt.Sprintf(importExample, fullName),
+		Args:        cobra.RangeArgs(1, 2),
+		Annotations: map[string]string{"command": "component"},
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(io, cmd, args)
+		},
+	}
+	importCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	odoutil.AddContextFlag(importCmd, &io.contextFlag)
+	projectCmd.AddProjectFlag(importCmd)
+	appCmd.AddApplicationFlag(importCmd)
+	completion.RegisterCommandFlagHandler(importCmd, "context", completion.FileCompletionHandler)
+	return importCmd
+}
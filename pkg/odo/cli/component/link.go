@@ -35,9 +35,19 @@ var (
 
 # Link the current component to the 'EtcdCluster' named 'myetcd'
 # and make the secrets accessible as files in the '/bindings/etcd/' directory
-%[1]s EtcdCluster/myetcd  --bind-as-files --name etcd`)
+%[1]s EtcdCluster/myetcd  --bind-as-files --name etcd
 
-	linkLongDesc = `Link current or provided component to a service (backed by an Operator) or another component
+# Link the current component to the Secret named 'mydb' holding the credentials of an external database
+%[1]s secret/mydb
+
+# Link the current component to the ConfigMap named 'myconfig' and make its keys accessible as files
+%[1]s configmap/myconfig --bind-as-files
+
+# Re-run the binding of the existing link to the Secret named 'mydb', after the secret has been modified
+%[1]s secret/mydb --refresh`)
+
+	linkLongDesc = `Link current or provided component to a service (backed by an Operator), another component,
+or a plain Secret or ConfigMap (referenced as secret/<name> or configmap/<name>)
 
 The appropriate secret will be added to the environment of the source component as environment variables by 
 default.
@@ -54,6 +64,13 @@ SERVICE_BACKEND_PORT_PORT-8080=8080
 
 Using the '--bind-as-files' flag, secrets will be accessible as files instead of environment variables.
 The value of the '--name' flag indicates the name of the directory under '/bindings/' containing the secrets files.
+
+Links to a Secret or a ConfigMap, for example representing an external service, are created by odo itself
+and don't require the Service Binding Operator.
+
+When the binding data of a service changed since a link was created, 'odo describe' reports the link as out of date.
+Using the '--refresh' flag on an existing link re-runs its binding, to update the component with
+the current state of the service.
 `
 )
 
@@ -104,7 +121,7 @@ func NewCmdLink(name, fullName string) *cobra.Command {
 	o := NewLinkOptions()
 
 	linkCmd := &cobra.Command{
-		Use:         fmt.Sprintf("%s <operator-service-type>/<service-name> OR %s <operator-service-type>/<service-name> --component [component] OR %s <component> --component [component]", name, name, name),
+		Use:         fmt.Sprintf("%s <operator-service-type>/<service-name> OR %s <operator-service-type>/<service-name> --component [component] OR %s <component> --component [component] OR %s secret/<name> OR %s configmap/<name>", name, name, name, name, name),
 		Short:       "Link component to a service or component",
 		Long:        linkLongDesc,
 		Example:     fmt.Sprintf(linkExample, fullName),
@@ -118,6 +135,7 @@ func NewCmdLink(name, fullName string) *cobra.Command {
 	linkCmd.PersistentFlags().BoolVarP(&o.inlined, "inlined", "", false, "Puts the link definition in the devfile instead of a separate file")
 	linkCmd.PersistentFlags().StringVar(&o.name, "name", "", "Name of the created ServiceBinding resource")
 	linkCmd.PersistentFlags().BoolVar(&o.bindAsFiles, "bind-as-files", false, "If enabled, configuration values will be mounted as files, instead of declared as environment variables")
+	linkCmd.PersistentFlags().BoolVar(&o.refresh, "refresh", false, "Re-run the binding of an existing link, to update it with the current state of the service")
 	linkCmd.PersistentFlags().StringArrayVarP(&o.mappings, "map", "", []string{}, "Mappings (custom binding data) to be added to the component; each map should be specified as <key>=<value>")
 	linkCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
 
@@ -43,6 +43,9 @@ var pushCmdExample = (`  # Push source code to the current component
 
 # Output JSON events corresponding to devfile command execution and log text
 %[1]s -o json
+
+# Wait until the services the component is linked to are ready before updating the component
+%[1]s --wait-services
   `)
 
 // PushRecommendedCommandName is the recommended push command name
@@ -54,9 +57,11 @@ type PushOptions struct {
 	*CommonPushOptions
 
 	// Flags
-	ignoreFlag     []string
-	forceBuildFlag bool
-	debugFlag      bool
+	ignoreFlag       []string
+	forceBuildFlag   bool
+	debugFlag        bool
+	waitServicesFlag bool
+	yesFlag          bool
 
 	// devfile commands flags
 	initCommandFlag  string
@@ -256,12 +261,14 @@ func NewCmdPush(name, fullName string) *cobra.Command {
 	pushCmd.Flags().BoolVar(&po.configFlag, "config", false, "Use config flag to only apply config on to cluster")
 	pushCmd.Flags().BoolVar(&po.sourceFlag, "source", false, "Use source flag to only push latest source on to cluster")
 	pushCmd.Flags().BoolVarP(&po.forceBuildFlag, "force-build", "f", false, "Use force-build flag to re-sync the entire source code and re-build the component")
+	pushCmd.Flags().BoolVar(&po.yesFlag, "yes", false, "Apply the changes of the fields of the services marked as immutable by their Operators without confirmation")
 
 	pushCmd.Flags().StringVar(&po.initCommandFlag, "init-command", "", "Devfile Init Command to execute")
 	pushCmd.Flags().StringVar(&po.buildCommandFlag, "build-command", "", "Devfile Build Command to execute")
 	pushCmd.Flags().StringVar(&po.runCommandflag, "run-command", "", "Devfile Run Command to execute")
 	pushCmd.Flags().BoolVar(&po.debugFlag, "debug", false, "Runs the component in debug mode")
 	pushCmd.Flags().StringVar(&po.debugCommandFlag, "debug-command", "", "Devfile Debug Command to execute")
+	pushCmd.Flags().BoolVar(&po.waitServicesFlag, "wait-services", false, "Wait until the linked services are ready before updating the component")
 
 	//Adding `--project` flag
 	projectCmd.AddProjectFlag(pushCmd)
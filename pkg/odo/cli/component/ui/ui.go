@@ -1,10 +1,12 @@
 package ui
 
 import (
+	"fmt"
 	"sort"
 
 	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	"github.com/redhat-developer/odo/pkg/catalog"
+	"github.com/redhat-developer/odo/pkg/component"
 	"github.com/redhat-developer/odo/pkg/odo/cli/ui"
 	"github.com/redhat-developer/odo/pkg/odo/util/validation"
 	"gopkg.in/AlecAivazis/survey.v1"
@@ -46,18 +48,35 @@ func SelectStarterProject(projects []devfilev1.StarterProject) string {
 
 }
 
-// SelectDevfileComponentType lets the user to select the devfile component type in the prompt
-func SelectDevfileComponentType(options []catalog.DevfileComponentType) string {
+// SelectDevfileComponentType lets the user to select the devfile component type in the prompt,
+// the default component type being the one detected from the source code, if any
+func SelectDevfileComponentType(options []catalog.DevfileComponentType, defaultComponentType string) string {
 	var componentType string
 	prompt := &survey.Select{
 		Message: "Which devfile component type do you wish to create",
 		Options: getDevfileComponentTypeNameCandidates(options),
 	}
+	if defaultComponentType != "" {
+		prompt.Default = defaultComponentType
+	}
 	err := survey.AskOne(prompt, &componentType, survey.Required)
 	ui.HandleError(err)
 	return componentType
 }
 
+// SelectStackVersion lets the user to select the version of the devfile stack in the prompt
+func SelectStackVersion(versions []catalog.StackVersion, defaultVersion string) string {
+	var version string
+	prompt := &survey.Select{
+		Message: "Which version of the devfile component type do you wish to use",
+		Options: getStackVersionCandidates(versions),
+		Default: defaultVersion,
+	}
+	err := survey.AskOne(prompt, &version, survey.Required)
+	ui.HandleError(err)
+	return version
+}
+
 // EnterDevfileComponentName lets the user to specify the component name in the prompt
 func EnterDevfileComponentName(defaultComponentName string) string {
 	var componentName string
@@ -70,6 +89,22 @@ func EnterDevfileComponentName(defaultComponentName string) string {
 	return componentName
 }
 
+// EnterStarterParameter lets the user to specify the value of a parameter of the starter project in the prompt
+func EnterStarterParameter(parameter component.StarterParameter) string {
+	message := parameter.Name
+	if parameter.Description != "" {
+		message = fmt.Sprintf("%s (%s)", parameter.Description, parameter.Name)
+	}
+	var value string
+	prompt := &survey.Input{
+		Message: message,
+		Default: parameter.Default,
+	}
+	err := survey.AskOne(prompt, &value, survey.Required)
+	ui.HandleError(err)
+	return value
+}
+
 // EnterDevfileComponentProject lets the user to specify the component project in the prompt
 func EnterDevfileComponentProject(defaultComponentNamespace string) string {
 	var name string
@@ -91,6 +126,14 @@ func getDevfileComponentTypeNameCandidates(options []catalog.DevfileComponentTyp
 	return result
 }
 
+func getStackVersionCandidates(versions []catalog.StackVersion) []string {
+	result := make([]string, len(versions))
+	for i, version := range versions {
+		result[i] = version.Version
+	}
+	return result
+}
+
 func getProjectNames(projects []devfilev1.StarterProject) []string {
 	result := make([]string, len(projects))
 	for i, project := range projects {
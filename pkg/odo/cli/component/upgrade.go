@@ -0,0 +1,243 @@
+package component
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/blang/semver"
+	"github.com/pkg/errors"
+	"github.com/redhat-developer/odo/pkg/catalog"
+	"github.com/redhat-developer/odo/pkg/component"
+	"github.com/redhat-developer/odo/pkg/devfile"
+	"github.com/redhat-developer/odo/pkg/envinfo"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+	"github.com/spf13/cobra"
+
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+)
+
+// UpgradeRecommendedCommandName is the recommended upgrade command name
+const UpgradeRecommendedCommandName = "upgrade"
+
+var upgradeLongDesc = ktemplates.LongDesc(`Upgrade the devfile of a component to a newer version of its stack.
+
+The devfile of the version of the stack the component is created from, the devfile of the component and the devfile
+of the new version of the stack are merged: the changes made to the devfile of the component are kept, and the changes
+of the stack are applied. When a field is modified differently in the component and in the stack, the conflict is
+reported and the devfile is not modified, unless the conflicts are resolved with --resolve.
+
+The default version of the stack is used, unless a version is specified. Upgrading to a version older than the
+version the component is created from is refused, unless --allow-downgrade is used.`)
+
+var upgradeExample = ktemplates.Examples(`  # Upgrade the devfile of the component to the default version of its stack
+  %[1]s
+
+  # Upgrade the devfile of the component to the version 2.0.0 of its stack
+  %[1]s 2.0.0
+
+  # Upgrade the devfile of the component, keeping the values of the component in case of conflict
+  %[1]s --resolve current
+
+  # Downgrade the devfile of the component to the version 1.0.0 of its stack
+  %[1]s 1.0.0 --allow-downgrade`)
+
+// UpgradeOptions encapsulates the options for the odo component upgrade command
+type UpgradeOptions struct {
+	// Context
+	*genericclioptions.Context
+
+	// Flags
+	contextFlag        string
+	resolveFlag        string
+	allowDowngradeFlag bool
+
+	version string
+	stack   envinfo.Stack
+}
+
+// NewUpgradeOptions returns new instance of UpgradeOptions
+func NewUpgradeOptions() *UpgradeOptions {
+	return &UpgradeOptions{}
+}
+
+// Complete completes upgrade args
+func (uo *UpgradeOptions) Complete(cmdline cmdline.Cmdline, args []string) (err error) {
+	uo.Context, err = genericclioptions.New(genericclioptions.NewCreateParameters(cmdline).NeedDevfile(uo.contextFlag).IsOffline())
+	if err != nil {
+		return err
+	}
+	if len(args) == 1 {
+		uo.version = args[0]
+	}
+	if stack := uo.EnvSpecificInfo.GetStack(); stack != nil {
+		uo.stack = *stack
+	}
+	return nil
+}
+
+// Validate validates the upgrade parameters
+func (uo *UpgradeOptions) Validate() error {
+	if uo.stack.Registry == "" || uo.stack.Name == "" {
+		return errors.New("the component is not created from a registry stack, its devfile cannot be upgraded")
+	}
+	if uo.stack.Version == "" {
+		return errors.Errorf("the version of stack %s the component is created from is unknown, its devfile cannot be upgraded", uo.stack.Name)
+	}
+	switch component.ConflictResolution(uo.resolveFlag) {
+	case "", component.KeepCurrent, component.UseStack:
+		return nil
+	default:
+		return errors.Errorf("invalid value %q for --resolve, the valid values are %q and %q", uo.resolveFlag, component.KeepCurrent, component.UseStack)
+	}
+}
+
+// Run merges the changes of the new version of the stack into the devfile of the component
+func (uo *UpgradeOptions) Run() (err error) {
+	devfileComponent, err := catalog.GetDevfileComponent(uo.stack.Registry, uo.stack.Name)
+	if err != nil {
+		return err
+	}
+	baseVersion, err := devfileComponent.GetStackVersion(uo.stack.Version)
+	if err != nil {
+		return errors.Wrap(err, "unable to get the version of the stack the component is created from")
+	}
+	newVersion, err := devfileComponent.GetStackVersion(uo.version)
+	if err != nil {
+		return err
+	}
+	if baseVersion.Version == newVersion.Version {
+		log.Infof("The component already uses the version %s of stack %s", newVersion.Version, uo.stack.Name)
+		return nil
+	}
+	if isDowngrade(baseVersion.Version, newVersion.Version) && !uo.allowDowngradeFlag {
+		return errors.Errorf("the version %s of stack %s is older than the version %s the component is created from, use --allow-downgrade to downgrade the devfile", newVersion.Version, uo.stack.Name, baseVersion.Version)
+	}
+
+	base, err := downloadStackDevfile(devfileComponent.Registry, uo.stack.Name, baseVersion)
+	if err != nil {
+		return err
+	}
+	upgraded, err := downloadStackDevfile(devfileComponent.Registry, uo.stack.Name, newVersion)
+	if err != nil {
+		return err
+	}
+	devfilePath := uo.EnvSpecificInfo.GetDevfilePath()
+	current, err := ioutil.ReadFile(devfilePath)
+	if err != nil {
+		return err
+	}
+
+	merged, conflicts, err := component.MergeDevfiles(base, current, upgraded, component.ConflictResolution(uo.resolveFlag))
+	if err != nil {
+		return err
+	}
+	for _, conflict := range conflicts {
+		log.Warningf("Conflict on %s:\n  version %s of the stack: %s\n  component: %s\n  version %s of the stack: %s",
+			conflict.Path, baseVersion.Version, conflict.Base, conflict.Current, newVersion.Version, conflict.Stack)
+	}
+	if len(conflicts) > 0 && uo.resolveFlag == "" {
+		return errors.Errorf("the devfile has %d conflicts with the version %s of stack %s, please resolve them with --resolve current or --resolve stack", len(conflicts), newVersion.Version, uo.stack.Name)
+	}
+
+	err = validateDevfile(merged, filepath.Dir(devfilePath))
+	if err != nil {
+		return errors.Wrap(err, "the upgraded devfile is invalid")
+	}
+	err = ioutil.WriteFile(devfilePath, merged, 0644) // #nosec G306
+	if err != nil {
+		return err
+	}
+
+	uo.stack.Version = newVersion.Version
+	err = uo.EnvSpecificInfo.SetStack(uo.stack)
+	if err != nil {
+		return err
+	}
+	if isDowngrade(baseVersion.Version, newVersion.Version) {
+		log.Successf("Downgraded the devfile from version %s to version %s of stack %s", baseVersion.Version, newVersion.Version, uo.stack.Name)
+		return nil
+	}
+	log.Successf("Upgraded the devfile from version %s to version %s of stack %s", baseVersion.Version, newVersion.Version, uo.stack.Name)
+	return nil
+}
+
+// isDowngrade returns true when the new version is older than the base version; versions which can't be parsed
+// are not compared
+func isDowngrade(baseVersion, newVersion string) bool {
+	base, err := semver.ParseTolerant(baseVersion)
+	if err != nil {
+		return false
+	}
+	upgraded, err := semver.ParseTolerant(newVersion)
+	if err != nil {
+		return false
+	}
+	return upgraded.LT(base)
+}
+
+// validateDevfile parses and validates the content of a devfile, written to a temporary file of dir so that the
+// relative references of the devfile are resolved as for the devfile of the component
+func validateDevfile(content []byte, dir string) error {
+	file, err := ioutil.TempFile(dir, ".devfile-*.yaml")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	_, err = file.Write(content)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	_, err = devfile.ParseAndValidateFromFile(file.Name())
+	return err
+}
+
+// downloadStackDevfile returns the content of the devfile of the version of the stack
+func downloadStackDevfile(registry catalog.Registry, stackName string, version catalog.StackVersion) ([]byte, error) {
+	spinner := log.Spinnerf("Downloading the version %s of stack %s", version.Version, stackName)
+	defer spinner.End(false)
+
+	dir, err := ioutil.TempDir("", "odo-upgrade")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	err = catalog.DownloadStack(registry, stackName, version, dir)
+	if err != nil {
+		return nil, err
+	}
+	content, err := ioutil.ReadFile(filepath.Join(dir, "devfile.yaml"))
+	if err != nil {
+		return nil, err
+	}
+	spinner.End(true)
+	return content, nil
+}
+
+// NewCmdUpgrade implements the odo component upgrade command
+func NewCmdUpgrade(name, fullName string) *cobra.Command {
+	uo := NewUpgradeOptions()
+	var upgradeCmd = &cobra.Command{
+		Use:     fmt.Sprintf("%s [version]", name),
+		Short:   "Upgrade the devfile of a component to a newer version of its stack",
+		Long:    upgradeLongDesc,
+		Example: fmt.Sprintf(upgradeExample, fullName),
+		Args:    cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(uo, cmd, args)
+		},
+	}
+	upgradeCmd.Flags().StringVar(&uo.resolveFlag, "resolve", "", "Resolve the conflicts with the values of the component (current) or of the new version of the stack (stack)")
+	upgradeCmd.Flags().BoolVar(&uo.allowDowngradeFlag, "allow-downgrade", false, "Allow downgrading the devfile to a version of the stack older than the version the component is created from")
+	odoutil.AddContextFlag(upgradeCmd, &uo.contextFlag)
+
+	return upgradeCmd
+}
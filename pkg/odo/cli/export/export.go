@@ -0,0 +1,151 @@
+package export
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	devfilefs "github.com/devfile/library/pkg/testingutil/filesystem"
+	"github.com/redhat-developer/odo/pkg/devfile"
+	"github.com/redhat-developer/odo/pkg/export"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+)
+
+// RecommendedCommandName is the recommended command name
+const RecommendedCommandName = "export"
+
+var exportExample = ktemplates.Examples(`
+  # Export the component and its services as a Kustomize base in the "export" directory
+  %[1]s
+
+  # Export the component and its services as a Helm chart in the "chart" directory
+  %[1]s --format helm --output-dir chart`)
+
+var exportLongDesc = ktemplates.LongDesc(`
+Export the resources of the component deployed on the cluster, and the services and links defined in the devfile, as a portable bundle.
+
+The parts used only for the development, such as the supervisord init container and the volume of the sources, are removed,
+and the run command of the devfile becomes the command of the container.
+
+The resources are written either with a kustomization.yaml file listing them, or as the templates of a Helm chart whose
+values are the images of the containers.`)
+
+// ExportOptions encapsulates the options for the odo export command
+type ExportOptions struct {
+	// Context
+	*genericclioptions.Context
+
+	// Flags
+	contextFlag   string
+	formatFlag    string
+	outputDirFlag string
+	forceFlag     bool
+}
+
+// NewExportOptions creates a new ExportOptions instance
+func NewExportOptions() *ExportOptions {
+	return &ExportOptions{}
+}
+
+// Complete completes ExportOptions after they've been created
+func (o *ExportOptions) Complete(cmdline cmdline.Cmdline, args []string) (err error) {
+	o.Context, err = genericclioptions.New(genericclioptions.NewCreateParameters(cmdline).NeedDevfile(o.contextFlag))
+	if err != nil {
+		return err
+	}
+	if !filepath.IsAbs(o.outputDirFlag) {
+		o.outputDirFlag = filepath.Join(o.GetComponentContext(), o.outputDirFlag)
+	}
+	return nil
+}
+
+// Validate validates the ExportOptions based on completed values
+func (o *ExportOptions) Validate() error {
+	valid := false
+	for _, f := range export.Formats {
+		if export.Format(o.formatFlag) == f {
+			valid = true
+		}
+	}
+	if !valid {
+		return fmt.Errorf("unknown format %q, supported formats are %v", o.formatFlag, export.Formats)
+	}
+
+	// the output directory can't hold the component, the files of the export would overwrite its sources
+	contextDir, err := filepath.Abs(o.GetComponentContext())
+	if err != nil {
+		return err
+	}
+	outputDir, err := filepath.Abs(o.outputDirFlag)
+	if err != nil {
+		return err
+	}
+	if rel, relErr := filepath.Rel(outputDir, contextDir); relErr == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return fmt.Errorf("the output directory %q cannot be the directory of the component or one of its parents", o.outputDirFlag)
+	}
+
+	files, err := ioutil.ReadDir(o.outputDirFlag)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	if len(files) > 0 && !o.forceFlag {
+		return fmt.Errorf("the directory %q is not empty; use %q to overwrite the exported files it contains", o.outputDirFlag, "--force")
+	}
+	return nil
+}
+
+// Run contains the logic for the odo export command
+func (o *ExportOptions) Run() error {
+	k8sComponents, err := devfile.GetKubernetesComponentsToPush(o.EnvSpecificInfo.GetDevfileObj())
+	if err != nil {
+		return err
+	}
+
+	resources, err := export.GetComponentResources(o.KClient, o.EnvSpecificInfo.GetName(), o.GetApplication(), k8sComponents, o.GetComponentContext())
+	if err != nil {
+		return err
+	}
+
+	// with --force, only the files written by the export are overwritten, the other files of the directory are kept
+	err = export.Write(resources, o.EnvSpecificInfo.GetName(), export.Format(o.formatFlag), o.outputDirFlag, devfilefs.DefaultFs{})
+	if err != nil {
+		return err
+	}
+	log.Successf("Exported %d resources of component %q in %s format to %s", len(resources), o.EnvSpecificInfo.GetName(), o.formatFlag, o.outputDirFlag)
+	return nil
+}
+
+// NewCmdExport implements the odo export command
+func NewCmdExport(name, fullName string) *cobra.Command {
+	o := NewExportOptions()
+	exportCmd := &cobra.Command{
+		Use:     name,
+		Short:   "Export the component and its services as a Kustomize base or a Helm chart",
+		Long:    exportLongDesc,
+		Example: fmt.Sprintf(exportExample, fullName),
+		Args:    cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+
+	exportCmd.Flags().StringVar(&o.formatFlag, "format", string(export.FormatKustomize), "Format of the exported resources, kustomize or helm")
+	exportCmd.Flags().StringVar(&o.outputDirFlag, "output-dir", "export", "Directory where the resources are written, relative to the component directory")
+	exportCmd.Flags().BoolVarP(&o.forceFlag, "force", "f", false, "Overwrite the exported files if the output directory is not empty, the other files of the directory being kept")
+
+	// Add a defined annotation in order to appear in the help menu
+	exportCmd.Annotations = map[string]string{"command": "utility"}
+	exportCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	odoutil.AddContextFlag(exportCmd, &o.contextFlag)
+	return exportCmd
+}
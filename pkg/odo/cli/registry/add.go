@@ -31,6 +31,19 @@ var (
 	%[1]s CheRegistry https://che-devfile-registry.openshift.io
 
 	%[1]s RegistryFromGitHub https://github.com/elsony/devfile-registry
+
+	# Add devfile registry stored in a local directory
+	%[1]s LocalRegistry ./registry
+	%[1]s LocalRegistry file:///home/user/registry
+
+	# Add devfile registry requiring basic authentication
+	%[1]s MyRegistry https://myregistry.example.com --username user --password secret
+
+	# Add OCI-based devfile registry using the credentials of "docker login", with a client certificate
+	%[1]s MyRegistry https://myregistry.example.com --auth-type docker --client-cert cert.pem --client-key key.pem
+
+	# Add devfile registry whose stacks are signed, verifying the signatures with the public key of the registry
+	%[1]s MyRegistry https://myregistry.example.com --public-key cosign.pub
 	`)
 )
 
@@ -44,7 +57,8 @@ type AddOptions struct {
 	registryURL  string
 
 	// Flags
-	tokenFlag string
+	authFlags
+	publicKeyFlag string
 
 	operation string
 	user      string
@@ -61,14 +75,29 @@ func NewAddOptions(prefClient preference.Client) *AddOptions {
 func (o *AddOptions) Complete(cmdline cmdline.Cmdline, args []string) (err error) {
 	o.operation = "add"
 	o.registryName = args[0]
-	o.registryURL = args[1]
+	o.registryURL, err = util2.ConvertPathToRegistryURL(args[1])
+	if err != nil {
+		return err
+	}
 	o.user = "default"
-	return nil
+	err = o.authFlags.complete()
+	if err != nil {
+		return err
+	}
+	return completePublicKey(&o.publicKeyFlag)
 }
 
 // Validate validates the AddOptions based on completed values
 func (o *AddOptions) Validate() (err error) {
-	err = util.ValidateURL(o.registryURL)
+	err = util2.ValidateRegistryURL(o.registryURL)
+	if err != nil {
+		return err
+	}
+	err = o.authFlags.validate()
+	if err != nil {
+		return err
+	}
+	err = validatePublicKey(o.publicKeyFlag)
 	if err != nil {
 		return err
 	}
@@ -81,17 +110,17 @@ func (o *AddOptions) Validate() (err error) {
 // Run contains the logic for "odo registry add" command
 func (o *AddOptions) Run() (err error) {
 	isSecure := false
-	if o.tokenFlag != "" {
+	if o.secret() != "" {
 		isSecure = true
 	}
 
-	err = o.prefClient.RegistryHandler(o.operation, o.registryName, o.registryURL, false, isSecure)
+	err = o.prefClient.RegistryHandler(o.operation, o.registryName, o.registryURL, false, isSecure, o.registryAuth(), o.publicKeyFlag)
 	if err != nil {
 		return err
 	}
 
-	if o.tokenFlag != "" {
-		err = keyring.Set(util.CredentialPrefix+o.registryName, o.user, o.tokenFlag)
+	if isSecure {
+		err = keyring.Set(util.CredentialPrefix+o.registryName, o.user, o.secret())
 		if err != nil {
 			return errors.Wrap(err, "unable to store registry credential to keyring")
 		}
@@ -109,7 +138,7 @@ func NewCmdAdd(name, fullName string) *cobra.Command {
 	}
 	o := NewAddOptions(prefClient)
 	registryAddCmd := &cobra.Command{
-		Use:     fmt.Sprintf("%s <registry name> <registry URL>", name),
+		Use:     fmt.Sprintf("%s <registry name> <registry URL or directory>", name),
 		Short:   addLongDesc,
 		Long:    addLongDesc,
 		Example: fmt.Sprintf(fmt.Sprint(addExample), fullName),
@@ -119,7 +148,8 @@ func NewCmdAdd(name, fullName string) *cobra.Command {
 		},
 	}
 
-	registryAddCmd.Flags().StringVar(&o.tokenFlag, "token", "", "Token to be used to access secure registry")
+	o.authFlags.addFlags(registryAddCmd)
+	addPublicKeyFlag(registryAddCmd, &o.publicKeyFlag, "Path of the public key verifying the signatures of the stacks of the registry")
 
 	return registryAddCmd
 }
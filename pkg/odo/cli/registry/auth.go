@@ -0,0 +1,130 @@
+package registry
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	registryUtil "github.com/redhat-developer/odo/pkg/odo/cli/registry/util"
+	"github.com/redhat-developer/odo/pkg/preference"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+// authFlags are the flags configuring the authentication to a registry, shared by "odo registry add" and "odo registry update"
+type authFlags struct {
+	authTypeFlag   string
+	usernameFlag   string
+	passwordFlag   string
+	tokenFlag      string
+	clientCertFlag string
+	clientKeyFlag  string
+	caBundleFlag   string
+}
+
+// registryAuthNone is the authentication type removing the authentication of a registry
+const registryAuthNone = "none"
+
+// addFlags adds the authentication flags to the command
+func (f *authFlags) addFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&f.authTypeFlag, "auth-type", "", fmt.Sprintf("Authentication type of the registry (%s, or %s to remove the authentication), inferred from the credentials when not set", strings.Join(registryUtil.RegistryAuthTypes, ", "), registryAuthNone))
+	cmd.Flags().StringVar(&f.usernameFlag, "username", "", "Username to be used to access the registry with basic authentication")
+	cmd.Flags().StringVar(&f.passwordFlag, "password", "", "Password to be used to access the registry with basic authentication")
+	cmd.Flags().StringVar(&f.tokenFlag, "token", "", "Token to be used to access secure registry")
+	cmd.Flags().StringVar(&f.clientCertFlag, "client-cert", "", "Path of the client TLS certificate presented to the registry")
+	cmd.Flags().StringVar(&f.clientKeyFlag, "client-key", "", "Path of the private key of the client TLS certificate")
+	cmd.Flags().StringVar(&f.caBundleFlag, "ca-bundle", "", "Path of the bundle of certificate authorities trusted to verify the certificate of the registry")
+}
+
+// complete infers the authentication type from the credentials when it is not set, and makes the paths of the files absolute
+func (f *authFlags) complete() (err error) {
+	if f.authTypeFlag == "" {
+		switch {
+		case f.usernameFlag != "" || f.passwordFlag != "":
+			f.authTypeFlag = preference.RegistryAuthBasic
+		case f.tokenFlag != "":
+			f.authTypeFlag = preference.RegistryAuthBearer
+		}
+	}
+	for _, path := range []*string{&f.clientCertFlag, &f.clientKeyFlag, &f.caBundleFlag} {
+		if *path == "" {
+			continue
+		}
+		*path, err = filepath.Abs(*path)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validate checks that the credentials match the authentication type, and that the TLS files can be loaded
+func (f *authFlags) validate() error {
+	switch f.authTypeFlag {
+	case "":
+	case registryAuthNone:
+		if f.isSet() {
+			return errors.Errorf("the credentials and the TLS flags cannot be used with the authentication type %s", registryAuthNone)
+		}
+		return nil
+	case preference.RegistryAuthBasic:
+		if f.usernameFlag == "" || f.passwordFlag == "" {
+			return errors.New("both --username and --password are required for the basic authentication")
+		}
+		if f.tokenFlag != "" {
+			return errors.New("--token cannot be used with the basic authentication")
+		}
+	case preference.RegistryAuthBearer:
+		if f.tokenFlag == "" {
+			return errors.New("--token is required for the bearer authentication")
+		}
+		if f.usernameFlag != "" || f.passwordFlag != "" {
+			return errors.New("--username and --password cannot be used with the bearer authentication")
+		}
+	case preference.RegistryAuthDocker:
+		if f.usernameFlag != "" || f.passwordFlag != "" || f.tokenFlag != "" {
+			return errors.New("--username, --password and --token cannot be used with the docker authentication, the credentials are read from the docker configuration")
+		}
+	default:
+		return errors.Errorf("invalid authentication type %q, supported types: %s, %s", f.authTypeFlag, strings.Join(registryUtil.RegistryAuthTypes, ", "), registryAuthNone)
+	}
+
+	if f.clientCertFlag != "" || f.clientKeyFlag != "" || f.caBundleFlag != "" {
+		_, err := util.NewTLSConfig(f.clientCertFlag, f.clientKeyFlag, f.caBundleFlag)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isSet returns true when a credential or a TLS file is passed
+func (f *authFlags) isSet() bool {
+	return f.usernameFlag != "" || f.passwordFlag != "" || f.tokenFlag != "" || f.clientCertFlag != "" || f.clientKeyFlag != "" || f.caBundleFlag != ""
+}
+
+// registryAuth returns the authentication configuration of the registry to be stored in the preferences;
+// nil is returned without authentication, or with only a bearer token, as the secure registries always did
+func (f *authFlags) registryAuth() *preference.RegistryAuth {
+	tls := f.clientCertFlag != "" || f.clientKeyFlag != "" || f.caBundleFlag != ""
+	if !tls && (f.authTypeFlag == "" || f.authTypeFlag == registryAuthNone || f.authTypeFlag == preference.RegistryAuthBearer) {
+		return nil
+	}
+	return &preference.RegistryAuth{
+		Type:       f.authTypeFlag,
+		Username:   f.usernameFlag,
+		ClientCert: f.clientCertFlag,
+		ClientKey:  f.clientKeyFlag,
+		CABundle:   f.caBundleFlag,
+	}
+}
+
+// secret returns the secret of the registry to be stored in the keyring, the password or the token
+func (f *authFlags) secret() string {
+	if f.authTypeFlag == preference.RegistryAuthBasic {
+		return f.passwordFlag
+	}
+	return f.tokenFlag
+}
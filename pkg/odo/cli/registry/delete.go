@@ -70,7 +70,7 @@ func (o *DeleteOptions) Validate() (err error) {
 // Run contains the logic for "odo registry delete" command
 func (o *DeleteOptions) Run() (err error) {
 	isSecure := registryUtil.IsSecure(o.prefClient, o.registryName)
-	err = o.prefClient.RegistryHandler(o.operation, o.registryName, o.registryURL, o.forceFlag, false)
+	err = o.prefClient.RegistryHandler(o.operation, o.registryName, o.registryURL, o.forceFlag, false, nil, "")
 	if err != nil {
 		return err
 	}
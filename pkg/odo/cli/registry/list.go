@@ -89,7 +89,7 @@ func (o *ListOptions) printRegistryList(w io.Writer, registryList *[]preference.
 	for i := len(regList) - 1; i >= 0; i-- {
 		registry := regList[i]
 		secure := "No"
-		if registry.Secure {
+		if registry.Secure || registry.Auth != nil {
 			secure = "Yes"
 		}
 		fmt.Fprintln(w, registry.Name, "\t", registry.URL, "\t", secure)
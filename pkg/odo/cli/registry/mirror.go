@@ -0,0 +1,121 @@
+package registry
+
+import (
+	// Built-in packages
+	"fmt"
+	"path/filepath"
+
+	// Third-party packages
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	// odo packages
+	"github.com/redhat-developer/odo/pkg/catalog"
+	"github.com/redhat-developer/odo/pkg/log"
+	registryUtil "github.com/redhat-developer/odo/pkg/odo/cli/registry/util"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+)
+
+const mirrorCommandName = "mirror"
+
+// "odo registry mirror" command description and examples
+var (
+	mirrorLongDesc = ktemplates.LongDesc(`Mirror a devfile registry into a local directory.
+
+The index of the registry, the devfile and resources of each stack, and the archives of the starter projects
+are downloaded into the directory, which can then be added as a registry with a file:// URL, to create
+components without accessing the original registry.`)
+
+	mirrorExample = ktemplates.Examples(`# Mirror the default devfile registry into the /mnt/registry directory
+	%[1]s DefaultDevfileRegistry --to /mnt/registry
+
+	# Add the mirrored registry
+	odo registry add MirroredRegistry file:///mnt/registry
+	`)
+)
+
+// MirrorOptions encapsulates the options for the "odo registry mirror" command
+type MirrorOptions struct {
+	// Parameters
+	registryName string
+
+	// Flags
+	toFlag                 string
+	insecureSkipVerifyFlag bool
+
+	registry catalog.Registry
+}
+
+// NewMirrorOptions creates a new MirrorOptions instance
+func NewMirrorOptions() *MirrorOptions {
+	return &MirrorOptions{}
+}
+
+// Complete completes MirrorOptions after they've been created
+func (o *MirrorOptions) Complete(cmdline cmdline.Cmdline, args []string) (err error) {
+	o.registryName = args[0]
+	if o.toFlag != "" {
+		o.toFlag, err = filepath.Abs(o.toFlag)
+		if err != nil {
+			return err
+		}
+	}
+	registries, err := catalog.GetDevfileRegistries(o.registryName)
+	if err != nil {
+		return err
+	}
+	if len(registries) > 0 {
+		o.registry = registries[0]
+	}
+	return nil
+}
+
+// Validate validates the MirrorOptions based on completed values
+func (o *MirrorOptions) Validate() (err error) {
+	if o.registry.Name == "" {
+		return errors.Errorf("registry %s doesn't exist, please run `odo registry list` for the list of registries", o.registryName)
+	}
+	if o.toFlag == "" {
+		return errors.New("the directory to mirror the registry into must be specified with --to")
+	}
+	if registryUtil.IsFileBasedRegistry(o.registry.URL) && registryUtil.GetFileRegistryDir(o.registry.URL) == o.toFlag {
+		return errors.Errorf("the registry %s is already stored in the directory %s", o.registryName, o.toFlag)
+	}
+	return nil
+}
+
+// Run contains the logic for "odo registry mirror" command
+func (o *MirrorOptions) Run() (err error) {
+	result, err := catalog.MirrorRegistry(o.registry, o.toFlag, o.insecureSkipVerifyFlag)
+	if err != nil {
+		return err
+	}
+	for _, warning := range result.Warnings {
+		log.Warning(warning)
+	}
+	log.Successf("Mirrored %d stacks and %d starter projects of registry %s into %s", result.Stacks, result.StarterProjects, o.registryName, o.toFlag)
+	log.Italicf("\nPlease use `odo registry add <registry name> %s%s` to add the mirrored registry", registryUtil.FileRegistryPrefix, filepath.ToSlash(o.toFlag))
+	return nil
+}
+
+// NewCmdMirror implements the "odo registry mirror" command
+func NewCmdMirror(name, fullName string) *cobra.Command {
+	o := NewMirrorOptions()
+	registryMirrorCmd := &cobra.Command{
+		Use:     fmt.Sprintf("%s <registry name> --to <directory>", name),
+		Short:   "Mirror a devfile registry into a local directory",
+		Long:    mirrorLongDesc,
+		Example: fmt.Sprintf(fmt.Sprint(mirrorExample), fullName),
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+
+	registryMirrorCmd.Flags().StringVar(&o.toFlag, "to", "", "Directory to mirror the registry into")
+	registryMirrorCmd.Flags().BoolVar(&o.insecureSkipVerifyFlag, "insecure-skip-verify", false, "Mirror the stacks and the starter projects without verifying their checksums and signatures listed in the index of the registry")
+
+	return registryMirrorCmd
+}
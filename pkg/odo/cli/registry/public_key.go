@@ -0,0 +1,35 @@
+package registry
+
+import (
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+// publicKeyFlagName is the flag setting the public key verifying the signatures of the stacks of a registry
+const publicKeyFlagName = "public-key"
+
+// addPublicKeyFlag adds the public key flag to the command
+func addPublicKeyFlag(cmd *cobra.Command, publicKey *string, usage string) {
+	cmd.Flags().StringVar(publicKey, publicKeyFlagName, "", usage)
+}
+
+// completePublicKey makes the path of the public key absolute
+func completePublicKey(publicKey *string) (err error) {
+	if *publicKey == "" {
+		return nil
+	}
+	*publicKey, err = filepath.Abs(*publicKey)
+	return err
+}
+
+// validatePublicKey checks that the public key can be loaded
+func validatePublicKey(publicKey string) error {
+	if publicKey == "" {
+		return nil
+	}
+	_, err := util.LoadPublicKey(publicKey)
+	return err
+}
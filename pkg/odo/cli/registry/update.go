@@ -27,6 +27,12 @@ var (
 
 	updateExample = ktemplates.Examples(`# Update devfile registry URL
 	%[1]s CheRegistry https://che-devfile-registry-update.openshift.io
+
+	# Update devfile registry URL and its custom CA bundle
+	%[1]s MyRegistry https://myregistry.example.com --auth-type docker --ca-bundle ca.pem
+
+	# Update devfile registry URL and remove its authentication and its public key
+	%[1]s MyRegistry https://myregistry.example.com --auth-type none --public-key ""
 	`)
 )
 
@@ -40,8 +46,12 @@ type UpdateOptions struct {
 	registryURL  string
 
 	// Flags
-	tokenFlag string
-	forceFlag bool
+	authFlags
+	publicKeyFlag string
+	forceFlag     bool
+
+	// publicKeySet is true when the public key flag is passed, even empty to remove the public key
+	publicKeySet bool
 
 	operation string
 	user      string
@@ -58,14 +68,30 @@ func NewUpdateOptions(prefClient preference.Client) *UpdateOptions {
 func (o *UpdateOptions) Complete(cmdline cmdline.Cmdline, args []string) (err error) {
 	o.operation = "update"
 	o.registryName = args[0]
-	o.registryURL = args[1]
+	o.registryURL, err = registryUtil.ConvertPathToRegistryURL(args[1])
+	if err != nil {
+		return err
+	}
 	o.user = "default"
-	return nil
+	err = o.authFlags.complete()
+	if err != nil {
+		return err
+	}
+	o.publicKeySet = cmdline.IsFlagSet(publicKeyFlagName)
+	return completePublicKey(&o.publicKeyFlag)
 }
 
 // Validate validates the UpdateOptions based on completed values
 func (o *UpdateOptions) Validate() (err error) {
-	err = util.ValidateURL(o.registryURL)
+	err = registryUtil.ValidateRegistryURL(o.registryURL)
+	if err != nil {
+		return err
+	}
+	err = o.authFlags.validate()
+	if err != nil {
+		return err
+	}
+	err = validatePublicKey(o.publicKeyFlag)
 	if err != nil {
 		return err
 	}
@@ -75,31 +101,43 @@ func (o *UpdateOptions) Validate() (err error) {
 	return nil
 }
 
-// Run contains the logic for "odo registry update" command
+// Run contains the logic for "odo registry update" command;
+// the authentication and the public key of the registry are kept unless their flags are passed
 func (o *UpdateOptions) Run() (err error) {
-	secureBeforeUpdate := false
-	secureAfterUpdate := false
-
-	secure := registryUtil.IsSecure(o.prefClient, o.registryName)
-	if secure {
-		secureBeforeUpdate = true
+	var existing preference.Registry
+	if o.prefClient.RegistryList() != nil {
+		for _, registry := range *o.prefClient.RegistryList() {
+			if registry.Name == o.registryName {
+				existing = registry
+				break
+			}
+		}
 	}
 
-	if o.tokenFlag != "" {
-		secureAfterUpdate = true
+	authChanged := o.authTypeFlag != "" || o.isSet()
+	secureAfterUpdate, auth := existing.Secure, existing.Auth
+	if authChanged {
+		secureAfterUpdate, auth = o.secret() != "", o.registryAuth()
+	}
+	publicKey := existing.PublicKey
+	if o.publicKeySet {
+		publicKey = o.publicKeyFlag
 	}
 
-	err = o.prefClient.RegistryHandler(o.operation, o.registryName, o.registryURL, o.forceFlag, secureAfterUpdate)
+	err = o.prefClient.RegistryHandler(o.operation, o.registryName, o.registryURL, o.forceFlag, secureAfterUpdate, auth, publicKey)
 	if err != nil {
 		return err
 	}
 
+	if !authChanged {
+		return nil
+	}
 	if secureAfterUpdate {
-		err = keyring.Set(util.CredentialPrefix+o.registryName, o.user, o.tokenFlag)
+		err = keyring.Set(util.CredentialPrefix+o.registryName, o.user, o.secret())
 		if err != nil {
 			return errors.Wrap(err, "unable to store registry credential to keyring")
 		}
-	} else if secureBeforeUpdate && !secureAfterUpdate {
+	} else if existing.Secure {
 		err = keyring.Delete(util.CredentialPrefix+o.registryName, o.user)
 		if err != nil {
 			return errors.Wrap(err, "unable to delete registry credential from keyring")
@@ -117,7 +155,7 @@ func NewCmdUpdate(name, fullName string) *cobra.Command {
 	}
 	o := NewUpdateOptions(prefClient)
 	registryUpdateCmd := &cobra.Command{
-		Use:     fmt.Sprintf("%s <registry name> <registry URL>", name),
+		Use:     fmt.Sprintf("%s <registry name> <registry URL or directory>", name),
 		Short:   updateLongDesc,
 		Long:    updateLongDesc,
 		Example: fmt.Sprintf(fmt.Sprint(updateExample), fullName),
@@ -127,7 +165,8 @@ func NewCmdUpdate(name, fullName string) *cobra.Command {
 		},
 	}
 
-	registryUpdateCmd.Flags().StringVar(&o.tokenFlag, "token", "", "Token to be used to access secure registry")
+	o.authFlags.addFlags(registryUpdateCmd)
+	addPublicKeyFlag(registryUpdateCmd, &o.publicKeyFlag, "Path of the public key verifying the signatures of the stacks of the registry, empty to remove the public key; the public key is kept when not set")
 	registryUpdateCmd.Flags().BoolVarP(&o.forceFlag, "force", "f", false, "Don't ask for confirmation, update the registry directly")
 
 	return registryUpdateCmd
@@ -0,0 +1,87 @@
+package util
+
+import (
+	"io/ioutil"
+	"net/url"
+
+	"github.com/pkg/errors"
+	"github.com/zalando/go-keyring"
+
+	"github.com/redhat-developer/odo/pkg/preference"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+// RegistryAuthTypes are the supported authentication types of the registries
+var RegistryAuthTypes = []string{preference.RegistryAuthBasic, preference.RegistryAuthBearer, preference.RegistryAuthDocker}
+
+// GetRegistryAuth returns the authentication configuration of the registry, a secure registry without
+// authentication configuration using a bearer token; nil is returned for registries without authentication
+func GetRegistryAuth(prefClient preference.Client, registryName string) *preference.RegistryAuth {
+	if prefClient.RegistryList() == nil {
+		return nil
+	}
+	for _, registry := range *prefClient.RegistryList() {
+		if registry.Name != registryName {
+			continue
+		}
+		if registry.Auth != nil {
+			return registry.Auth
+		}
+		if registry.Secure {
+			return &preference.RegistryAuth{Type: preference.RegistryAuthBearer}
+		}
+		return nil
+	}
+	return nil
+}
+
+// GetRegistryCredentials returns the credentials and the TLS configuration used to access the index, the devfiles and
+// the starter projects of the registry, as configured by its authentication; the secrets are read from the keyring
+func GetRegistryCredentials(prefClient preference.Client, registryName, registryURL string) (util.HTTPCredentials, error) {
+	var credentials util.HTTPCredentials
+	auth := GetRegistryAuth(prefClient, registryName)
+	if auth == nil {
+		return credentials, nil
+	}
+
+	switch auth.Type {
+	case preference.RegistryAuthBasic, preference.RegistryAuthBearer:
+		secret, err := keyring.Get(util.CredentialPrefix+registryName, RegistryUser)
+		if err != nil {
+			return credentials, errors.Wrap(err, "unable to get secure registry credential from keyring")
+		}
+		if auth.Type == preference.RegistryAuthBasic {
+			credentials.Username = auth.Username
+			credentials.Password = secret
+		} else {
+			credentials.Token = secret
+		}
+	case preference.RegistryAuthDocker:
+		if !IsFileBasedRegistry(registryURL) {
+			urlObj, err := url.Parse(registryURL)
+			if err != nil {
+				return credentials, err
+			}
+			credentials.Username, credentials.Password, err = util.GetDockerCredentials(urlObj.Host)
+			if err != nil {
+				return credentials, err
+			}
+		}
+	}
+
+	if auth.ClientCert != "" || auth.ClientKey != "" || auth.CABundle != "" {
+		tlsConfig, err := util.NewTLSConfig(auth.ClientCert, auth.ClientKey, auth.CABundle)
+		if err != nil {
+			return credentials, errors.Wrapf(err, "invalid TLS configuration of registry %s", registryName)
+		}
+		credentials.TLSConfig = tlsConfig
+	}
+	if auth.CABundle != "" {
+		caBundle, err := ioutil.ReadFile(auth.CABundle)
+		if err != nil {
+			return credentials, errors.Wrapf(err, "unable to read the CA bundle of registry %s", registryName)
+		}
+		credentials.CABundle = string(caBundle)
+	}
+	return credentials, nil
+}
@@ -0,0 +1,91 @@
+package util
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/zalando/go-keyring"
+
+	"github.com/redhat-developer/odo/pkg/preference"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+func TestGetRegistryCredentials(t *testing.T) {
+	keyring.MockInit()
+	for name, secret := range map[string]string{"TokenRegistry": "token", "BasicRegistry": "password"} {
+		if err := keyring.Set(util.CredentialPrefix+name, RegistryUser, secret); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	dockerConfigDir, err := ioutil.TempDir("", "odo-docker-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dockerConfigDir)
+	err = ioutil.WriteFile(filepath.Join(dockerConfigDir, "config.json"), []byte(`{"auths": {"oci.example.com": {"auth": "cm9ib3Q6czNjcjN0"}}}`), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.Setenv("DOCKER_CONFIG", dockerConfigDir)
+	defer os.Unsetenv("DOCKER_CONFIG")
+
+	registries := []preference.Registry{
+		{Name: "PublicRegistry", URL: "https://public.example.com"},
+		{Name: "TokenRegistry", URL: "https://token.example.com", Secure: true},
+		{Name: "BasicRegistry", URL: "https://basic.example.com", Secure: true, Auth: &preference.RegistryAuth{Type: preference.RegistryAuthBasic, Username: "user"}},
+		{Name: "DockerRegistry", URL: "https://oci.example.com", Auth: &preference.RegistryAuth{Type: preference.RegistryAuthDocker}},
+		{Name: "TLSRegistry", URL: "https://tls.example.com", Auth: &preference.RegistryAuth{CABundle: filepath.Join(dockerConfigDir, "missing.pem")}},
+	}
+
+	tests := []struct {
+		name     string
+		registry preference.Registry
+		want     util.HTTPCredentials
+		wantErr  bool
+	}{
+		{
+			name:     "registry without authentication",
+			registry: registries[0],
+		},
+		{
+			name:     "secure registry with a token",
+			registry: registries[1],
+			want:     util.HTTPCredentials{Token: "token"},
+		},
+		{
+			name:     "registry with basic authentication",
+			registry: registries[2],
+			want:     util.HTTPCredentials{Username: "user", Password: "password"},
+		},
+		{
+			name:     "registry with docker authentication",
+			registry: registries[3],
+			want:     util.HTTPCredentials{Username: "robot", Password: "s3cr3t"},
+		},
+		{
+			name:     "registry with missing CA bundle",
+			registry: registries[4],
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			prefClient := preference.NewMockClient(ctrl)
+			prefClient.EXPECT().RegistryList().Return(&registries).AnyTimes()
+
+			got, err := GetRegistryCredentials(prefClient, tt.registry.Name, tt.registry.URL)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error: %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("got credentials %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -3,14 +3,25 @@ package util
 import (
 	// odo packages
 
+	"fmt"
+	"path/filepath"
 	"strings"
 
 	"github.com/redhat-developer/odo/pkg/log"
 	"github.com/redhat-developer/odo/pkg/preference"
+	"github.com/redhat-developer/odo/pkg/util"
 )
 
 const (
 	RegistryUser = "default"
+
+	// FileRegistryPrefix is the prefix of the URLs of the registries stored in a local directory
+	FileRegistryPrefix = "file://"
+	// FileRegistryIndex is the file containing the index of a registry stored in a local directory
+	FileRegistryIndex = "index.json"
+	// FileRegistryStacksDir is the directory of a registry stored in a local directory containing a directory for each
+	// stack, with the devfile and the resources of the stack
+	FileRegistryStacksDir = "stacks"
 )
 
 // IsSecure checks if the registry is secure
@@ -35,3 +46,52 @@ func IsGitBasedRegistry(url string) bool {
 func PrintGitRegistryDeprecationWarning() {
 	log.Deprecate("Git based registries", "Please see https://github.com/redhat-developer/odo/tree/main/docs/public/git-registry-deprecation.adoc")
 }
+
+// IsFileBasedRegistry checks if the registry is stored in a local directory
+func IsFileBasedRegistry(url string) bool {
+	return strings.HasPrefix(url, FileRegistryPrefix)
+}
+
+// GetFileRegistryDir returns the local directory of a file based registry
+func GetFileRegistryDir(url string) string {
+	return filepath.FromSlash(strings.TrimPrefix(url, FileRegistryPrefix))
+}
+
+// ConvertPathToRegistryURL converts the path of a local directory, absolute or relative to the current directory,
+// to the URL of a file based registry; the argument is considered as a path only when it exists or starts with
+// ".", "~" or a path separator, and is returned unchanged otherwise to be validated as a URL
+func ConvertPathToRegistryURL(url string) (string, error) {
+	if strings.Contains(url, "://") || !isLocalPath(url) {
+		return url, nil
+	}
+	dir, err := util.GetAbsPath(url)
+	if err != nil {
+		return "", err
+	}
+	return FileRegistryPrefix + filepath.ToSlash(dir), nil
+}
+
+// isLocalPath checks if the argument designates a local path rather than a URL without scheme
+func isLocalPath(path string) bool {
+	return util.CheckPathExists(path) ||
+		strings.HasPrefix(path, ".") ||
+		strings.HasPrefix(path, "~") ||
+		strings.HasPrefix(path, "/") ||
+		filepath.IsAbs(path)
+}
+
+// ValidateRegistryURL validates the URL of a registry: a file based registry must be a directory containing an index
+// or a directory of stacks, any other registry a valid remote URL
+func ValidateRegistryURL(url string) error {
+	if !IsFileBasedRegistry(url) {
+		return util.ValidateURL(url)
+	}
+	dir := GetFileRegistryDir(url)
+	if !filepath.IsAbs(dir) {
+		return fmt.Errorf("the directory %q of the registry must be an absolute path", dir)
+	}
+	if util.CheckPathExists(filepath.Join(dir, FileRegistryIndex)) || util.CheckPathExists(filepath.Join(dir, FileRegistryStacksDir)) {
+		return nil
+	}
+	return fmt.Errorf("the directory %q is not a devfile registry, it contains neither an %s file nor a %s directory", dir, FileRegistryIndex, FileRegistryStacksDir)
+}
@@ -3,6 +3,7 @@ package util
 import (
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 
@@ -57,7 +58,7 @@ func TestIsSecure(t *testing.T) {
 			if err != nil {
 				t.Errorf("Unable to get preference file with error: %v", err)
 			}
-			err = cfg.RegistryHandler(tt.registryOperation, tt.registryName, tt.registryURL, tt.forceFlag, tt.isSecure)
+			err = cfg.RegistryHandler(tt.registryOperation, tt.registryName, tt.registryURL, tt.forceFlag, tt.isSecure, nil, "")
 			if err != nil {
 				t.Errorf("Unable to add registry to preference file with error: %v", err)
 			}
@@ -101,3 +102,99 @@ func TestIsGitBasedRegistry(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateRegistryURL(t *testing.T) {
+	registryDir, err := ioutil.TempDir("", "odo-registry")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(registryDir)
+	err = ioutil.WriteFile(filepath.Join(registryDir, FileRegistryIndex), []byte("[]"), 0640)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stacksDir, err := ioutil.TempDir("", "odo-registry")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(stacksDir)
+	err = os.Mkdir(filepath.Join(stacksDir, FileRegistryStacksDir), 0750)
+	if err != nil {
+		t.Fatal(err)
+	}
+	emptyDir, err := ioutil.TempDir("", "odo-registry")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(emptyDir)
+
+	tests := []struct {
+		name    string
+		url     string
+		wantErr bool
+	}{
+		{name: "remote registry", url: "https://registry.devfile.io"},
+		{name: "invalid remote URL", url: "registry.devfile.io", wantErr: true},
+		{name: "directory containing an index", url: FileRegistryPrefix + filepath.ToSlash(registryDir)},
+		{name: "directory containing stacks", url: FileRegistryPrefix + filepath.ToSlash(stacksDir)},
+		{name: "directory without index nor stacks", url: FileRegistryPrefix + filepath.ToSlash(emptyDir), wantErr: true},
+		{name: "relative directory", url: FileRegistryPrefix + "registry", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateRegistryURL(tt.url)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got error %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestConvertPathToRegistryURL(t *testing.T) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpDir := t.TempDir()
+	err = os.Mkdir(filepath.Join(tmpDir, "registry"), 0750)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.Chdir(tmpDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := os.Chdir(wd); err != nil {
+			t.Error(err)
+		}
+	}()
+	// the temporary directory may be a symbolic link
+	tmpDir, err = os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{url: "https://registry.devfile.io", want: "https://registry.devfile.io"},
+		{url: "file:///home/user/registry", want: "file:///home/user/registry"},
+		{url: "registry", want: FileRegistryPrefix + filepath.ToSlash(filepath.Join(tmpDir, "registry"))},
+		{url: "./other", want: FileRegistryPrefix + filepath.ToSlash(filepath.Join(tmpDir, "other"))},
+		{url: "/home/user/registry", want: "file:///home/user/registry"},
+		{url: "registry.devfile.io", want: "registry.devfile.io"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			got, err := ConvertPathToRegistryURL(tt.url)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -18,11 +18,23 @@ const (
 
 var (
 	createOperatorExample = ktemplates.Examples(`
+	# Create new service interactively, by selecting the operator and the kind of service and entering the parameters of the service
+	%[1]s
+
 	# Create new EtcdCluster service from etcdoperator.v0.9.4 operator.
 	%[1]s etcdoperator.v0.9.4/EtcdCluster
 	
 	# Create new EtcdCluster service from etcdoperator.v0.9.4 operator and puts the service definition in the devfile instead of a separate file.
-	%[1]s etcdoperator.v0.9.4/EtcdCluster --inlined`)
+	%[1]s etcdoperator.v0.9.4/EtcdCluster --inlined
+
+	# Create new mydb service from the postgresql chart of the bitnami Helm chart repository, setting the values of the chart
+	%[1]s helm:bitnami/postgresql mydb -p auth.username=admin -p primary.persistence.enabled=false
+
+	# Create new mydb service from the version 10.3.0 of the postgresql chart of a local Helm chart repository
+	%[1]s helm:./charts/postgresql@10.3.0 mydb
+
+	# Create new mydb service from the postgres-small template of the team source, overriding one of its parameters
+	%[1]s --template team/postgres-small mydb -p postgresVersion=14`)
 
 	createShortDesc = `Create a new service from Operator Hub and deploy it on Kubernetes or OpenShift.`
 
@@ -35,7 +47,17 @@ To create the service from outside a component directory, specify path to a vali
 
 When creating a service using Operator Hub, provide a service name along with Operator name.
 
-For a full list of service types, use: 'odo catalog list services'`)
+When no Operator name is given, the service is created interactively: odo asks for the Operator, the kind of service, its name
+and the values of the parameters of the service, required ones first, based on the schema of the service.
+
+For a full list of service types, use: 'odo catalog list services'
+
+A service can also be created from a Helm chart, using "helm:<repository>/<chart>[@<version>]" as service type, where the
+repository is either a chart repository configured in Helm with "helm repo add" or a local directory containing an index.yaml file.
+The chart is rendered with the values given as parameters, and the resulting resources are added to the configuration.
+
+A service can also be created from a service template using "--template <source>/<name>", the parameters given overriding
+the values defined by the template. Use 'odo catalog list services --templates' to list the available templates.`)
 )
 
 // CreateOptions encapsulates the options for the odo service create command
@@ -49,6 +71,7 @@ type CreateOptions struct {
 	contextFlag    string
 	DryRunFlag     bool
 	fromFileFlag   string
+	templateFlag   string
 	inlinedFlag    bool
 
 	// ServiceType corresponds to the service class name
@@ -87,12 +110,13 @@ func (o *CreateOptions) Complete(cmdline cmdline.Cmdline, args []string) (err er
 	if err != nil {
 		return err
 	}
-	//if no args are provided and if request is not from file, user wants interactive mode
-	if o.fromFileFlag == "" && len(args) == 0 {
-		return fmt.Errorf("odo doesn't support interactive mode for creating Operator backed service")
+	// if no args are provided and if request is not from file or template, user wants interactive mode
+	o.interactive = o.fromFileFlag == "" && o.templateFlag == "" && len(args) == 0
+	if len(args) > 0 && isHelmServiceType(args[0]) {
+		o.Backend = NewHelmBackend()
+	} else {
+		o.Backend = NewOperatorBackend()
 	}
-	o.Backend = NewOperatorBackend()
-	o.interactive = false
 	return o.Backend.CompleteServiceCreate(o, args)
 }
 
@@ -121,7 +145,7 @@ func NewCmdServiceCreate(name, fullName string) *cobra.Command {
 	o := NewCreateOptions()
 	o.CmdFullName = fullName
 	serviceCreateCmd := &cobra.Command{
-		Use:         name + " <operator_type>/<crd_name> [service_name] [flags]",
+		Use:         name + " <operator_type>/<crd_name> | helm:<repository>/<chart>[@<version>] | --template <source>/<name> [service_name] [flags]",
 		Short:       createShortDesc,
 		Long:        createLongDesc,
 		Example:     fmt.Sprintf(createOperatorExample, fullName),
@@ -134,10 +158,10 @@ func NewCmdServiceCreate(name, fullName string) *cobra.Command {
 
 	serviceCreateCmd.Flags().BoolVar(&o.inlinedFlag, "inlined", false, "Puts the service definition in the devfile instead of a separate file")
 	serviceCreateCmd.Flags().BoolVar(&o.DryRunFlag, "dry-run", false, "Print the yaml specificiation that will be used to create the operator backed service")
-	// remove this feature after enabling service create interactive mode for operator backed services
 	serviceCreateCmd.Flags().StringVar(&o.fromFileFlag, "from-file", "", "Path to the file containing yaml specification to use to start operator backed service")
+	serviceCreateCmd.Flags().StringVar(&o.templateFlag, "template", "", "Name of the service template to create the operator backed service from, of the form <source>/<name>")
 
-	serviceCreateCmd.Flags().StringArrayVarP(&o.parametersFlag, "parameters", "p", []string{}, "Parameters to be used to create Operator backed service where a parameter is expressed as <key>=<value")
+	serviceCreateCmd.Flags().StringArrayVarP(&o.parametersFlag, "parameters", "p", []string{}, "Parameters to be used to create the service where a parameter is expressed as <key>=<value>; for Operator backed services, the key can contain list indexes (e.g. nodes[0].size) and the value can be a JSON object or array, or @<file> to load it from a YAML or JSON file")
 	serviceCreateCmd.Flags().BoolVarP(&o.waitFlag, "wait", "w", false, "Wait until the service is ready")
 	odoutil.AddContextFlag(serviceCreateCmd, &o.contextFlag)
 	return serviceCreateCmd
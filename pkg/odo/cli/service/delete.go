@@ -65,7 +65,7 @@ func (o *DeleteOptions) Complete(cmdline cmdline.Cmdline, args []string) (err er
 	if err != nil {
 		return fmt.Errorf("invalid service name")
 	}
-	o.Backend = NewOperatorBackend()
+	o.Backend = getServiceBackend(o.serviceName)
 
 	return nil
 }
@@ -7,7 +7,6 @@ import (
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
 	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
 	"github.com/redhat-developer/odo/pkg/service"
-	svc "github.com/redhat-developer/odo/pkg/service"
 	"github.com/spf13/cobra"
 	ktemplates "k8s.io/kubectl/pkg/util/templates"
 )
@@ -59,7 +58,7 @@ func (o *DescribeOptions) Complete(cmdline cmdline.Cmdline, args []string) (err
 	if err != nil {
 		return fmt.Errorf("invalid service name")
 	}
-	o.Backend = NewOperatorBackend()
+	o.Backend = getServiceBackend(o.serviceName)
 
 	return nil
 }
@@ -71,7 +70,7 @@ func (o *DescribeOptions) Validate() error {
 		return err
 	}
 
-	svcDeployed, err := svc.OperatorSvcExists(o.KClient, o.serviceName)
+	svcDeployed, err := o.Backend.ServiceDeployed(o.Context, o.serviceName)
 	if err != nil {
 		return err
 	}
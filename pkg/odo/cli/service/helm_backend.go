@@ -0,0 +1,254 @@
+/*
+	This file contains the code of the Helm backend, creating services from the charts of Helm chart repositories.
+	The charts are rendered by odo, and the resulting manifests are stored in the devfile as Kubernetes components.
+*/
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+	"github.com/redhat-developer/odo/pkg/devfile"
+	"github.com/redhat-developer/odo/pkg/helm"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/machineoutput"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	svc "github.com/redhat-developer/odo/pkg/service"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// helmPrefix is the prefix of the service types provided by the Helm backend, e.g. helm:bitnami/postgresql
+const helmPrefix = "helm:"
+
+// isHelmServiceType returns true if the service type refers to a Helm chart
+func isHelmServiceType(serviceType string) bool {
+	return strings.HasPrefix(serviceType, helmPrefix)
+}
+
+// CompleteServiceCreate contains logic to complete the "odo service create" call for the case of Helm backend
+func (b *HelmBackend) CompleteServiceCreate(o *CreateOptions, args []string) (err error) {
+	o.interactive = false
+
+	if o.fromFileFlag != "" {
+		return fmt.Errorf("the %q flag is not supported for Helm charts", "--from-file")
+	}
+	if o.templateFlag != "" {
+		return fmt.Errorf("the %q flag is not supported for Helm charts", "--template")
+	}
+
+	// the service type is of the form helm:<repository>/<chart>[@<version>]
+	chartRef := strings.TrimPrefix(args[0], helmPrefix)
+	invalidErr := fmt.Errorf("invalid chart name, use the format %s<repository>/<chart>[@<version>]", helmPrefix)
+	if i := strings.LastIndex(chartRef, "@"); i != -1 {
+		chartRef, b.Version = chartRef[:i], chartRef[i+1:]
+		if b.Version == "" {
+			return invalidErr
+		}
+	}
+	i := strings.LastIndex(chartRef, "/")
+	if i <= 0 || i == len(chartRef)-1 {
+		return invalidErr
+	}
+	b.Repository, b.Chart = chartRef[:i], chartRef[i+1:]
+	o.ServiceType = b.Chart
+
+	if len(args) == 2 {
+		o.ServiceName = args[1]
+	} else {
+		o.ServiceName = b.Chart
+	}
+	return nil
+}
+
+// ValidateServiceCreate renders the chart with the parameters given by the user
+func (b *HelmBackend) ValidateServiceCreate(o *CreateOptions) error {
+	if !o.DryRunFlag {
+		defined, err := b.ServiceDefined(o.Context, svc.HelmReleaseKind+"/"+o.ServiceName)
+		if err != nil {
+			return err
+		}
+		deployed, err := b.ServiceDeployed(o.Context, svc.HelmReleaseKind+"/"+o.ServiceName)
+		if err != nil {
+			return err
+		}
+		if defined || deployed {
+			return fmt.Errorf("service %q already exists; please provide a different name or delete the existing service first", svc.HelmReleaseKind+"/"+o.ServiceName)
+		}
+	}
+
+	repository, err := helm.GetRepository(b.Repository)
+	if err != nil {
+		return err
+	}
+	chartVersion, err := repository.FindChart(b.Chart, b.Version)
+	if err != nil {
+		return err
+	}
+	archive, err := repository.FetchChart(chartVersion)
+	if err != nil {
+		return err
+	}
+	chart, err := helm.LoadArchive(archive)
+	if err != nil {
+		return err
+	}
+
+	values, err := helm.ParseValues(o.ParametersMap)
+	if err != nil {
+		return err
+	}
+	release := helm.Release{Name: o.ServiceName, Namespace: o.KClient.GetCurrentNamespace()}
+	b.Manifests, err = helm.Render(chart, release, values, helm.DefaultCapabilities)
+	if err != nil {
+		return errors.Wrapf(err, "unable to render the chart %q", chart.String())
+	}
+	if len(b.Manifests) == 0 {
+		return fmt.Errorf("the chart %q doesn't render any resource with the given parameters", chart.String())
+	}
+
+	chartName := fmt.Sprintf("%s/%s@%s", b.Repository, chart.Metadata.Name, chart.Metadata.Version)
+	for i := range b.Manifests {
+		labels := b.Manifests[i].GetLabels()
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		labels[svc.HelmReleaseLabel] = o.ServiceName
+		b.Manifests[i].SetLabels(labels)
+
+		annotations := b.Manifests[i].GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[svc.HelmChartAnnotation] = chartName
+		b.Manifests[i].SetAnnotations(annotations)
+	}
+	return nil
+}
+
+// RunServiceCreate adds the resources rendered from the chart to the devfile, or prints them if "--dry-run" was requested
+func (b *HelmBackend) RunServiceCreate(o *CreateOptions) error {
+	if o.DryRunFlag {
+		var documents []string
+		for _, manifest := range b.Manifests {
+			manifestYaml, err := yaml.Marshal(manifest.Object)
+			if err != nil {
+				return err
+			}
+			documents = append(documents, string(manifestYaml))
+		}
+		log.Info(strings.Join(documents, "---\n"))
+		return nil
+	}
+
+	devfileObj := o.EnvSpecificInfo.GetDevfileObj()
+	for _, manifest := range b.Manifests {
+		manifestYaml, err := yaml.Marshal(manifest.Object)
+		if err != nil {
+			return err
+		}
+		name := svc.GetHelmReleaseComponentName(o.ServiceName, manifest)
+		if o.inlinedFlag {
+			err = devfile.AddKubernetesComponentToDevfile(string(manifestYaml), name, devfileObj)
+		} else {
+			err = devfile.AddKubernetesComponent(string(manifestYaml), name, o.contextFlag, devfileObj)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if log.IsJSON() {
+		item := NewServiceItem(svc.HelmReleaseKind + "/" + o.ServiceName)
+		releases, err := svc.ListDevfileHelmReleases(devfileObj, o.contextFlag)
+		if err != nil {
+			return err
+		}
+		item.Manifest = releases[item.Name].Object
+		item.InDevfile = true
+		machineoutput.OutputSuccess(item)
+	}
+	return nil
+}
+
+// ServiceDefined returns true if the resources of the Helm release are defined in the devfile
+func (b *HelmBackend) ServiceDefined(ctx *genericclioptions.Context, name string) (bool, error) {
+	releases, err := svc.ListDevfileHelmReleases(ctx.EnvSpecificInfo.GetDevfileObj(), ctx.GetComponentContext())
+	if err != nil {
+		return false, err
+	}
+	_, found := releases[name]
+	return found, nil
+}
+
+// ServiceDeployed returns true if the Helm release has been pushed to the cluster
+func (b *HelmBackend) ServiceDeployed(ctx *genericclioptions.Context, name string) (bool, error) {
+	_, releaseName, err := svc.SplitServiceKindName(name)
+	if err != nil {
+		return false, err
+	}
+	return svc.HelmReleaseExists(ctx.KClient, releaseName)
+}
+
+// UpdateService is not supported for Helm releases, the values used to render the chart not being stored
+func (b *HelmBackend) UpdateService(o *UpdateOptions, name string) error {
+	return fmt.Errorf("updating a Helm release is not supported; delete the service and create it again with the new values")
+}
+
+// DeleteService deletes the resources of the Helm release from the devfile
+func (b *HelmBackend) DeleteService(o *DeleteOptions, name string, application string) error {
+	_, releaseName, err := svc.SplitServiceKindName(name)
+	if err != nil {
+		return err
+	}
+
+	componentNames, err := svc.FindDevfileHelmReleaseComponents(o.EnvSpecificInfo.GetDevfileObj(), releaseName, o.contextFlag)
+	if err != nil {
+		return err
+	}
+	for _, componentName := range componentNames {
+		err = devfile.DeleteKubernetesComponentFromDevfile(componentName, o.EnvSpecificInfo.GetDevfileObj(), o.contextFlag)
+		if err != nil {
+			return errors.Wrap(err, "failed to delete service from the devfile")
+		}
+	}
+	return nil
+}
+
+// DescribeService describes the Helm release, as defined in the devfile or pushed to the cluster
+func (b *HelmBackend) DescribeService(o *DescribeOptions, serviceName, app string) error {
+	clusterList, err := svc.ListDeployedHelmReleases(o.KClient)
+	if err != nil {
+		return err
+	}
+	var clusterFound *unstructured.Unstructured
+	for i, clusterInstance := range clusterList {
+		if svc.HelmReleaseKind+"/"+clusterInstance.GetName() == serviceName {
+			clusterFound = &clusterList[i]
+			break
+		}
+	}
+
+	devfileList, err := svc.ListDevfileHelmReleases(o.EnvSpecificInfo.GetDevfileObj(), o.contextFlag)
+	if err != nil {
+		return err
+	}
+	devfileRelease, inDevfile := devfileList[serviceName]
+
+	item := NewServiceItem(serviceName)
+	item.InDevfile = inDevfile
+	item.Deployed = clusterFound != nil
+	if item.InDevfile {
+		item.Manifest = devfileRelease.Object
+	} else if item.Deployed {
+		item.Manifest = clusterFound.Object
+	}
+
+	if log.IsJSON() {
+		machineoutput.OutputSuccess(item)
+		return nil
+	}
+
+	return PrintHumanReadableOutput(item)
+}
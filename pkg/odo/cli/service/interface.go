@@ -5,13 +5,15 @@ import (
 )
 
 // ServiceProviderBackend is implemented by the backends supported by odo
-// It is used in "odo service create" and "odo service delete"
+// It is used in "odo service create", "odo service update", "odo service delete" and "odo service describe"
 type ServiceProviderBackend interface {
 	CompleteServiceCreate(options *CreateOptions, args []string) error
 	ValidateServiceCreate(options *CreateOptions) error
 	RunServiceCreate(options *CreateOptions) error
 
 	ServiceDefined(context *genericclioptions.Context, name string) (bool, error)
+	ServiceDeployed(context *genericclioptions.Context, name string) (bool, error)
+	UpdateService(options *UpdateOptions, serviceName string) error
 	DeleteService(options *DeleteOptions, serviceName, app string) error
 	DescribeService(options *DescribeOptions, serviceName, app string) error
 }
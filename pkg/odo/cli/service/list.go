@@ -29,7 +29,7 @@ type ServiceListOptions struct {
 	// Flags
 	contextFlag string
 
-	// If true, Operator Hub is installed on the cluster
+	// If true, Operator Hub is installed on the cluster, and the Operator backed services are listed
 	csvSupport bool
 }
 
@@ -50,10 +50,6 @@ func (o *ServiceListOptions) Complete(cmdline cmdline.Cmdline, args []string) (e
 		return err
 	}
 
-	if !o.csvSupport {
-		return fmt.Errorf("failed to list Operator backed services, make sure you have installed the Operators on the cluster")
-	}
-
 	return nil
 }
 
@@ -64,7 +60,7 @@ func (o *ServiceListOptions) Validate() (err error) {
 
 // Run contains the logic for the odo service list command
 func (o *ServiceListOptions) Run() (err error) {
-	return o.listOperatorServices()
+	return o.listServices()
 }
 
 // NewCmdServiceList implements the odo service list command.
@@ -8,6 +8,7 @@ import (
 	"text/tabwriter"
 	"time"
 
+	olm "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	applabels "github.com/redhat-developer/odo/pkg/application/labels"
 	cmplabels "github.com/redhat-developer/odo/pkg/component/labels"
 	"github.com/redhat-developer/odo/pkg/log"
@@ -31,6 +32,7 @@ type serviceItem struct {
 	InDevfile         bool                   `json:"inDevfile"`
 	Deployed          bool                   `json:"deployed"`
 	Manifest          map[string]interface{} `json:"manifest"`
+	Status            *svc.ServiceStatus     `json:"status,omitempty"`
 }
 
 type serviceItemList struct {
@@ -51,17 +53,25 @@ func NewServiceItem(name string) *serviceItem {
 	}
 }
 
-// listOperatorServices lists the operator backed services
+// listServices lists the operator backed services and the Helm releases
 // - deployed in the cluster
 // - defined in the current devfile
-func (o *ServiceListOptions) listOperatorServices() (err error) {
+func (o *ServiceListOptions) listServices() (err error) {
 
 	// get the services deployed
 	var clusterList []unstructured.Unstructured
-	clusterList, failedListingCR, err := svc.ListOperatorServices(o.KClient)
+	var failedListingCR []string
+	if o.csvSupport {
+		clusterList, failedListingCR, err = svc.ListOperatorServices(o.KClient)
+		if err != nil {
+			return err
+		}
+	}
+	helmReleases, err := svc.ListDeployedHelmReleases(o.KClient)
 	if err != nil {
 		return err
 	}
+	clusterList = append(clusterList, helmReleases...)
 
 	// get the services defined in the devfile
 	// and the name of the component of the devfile
@@ -72,15 +82,29 @@ func (o *ServiceListOptions) listOperatorServices() (err error) {
 		if err != nil {
 			return fmt.Errorf("error reading devfile")
 		}
+		devfileHelmReleases, err := svc.ListDevfileHelmReleases(o.EnvSpecificInfo.GetDevfileObj(), o.contextFlag)
+		if err != nil {
+			return fmt.Errorf("error reading devfile")
+		}
+		if devfileList == nil {
+			devfileList = map[string]unstructured.Unstructured{}
+		}
+		for name, release := range devfileHelmReleases {
+			devfileList[name] = release
+		}
 		devfileComponent = o.EnvSpecificInfo.GetComponentSettings().Name
 	}
 
 	servicesItems := mixServices(clusterList, devfileList)
+	o.setServicesStatus(servicesItems.Items)
 
 	if len(servicesItems.Items) == 0 {
 		if len(failedListingCR) > 0 {
 			fmt.Printf("Failed to fetch services for operator(s): %q\n\n", strings.Join(failedListingCR, ", "))
 		}
+		if !o.csvSupport {
+			return fmt.Errorf("no Helm releases found in namespace %s, and failed to list Operator backed services, make sure you have installed the Operators on the cluster", o.KClient.GetCurrentNamespace())
+		}
 		return fmt.Errorf("no operator backed services found in namespace: %s", o.KClient.GetCurrentNamespace())
 	}
 
@@ -91,11 +115,15 @@ func (o *ServiceListOptions) listOperatorServices() (err error) {
 
 	// output result
 	w := tabwriter.NewWriter(os.Stdout, 5, 2, 3, ' ', tabwriter.TabIndent)
-	fmt.Fprintln(w, "NAME", "\t", "MANAGED BY ODO", "\t", "STATE", "\t", "AGE")
+	fmt.Fprintln(w, "NAME", "\t", "MANAGED BY ODO", "\t", "STATE", "\t", "STATUS", "\t", "AGE")
 	for i := range servicesItems.Items {
 		item := servicesItems.Items[i]
 		managedByOdo, state, duration := getTabularInfo(&item, devfileComponent)
-		fmt.Fprintln(w, item.Name, "\t", managedByOdo, "\t", state, "\t", duration)
+		status := ""
+		if item.Status != nil {
+			status = string(item.Status.State)
+		}
+		fmt.Fprintln(w, item.Name, "\t", managedByOdo, "\t", state, "\t", status, "\t", duration)
 	}
 	w.Flush()
 
@@ -106,6 +134,27 @@ func (o *ServiceListOptions) listOperatorServices() (err error) {
 	return nil
 }
 
+// setServicesStatus sets the status of the operator backed services deployed on the cluster, as reported by their operators
+func (o *ServiceListOptions) setServicesStatus(items []serviceItem) {
+	statusDescriptors := map[string][]olm.StatusDescriptor{}
+	for i := range items {
+		if !items[i].Deployed {
+			continue
+		}
+		u := unstructured.Unstructured{Object: items[i].Manifest}
+		if u.GetKind() == svc.HelmReleaseKind {
+			continue
+		}
+		descriptors, ok := statusDescriptors[u.GetKind()]
+		if !ok {
+			descriptors = svc.GetStatusDescriptors(o.KClient, u.GetKind())
+			statusDescriptors[u.GetKind()] = descriptors
+		}
+		status := svc.GetServiceStatus(u, descriptors)
+		items[i].Status = &status
+	}
+}
+
 // mixServices returns a structure containing both the services in cluster and defined in devfile
 func mixServices(clusterList []unstructured.Unstructured, devfileList map[string]unstructured.Unstructured) serviceItemList {
 	servicesItems := map[string]*serviceItem{}
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"sort"
 	"strings"
 	"text/tabwriter"
 
@@ -17,12 +18,15 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/validate"
 	"github.com/pkg/errors"
+	"github.com/redhat-developer/odo/pkg/catalog"
 	"github.com/redhat-developer/odo/pkg/devfile"
 	"github.com/redhat-developer/odo/pkg/log"
 	"github.com/redhat-developer/odo/pkg/machineoutput"
+	serviceui "github.com/redhat-developer/odo/pkg/odo/cli/service/ui"
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
 	svc "github.com/redhat-developer/odo/pkg/service"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 var (
@@ -31,12 +35,28 @@ var (
 
 // CompleteServiceCreate contains logic to complete the "odo service create" call for the case of Operator backend
 func (b *OperatorBackend) CompleteServiceCreate(o *CreateOptions, args []string) (err error) {
-	// since interactive mode is not supported for Operators yet, set it to false
-	o.interactive = false
+	// if user has just used "odo service create", ask for the service to create
+	if o.interactive {
+		return b.completeInteractively(o)
+	}
 
-	// if user has just used "odo service create", simply return
-	if o.fromFileFlag == "" && len(args) == 0 {
-		return
+	// if user wants to create service from a template, the only argument is the name of the service
+	if o.templateFlag != "" {
+		if o.fromFileFlag != "" {
+			return fmt.Errorf("the %q and %q flags cannot be used together", "--template", "--from-file")
+		}
+		if len(args) > 1 {
+			return fmt.Errorf("only the name of the service can be given when creating a service from a template")
+		}
+		if len(args) == 1 {
+			o.ServiceName = args[0]
+		}
+		template, e := catalog.GetServiceTemplate(o.templateFlag)
+		if e != nil {
+			return e
+		}
+		b.template = &template
+		return nil
 	}
 
 	// if user wants to create service from file and use a name given on CLI
@@ -61,29 +81,98 @@ func (b *OperatorBackend) CompleteServiceCreate(o *CreateOptions, args []string)
 	return nil
 }
 
+// completeInteractively lets the user select the Operator and the kind of the service, and enter its name and parameters
+func (b *OperatorBackend) completeInteractively(o *CreateOptions) error {
+	if !log.IsTerminal(os.Stdin) {
+		return fmt.Errorf("odo needs a terminal to create a service interactively; use \"odo service create <operator>/<kind>\" otherwise")
+	}
+	csvSupported, err := o.KClient.IsCSVSupported()
+	if err != nil {
+		return err
+	}
+	if !csvSupported {
+		return fmt.Errorf("unable to create a service interactively, make sure you have installed the Operators on the cluster")
+	}
+
+	csvs, err := o.KClient.ListClusterServiceVersions()
+	if err != nil {
+		return err
+	}
+	var operators []string
+	for _, csv := range csvs.Items {
+		if len(*o.KClient.GetCustomResourcesFromCSV(&csv)) > 0 {
+			operators = append(operators, csv.Name)
+		}
+	}
+	if len(operators) == 0 {
+		return fmt.Errorf("no Operator providing services found in namespace %q", o.KClient.GetCurrentNamespace())
+	}
+	sort.Strings(operators)
+	o.ServiceType = serviceui.SelectOperator(operators)
+
+	csv, err := o.KClient.GetClusterServiceVersion(o.ServiceType)
+	if err != nil {
+		return err
+	}
+	crs := *o.KClient.GetCustomResourcesFromCSV(&csv)
+	kinds := make([]string, 0, len(crs))
+	for _, cr := range crs {
+		kinds = append(kinds, cr.Kind)
+	}
+	sort.Strings(kinds)
+	b.CustomResource = serviceui.SelectCustomResource(kinds)
+
+	o.ServiceName = serviceui.EnterServiceName(strings.ToLower(b.CustomResource))
+
+	group, _, _, err := svc.GetGVRFromOperator(csv, b.CustomResource)
+	if err != nil {
+		return err
+	}
+	_, cr := o.KClient.CheckCustomResourceInCSV(b.CustomResource, &csv)
+	crd, err := o.KClient.GetCRDSpec(cr, group, b.CustomResource)
+	if err != nil {
+		return err
+	}
+	o.ParametersMap = serviceui.EnterServiceParameters(crd)
+	return nil
+}
+
 func (b *OperatorBackend) ValidateServiceCreate(o *CreateOptions) error {
 	u := unstructured.Unstructured{}
-	// if the user wants to create service from a file, we check for
+	// if the user wants to create service from a file or a template, we check for
 	// existence of file and validate if the requested operator and CR
 	// exist on the cluster
-	if o.fromFileFlag != "" {
-		if _, err := os.Stat(o.fromFileFlag); err != nil {
-			return errors.Wrap(err, "unable to find specified file")
-		}
+	if o.fromFileFlag != "" || b.template != nil {
+		// the parameters override the values of the template only
+		var params map[string]string
+		if b.template != nil {
+			u.Object = runtime.DeepCopyJSON(b.template.Service)
+			params = o.ParametersMap
+			if o.ServiceName != "" {
+				u.SetName(o.ServiceName)
+			} else if u.GetName() == "" {
+				u.SetName(strings.ToLower(u.GetKind()))
+			}
+		} else {
+			if _, err := os.Stat(o.fromFileFlag); err != nil {
+				return errors.Wrap(err, "unable to find specified file")
+			}
 
-		// Parse the file to find Operator and CR info
-		fileContents, err := ioutil.ReadFile(o.fromFileFlag)
-		if err != nil {
-			return err
-		}
+			// Parse the file to find Operator and CR info
+			fileContents, err := ioutil.ReadFile(o.fromFileFlag)
+			if err != nil {
+				return err
+			}
 
-		err = yaml.Unmarshal(fileContents, &u.Object)
-		if err != nil {
-			return err
+			err = yaml.Unmarshal(fileContents, &u.Object)
+			if err != nil {
+				return err
+			}
 		}
 
 		gvk := u.GroupVersionKind()
 		b.group, b.version, b.kind = gvk.Group, gvk.Version, gvk.Kind
+		b.CustomResource = b.kind
 
 		if u.GetName() == "" {
 			return ErrNoMetadataName
@@ -126,6 +215,17 @@ func (b *OperatorBackend) ValidateServiceCreate(o *CreateOptions) error {
 			return err
 		}
 
+		if len(params) != 0 {
+			spec, _ := u.Object["spec"].(map[string]interface{})
+			if spec == nil {
+				spec = map[string]interface{}{}
+			}
+			u.Object["spec"], err = svc.UpdateSpecFromParams(spec, params, crd)
+			if err != nil {
+				return err
+			}
+		}
+
 		err = validate.AgainstSchema(crd, u.Object["spec"], strfmt.Default)
 		if err != nil {
 			return err
@@ -159,7 +259,7 @@ func (b *OperatorBackend) ValidateServiceCreate(o *CreateOptions) error {
 			return err
 		}
 
-		if len(o.parametersFlag) != 0 {
+		if len(o.ParametersMap) != 0 {
 			builtCRD, e := svc.BuildCRDFromParams(o.ParametersMap, crd, b.group, b.version, b.CustomResource)
 			if e != nil {
 				return e
@@ -215,7 +315,8 @@ func (b *OperatorBackend) ValidateServiceCreate(o *CreateOptions) error {
 		return fmt.Errorf("please use a valid command to start an Operator backed service; desired format: %q", "odo service create <operator-name>/<crd-name>")
 	}
 
-	return nil
+	// validate the whole service against the schema of the custom resource, to report the unknown fields
+	return validateServiceSchema(o.KClient, u)
 }
 
 func (b *OperatorBackend) RunServiceCreate(o *CreateOptions) (err error) {
@@ -285,6 +386,82 @@ func (b *OperatorBackend) ServiceDefined(ctx *genericclioptions.Context, name st
 	return devfile.IsComponentDefined(instanceName, ctx.EnvSpecificInfo.GetDevfileObj())
 }
 
+// ServiceDeployed returns true if the service is deployed on the cluster
+func (b *OperatorBackend) ServiceDeployed(ctx *genericclioptions.Context, name string) (bool, error) {
+	return svc.OperatorSvcExists(ctx.KClient, name)
+}
+
+// UpdateService sets the parameters given by the user in the definition of the service stored in the devfile
+func (b *OperatorBackend) UpdateService(o *UpdateOptions, serviceName string) error {
+	kind, componentName, err := svc.SplitServiceKindName(serviceName)
+	if err != nil {
+		return err
+	}
+	devfileObj := o.EnvSpecificInfo.GetDevfileObj()
+	devfileList, err := svc.ListDevfileServices(o.KClient, devfileObj, o.contextFlag)
+	if err != nil {
+		return err
+	}
+	u, found := devfileList[serviceName]
+	if !found {
+		return fmt.Errorf("couldn't find service named %q in the devfile", serviceName)
+	}
+
+	csv, err := o.KClient.GetCSVWithCR(kind)
+	if err != nil {
+		return err
+	}
+	hasCR, cr := o.KClient.CheckCustomResourceInCSV(kind, csv)
+	if !hasCR {
+		return fmt.Errorf("the %q resource doesn't exist in the %q operator", kind, csv.Name)
+	}
+	crd, err := o.KClient.GetCRDSpec(cr, u.GroupVersionKind().Group, kind)
+	if err != nil {
+		return err
+	}
+
+	updated := u.DeepCopy()
+	spec, _ := updated.Object["spec"].(map[string]interface{})
+	updated.Object["spec"], err = svc.UpdateSpecFromParams(spec, o.ParametersMap, crd)
+	if err != nil {
+		return err
+	}
+	err = validate.AgainstSchema(crd, updated.Object["spec"], strfmt.Default)
+	if err != nil {
+		return err
+	}
+	err = validateServiceSchema(o.KClient, *updated)
+	if err != nil {
+		return err
+	}
+
+	crdYaml, err := yaml.Marshal(updated.Object)
+	if err != nil {
+		return err
+	}
+	if o.DryRunFlag {
+		log.Info(string(crdYaml))
+		return nil
+	}
+
+	changes := svc.GetSpecChanges(o.KClient, kind, u.Object["spec"], updated.Object["spec"])
+	if len(changes) == 0 {
+		log.Infof("The parameters of the service %q are already set to the given values", serviceName)
+		return nil
+	}
+	log.Infof("Changes to the service %q:", serviceName)
+	immutable := false
+	for _, change := range changes {
+		log.Infof("  %s", change)
+		immutable = immutable || change.Immutable
+	}
+	if immutable {
+		log.Warningf("Fields marked as immutable by the Operator are changed; %q will ask for a confirmation before applying them", "odo push")
+	}
+
+	return devfile.UpdateKubernetesComponent(string(crdYaml), componentName, o.contextFlag, devfileObj)
+}
+
 func (b *OperatorBackend) DeleteService(o *DeleteOptions, name string, application string) error {
 	// "name" is of the form CR-Name/Instance-Name so we split it
 	_, instanceName, err := svc.SplitServiceKindName(name)
@@ -326,6 +503,8 @@ func (b *OperatorBackend) DescribeService(o *DescribeOptions, serviceName, app s
 	item.Deployed = clusterFound != nil
 	if item.Deployed {
 		item.Manifest = clusterFound.Object
+		status := svc.GetServiceStatus(*clusterFound, svc.GetStatusDescriptors(o.KClient, clusterFound.GetKind()))
+		item.Status = &status
 	} else if item.InDevfile {
 		item.Manifest = devfileService.Object
 	}
@@ -361,9 +540,43 @@ func PrintHumanReadableOutput(item *serviceItem) error {
 
 	log.Describef("Parameters:\n", tab.String())
 
+	if item.Status != nil {
+		printServiceStatus(*item.Status)
+	}
 	return nil
 }
 
+// printServiceStatus outputs the status of a service deployed on the cluster, with its conditions and the fields described by the operator
+func printServiceStatus(status svc.ServiceStatus) {
+	if status.Message != "" {
+		log.Describef("Status: ", "%s (%s)", status.State, status.Message)
+	} else {
+		log.Describef("Status: ", "%s", status.State)
+	}
+
+	if len(status.Conditions) > 0 {
+		var tab bytes.Buffer
+		wr := tabwriter.NewWriter(&tab, 5, 2, 3, ' ', tabwriter.TabIndent)
+		fmt.Fprint(wr, "TYPE", "\t", "STATUS", "\t", "REASON", "\t", "MESSAGE", "\n")
+		for _, c := range status.Conditions {
+			fmt.Fprint(wr, c.Type, "\t", c.Status, "\t", c.Reason, "\t", c.Message, "\n")
+		}
+		wr.Flush()
+		log.Describef("Conditions:\n", tab.String())
+	}
+
+	if len(status.Fields) > 0 {
+		var tab bytes.Buffer
+		wr := tabwriter.NewWriter(&tab, 5, 2, 3, ' ', tabwriter.TabIndent)
+		fmt.Fprint(wr, "NAME", "\t", "VALUE", "\n")
+		for _, f := range status.Fields {
+			fmt.Fprint(wr, f.Name, "\t", f.Value, "\n")
+		}
+		wr.Flush()
+		log.Describef("Status fields:\n", tab.String())
+	}
+}
+
 // displayParameters adds lines describing fields of a given map
 func displayParameters(wr *tabwriter.Writer, spec map[string]interface{}, prefix string) {
 	keys := make([]string, len(spec))
@@ -22,33 +22,41 @@ func NewCmdService(name, fullName string) *cobra.Command {
 	serviceListCmd := NewCmdServiceList(listRecommendedCommandName, util.GetFullName(fullName, listRecommendedCommandName))
 	serviceDeleteCmd := NewCmdServiceDelete(deleteRecommendedCommandName, util.GetFullName(fullName, deleteRecommendedCommandName))
 	serviceDescribeCmd := NewCmdServiceDescribe(describeRecommendedCommandName, util.GetFullName(fullName, describeRecommendedCommandName))
+	serviceUpdateCmd := NewCmdServiceUpdate(updateRecommendedCommandName, util.GetFullName(fullName, updateRecommendedCommandName))
+	serviceValidateCmd := NewCmdServiceValidate(validateRecommendedCommandName, util.GetFullName(fullName, validateRecommendedCommandName))
 	serviceCmd := &cobra.Command{
 		Use:   name,
 		Short: "Perform service related operations",
 		Long:  serviceLongDesc,
-		Example: fmt.Sprintf("%s\n\n%s\n\n%s\n\n%s",
+		Example: fmt.Sprintf("%s\n\n%s\n\n%s\n\n%s\n\n%s\n\n%s",
 			serviceCreateCmd.Example,
+			serviceUpdateCmd.Example,
 			serviceDeleteCmd.Example,
 			serviceDescribeCmd.Example,
-			serviceListCmd.Example),
+			serviceListCmd.Example,
+			serviceValidateCmd.Example),
 		Args: cobra.RangeArgs(1, 3),
 	}
 	// Add a defined annotation in order to appear in the help menu
 	serviceCmd.Annotations = map[string]string{"command": "main"}
 	serviceCmd.SetUsageTemplate(util.CmdUsageTemplate)
-	serviceCmd.AddCommand(serviceCreateCmd, serviceDeleteCmd, serviceDescribeCmd, serviceListCmd)
+	serviceCmd.AddCommand(serviceCreateCmd, serviceUpdateCmd, serviceDeleteCmd, serviceDescribeCmd, serviceListCmd, serviceValidateCmd)
 
 	//Adding `--project` flag
 	projectCmd.AddProjectFlag(serviceCreateCmd)
+	projectCmd.AddProjectFlag(serviceUpdateCmd)
 	projectCmd.AddProjectFlag(serviceDeleteCmd)
 	projectCmd.AddProjectFlag(serviceDescribeCmd)
 	projectCmd.AddProjectFlag(serviceListCmd)
+	projectCmd.AddProjectFlag(serviceValidateCmd)
 
 	//Adding `--application` flag
 	appCmd.AddApplicationFlag(serviceCreateCmd)
+	appCmd.AddApplicationFlag(serviceUpdateCmd)
 	appCmd.AddApplicationFlag(serviceDeleteCmd)
 	appCmd.AddApplicationFlag(serviceDescribeCmd)
 	appCmd.AddApplicationFlag(serviceListCmd)
+	appCmd.AddApplicationFlag(serviceValidateCmd)
 
 	return serviceCmd
 }
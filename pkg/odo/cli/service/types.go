@@ -1,5 +1,10 @@
 package service
 
+import (
+	"github.com/redhat-developer/odo/pkg/catalog"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
 //OperatorBackend implements the interface ServiceProviderBackend and contains methods that help create a service from Operators
 type OperatorBackend struct {
 	// Custom Resrouce to create service from
@@ -14,8 +19,26 @@ type OperatorBackend struct {
 	resource string
 	// Kind of GVK
 	kind string
+	// template is the service template to create the service from
+	template *catalog.ServiceTemplate
 }
 
 func NewOperatorBackend() *OperatorBackend {
 	return &OperatorBackend{}
 }
+
+// HelmBackend implements the interface ServiceProviderBackend and contains methods that help create a service from Helm charts
+type HelmBackend struct {
+	// Repository is the name of the chart repository, as configured in Helm, or the path of a local chart repository
+	Repository string
+	// Chart is the name of the chart in the repository
+	Chart string
+	// Version is the version of the chart, the latest one if empty
+	Version string
+	// Manifests are the resources rendered from the chart
+	Manifests []unstructured.Unstructured
+}
+
+func NewHelmBackend() *HelmBackend {
+	return &HelmBackend{}
+}
@@ -0,0 +1,255 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/spec"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cli/ui"
+	"github.com/redhat-developer/odo/pkg/odo/util/validation"
+	svc "github.com/redhat-developer/odo/pkg/service"
+	"gopkg.in/AlecAivazis/survey.v1"
+)
+
+// SelectOperator lets the user select the Operator providing the service in the prompt
+func SelectOperator(operators []string) string {
+	var operator string
+	prompt := &survey.Select{
+		Message: "Which Operator do you wish to create the service from",
+		Options: operators,
+	}
+	err := survey.AskOne(prompt, &operator, survey.Required)
+	ui.HandleError(err)
+	return operator
+}
+
+// SelectCustomResource lets the user select the kind of service provided by the Operator in the prompt
+func SelectCustomResource(customResources []string) string {
+	// select the only custom resource of the Operator
+	if len(customResources) == 1 {
+		return customResources[0]
+	}
+	var customResource string
+	prompt := &survey.Select{
+		Message: "Which kind of service do you wish to create",
+		Options: customResources,
+	}
+	err := survey.AskOne(prompt, &customResource, survey.Required)
+	ui.HandleError(err)
+	return customResource
+}
+
+// EnterServiceName lets the user specify the name of the service in the prompt
+func EnterServiceName(defaultName string) string {
+	var name string
+	prompt := &survey.Input{
+		Message: "What do you wish to name the new service",
+		Default: defaultName,
+	}
+	err := survey.AskOne(prompt, &name, validation.NameValidator)
+	ui.HandleError(err)
+	return name
+}
+
+// EnterServiceParameters walks the schema of the spec of the service and lets the user enter the values of its fields in the prompt,
+// the required fields first; it returns the values as parameters expressed as <path>=<value>, as given with the --parameters flag
+func EnterServiceParameters(schema *spec.Schema) map[string]string {
+	parameters := map[string]string{}
+	if schema != nil {
+		enterObjectParameters(schema, "", parameters)
+	}
+	return parameters
+}
+
+// enterObjectParameters asks for the values of the required fields of an object, then for the optional fields the user selects
+func enterObjectParameters(schema *spec.Schema, prefix string, parameters map[string]string) {
+	required, optional := getSortedProperties(schema)
+
+	for _, key := range required {
+		property := schema.Properties[key]
+		if !isSupportedProperty(property) {
+			log.Warningf("The required parameter %q of type %s can't be set interactively; use --from-file to create the service", prefix+key, getTypeString(property))
+			continue
+		}
+		enterPropertyParameters(property, prefix+key, true, parameters)
+	}
+
+	var options []string
+	for _, key := range optional {
+		if isSupportedProperty(schema.Properties[key]) {
+			options = append(options, key)
+		}
+	}
+	if len(options) == 0 {
+		return
+	}
+
+	var selected []string
+	message := "Which optional parameters do you wish to set"
+	if prefix != "" {
+		message = fmt.Sprintf("Which optional parameters of %q do you wish to set", strings.TrimSuffix(prefix, "."))
+	}
+	prompt := &survey.MultiSelect{
+		Message: message,
+		Options: options,
+		Help:    getOptionsHelp(schema, options),
+	}
+	err := survey.AskOne(prompt, &selected, nil)
+	ui.HandleError(err)
+
+	for _, key := range selected {
+		enterPropertyParameters(schema.Properties[key], prefix+key, false, parameters)
+	}
+}
+
+// enterPropertyParameters asks for the value of a field, or for the values of its fields if it is an object
+func enterPropertyParameters(property spec.Schema, path string, required bool, parameters map[string]string) {
+	if isObject(property) {
+		enterObjectParameters(&property, path+".", parameters)
+		return
+	}
+
+	message := fmt.Sprintf("%s (%s)", path, getTypeString(property))
+	help := getDescription(property)
+
+	var value string
+	var err error
+	switch {
+	case len(property.Enum) > 0:
+		prompt := &survey.Select{
+			Message: message,
+			Options: getEnumOptions(property),
+			Help:    help,
+		}
+		if property.Default != nil {
+			prompt.Default = fmt.Sprint(property.Default)
+		}
+		err = survey.AskOne(prompt, &value, survey.Required)
+
+	case property.Type.Contains("boolean"):
+		var response bool
+		prompt := &survey.Confirm{
+			Message: message,
+			Help:    help,
+		}
+		if defaultValue, ok := property.Default.(bool); ok {
+			prompt.Default = defaultValue
+		}
+		err = survey.AskOne(prompt, &response, nil)
+		value = fmt.Sprint(response)
+
+	default:
+		prompt := &survey.Input{
+			Message: message,
+			Help:    help,
+		}
+		if property.Default != nil {
+			prompt.Default = fmt.Sprint(property.Default)
+		}
+		err = survey.AskOne(prompt, &value, getParameterValidator(property, required))
+	}
+	ui.HandleError(err)
+
+	if value != "" {
+		parameters[path] = value
+	}
+}
+
+// getParameterValidator returns a validator checking that the answer matches the schema of the field
+// an empty answer is accepted for an optional field, and the field is then not set
+func getParameterValidator(property spec.Schema, required bool) survey.Validator {
+	return func(ans interface{}) error {
+		s, ok := ans.(string)
+		if !ok {
+			return fmt.Errorf("can only validate strings, got %v", ans)
+		}
+		if s == "" {
+			if required {
+				return fmt.Errorf("a value is required")
+			}
+			return nil
+		}
+		return svc.ValidateParamValue(&property, s)
+	}
+}
+
+// getSortedProperties returns the names of the required and of the optional properties of an object, sorted by name
+func getSortedProperties(schema *spec.Schema) (required []string, optional []string) {
+	requiredMap := map[string]bool{}
+	for _, req := range schema.Required {
+		requiredMap[req] = true
+	}
+	for key := range schema.Properties {
+		if requiredMap[key] {
+			required = append(required, key)
+		} else {
+			optional = append(optional, key)
+		}
+	}
+	sort.Strings(required)
+	sort.Strings(optional)
+	return required, optional
+}
+
+// isObject returns true if the property is an object with known properties, which are asked one by one
+func isObject(property spec.Schema) bool {
+	return property.Type.Contains("object") && len(property.Properties) > 0
+}
+
+// isSupportedProperty returns true if the value of the property can be entered interactively
+func isSupportedProperty(property spec.Schema) bool {
+	if isObject(property) {
+		return true
+	}
+	for _, t := range []string{"string", "integer", "number", "boolean"} {
+		if property.Type.Contains(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// getTypeString returns the type of the property, as displayed to the user
+func getTypeString(property spec.Schema) string {
+	if len(property.Type) == 0 {
+		return "any"
+	}
+	tpe := strings.Join(property.Type, ", ")
+	if property.Type.Contains("array") && property.Items != nil && property.Items.Schema != nil {
+		tpe = "[]" + getTypeString(*property.Items.Schema)
+	}
+	return tpe
+}
+
+// getDescription returns the title and the description of the property
+func getDescription(property spec.Schema) string {
+	var parts []string
+	for _, text := range []string{property.Title, property.Description} {
+		if text = strings.TrimSpace(text); text != "" {
+			parts = append(parts, text)
+		}
+	}
+	return strings.Join(parts, "\n")
+}
+
+// getOptionsHelp returns the descriptions of the optional properties proposed to the user
+func getOptionsHelp(schema *spec.Schema, options []string) string {
+	var lines []string
+	for _, key := range options {
+		if description := getDescription(schema.Properties[key]); description != "" {
+			lines = append(lines, fmt.Sprintf("%s: %s", key, strings.Replace(description, "\n", " ", -1)))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// getEnumOptions returns the possible values of the property
+func getEnumOptions(property spec.Schema) []string {
+	options := make([]string, 0, len(property.Enum))
+	for _, value := range property.Enum {
+		options = append(options, fmt.Sprint(value))
+	}
+	return options
+}
@@ -0,0 +1,74 @@
+package ui
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-openapi/spec"
+)
+
+func TestGetSortedProperties(t *testing.T) {
+	schema := &spec.Schema{SchemaProps: spec.SchemaProps{
+		Required: []string{"size", "image"},
+		Properties: map[string]spec.Schema{
+			"size":      *spec.Int64Property(),
+			"image":     *spec.StringProperty(),
+			"storage":   *spec.StringProperty(),
+			"exporter":  *spec.BoolProperty(),
+			"resources": *spec.MapProperty(spec.StringProperty()),
+		},
+	}}
+	required, optional := getSortedProperties(schema)
+	if !reflect.DeepEqual(required, []string{"image", "size"}) {
+		t.Errorf("got required properties %v, want %v", required, []string{"image", "size"})
+	}
+	if !reflect.DeepEqual(optional, []string{"exporter", "resources", "storage"}) {
+		t.Errorf("got optional properties %v, want %v", optional, []string{"exporter", "resources", "storage"})
+	}
+}
+
+func TestIsSupportedProperty(t *testing.T) {
+	tests := []struct {
+		name     string
+		property spec.Schema
+		want     bool
+	}{
+		{name: "string", property: *spec.StringProperty(), want: true},
+		{name: "integer", property: *spec.Int32Property(), want: true},
+		{name: "boolean", property: *spec.BoolProperty(), want: true},
+		{name: "object with properties", property: *new(spec.Schema).Typed("object", "").SetProperty("size", *spec.Int32Property()), want: true},
+		{name: "object without properties", property: *spec.MapProperty(spec.StringProperty()), want: false},
+		{name: "array", property: *spec.ArrayProperty(spec.StringProperty()), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isSupportedProperty(tt.property); got != tt.want {
+				t.Errorf("isSupportedProperty() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetParameterValidator(t *testing.T) {
+	property := *spec.Int32Property()
+	tests := []struct {
+		name     string
+		required bool
+		answer   interface{}
+		wantErr  bool
+	}{
+		{name: "valid value", required: true, answer: "3"},
+		{name: "invalid value", required: true, answer: "three", wantErr: true},
+		{name: "empty required value", required: true, answer: "", wantErr: true},
+		{name: "empty optional value", required: false, answer: ""},
+		{name: "not a string", required: false, answer: 3, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := getParameterValidator(property, tt.required)(tt.answer)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validator error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/util"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+	svc "github.com/redhat-developer/odo/pkg/service"
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+)
+
+const updateRecommendedCommandName = "update"
+
+var (
+	updateExample = ktemplates.Examples(`
+    # Set the size of the service named 'EtcdCluster/myetcd'
+    %[1]s EtcdCluster/myetcd -p size=3
+
+    # Print the updated definition of the service without changing the devfile
+    %[1]s EtcdCluster/myetcd -p size=3 -p version=3.2.13 --dry-run`)
+
+	updateLongDesc = ktemplates.LongDesc(`
+	Update the parameters of an Operator backed service defined in the devfile.
+
+	The parameters are expressed as for "odo service create", and replace the values already defined.
+	The changes are displayed, the fields marked as immutable by the Operator being reported as such; use "odo push" to apply them on the cluster.`)
+)
+
+// UpdateOptions encapsulates the options for the odo service update command
+type UpdateOptions struct {
+	// Context
+	*genericclioptions.Context
+
+	// Parameters
+	serviceName string
+
+	// Flags
+	parametersFlag []string
+	contextFlag    string
+	DryRunFlag     bool
+
+	// ParametersMap is populated from the flag-provided values
+	ParametersMap map[string]string
+	// Backend is the service provider backend that was used to create the service
+	Backend ServiceProviderBackend
+}
+
+// NewUpdateOptions creates a new UpdateOptions instance
+func NewUpdateOptions() *UpdateOptions {
+	return &UpdateOptions{}
+}
+
+// Complete completes UpdateOptions after they've been created
+func (o *UpdateOptions) Complete(cmdline cmdline.Cmdline, args []string) (err error) {
+	o.Context, err = genericclioptions.New(genericclioptions.NewCreateParameters(cmdline).NeedDevfile(o.contextFlag))
+	if err != nil {
+		return err
+	}
+
+	err = validDevfileDirectory(o.contextFlag)
+	if err != nil {
+		return err
+	}
+
+	o.ParametersMap, err = util.MapFromParameters(o.parametersFlag)
+	if err != nil {
+		return err
+	}
+
+	o.serviceName = args[0]
+	_, _, err = svc.SplitServiceKindName(o.serviceName)
+	if err != nil {
+		return fmt.Errorf("invalid service name")
+	}
+	o.Backend = getServiceBackend(o.serviceName)
+	return nil
+}
+
+// Validate validates the UpdateOptions based on completed values
+func (o *UpdateOptions) Validate() error {
+	if len(o.ParametersMap) == 0 {
+		return fmt.Errorf("no parameters given; use %q to set the parameters of the service", "--parameters")
+	}
+	svcDefined, err := o.Backend.ServiceDefined(o.Context, o.serviceName)
+	if err != nil {
+		return err
+	}
+	if !svcDefined {
+		return fmt.Errorf("couldn't find service named %q in the devfile. Refer %q to see list of defined services", o.serviceName, "odo service list")
+	}
+	return nil
+}
+
+// Run contains the logic for the odo service update command
+func (o *UpdateOptions) Run() error {
+	err := o.Backend.UpdateService(o, o.serviceName)
+	if err != nil {
+		return err
+	}
+	if !o.DryRunFlag {
+		log.Infof("Successfully updated service %q in the configuration; do 'odo push' to update it on the cluster", o.serviceName)
+	}
+	return nil
+}
+
+// NewCmdServiceUpdate implements the odo service update command
+func NewCmdServiceUpdate(name, fullName string) *cobra.Command {
+	o := NewUpdateOptions()
+
+	var updateCmd = &cobra.Command{
+		Use:     fmt.Sprintf("%s <service_name>", name),
+		Short:   "Update the parameters of a service",
+		Long:    updateLongDesc,
+		Example: fmt.Sprintf(updateExample, fullName),
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+
+	updateCmd.Flags().StringArrayVarP(&o.parametersFlag, "parameters", "p", []string{}, "Parameters of the service to set, where a parameter is expressed as <key>=<value>; the key can contain list indexes (e.g. nodes[0].size) and the value can be a JSON object or array, or @<file> to load it from a YAML or JSON file")
+	updateCmd.Flags().BoolVar(&o.DryRunFlag, "dry-run", false, "Print the updated yaml specification of the service without changing the devfile")
+	odoutil.AddContextFlag(updateCmd, &o.contextFlag)
+	return updateCmd
+}
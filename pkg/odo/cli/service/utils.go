@@ -2,10 +2,14 @@ package service
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/redhat-developer/odo/pkg/devfile/location"
+	"github.com/redhat-developer/odo/pkg/kclient"
 	"github.com/redhat-developer/odo/pkg/odo/cli/component"
+	svc "github.com/redhat-developer/odo/pkg/service"
 	"github.com/redhat-developer/odo/pkg/util"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
 // validDevfileDirectory returns an error if the "odo service" command is executed from a directory not containing devfile.yaml
@@ -20,3 +24,23 @@ func validDevfileDirectory(componentContext string) error {
 	}
 	return nil
 }
+
+// getServiceBackend returns the backend providing the service of the given name, of the form <kind>/<name>
+func getServiceBackend(serviceName string) ServiceProviderBackend {
+	if strings.HasPrefix(serviceName, svc.HelmReleaseKind+"/") {
+		return NewHelmBackend()
+	}
+	return NewOperatorBackend()
+}
+
+// validateServiceSchema validates the service against the schema of its custom resource
+func validateServiceSchema(client kclient.ClientInterface, u unstructured.Unstructured) error {
+	fieldErrors, err := svc.ValidateResourceSchema(client, u)
+	if err != nil {
+		return err
+	}
+	if len(fieldErrors) > 0 {
+		return fmt.Errorf("the service %s/%s is invalid:\n  - %s", u.GetKind(), u.GetName(), strings.Join(fieldErrors, "\n  - "))
+	}
+	return nil
+}
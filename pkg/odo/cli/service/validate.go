@@ -0,0 +1,143 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/redhat-developer/odo/pkg/devfile"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+	svc "github.com/redhat-developer/odo/pkg/service"
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+)
+
+const validateRecommendedCommandName = "validate"
+
+var (
+	validateExample = ktemplates.Examples(`
+    # Validate all the services defined in the devfile
+    %[1]s
+
+    # Validate the service named 'EtcdCluster/myetcd'
+    %[1]s EtcdCluster/myetcd`)
+
+	validateLongDesc = ktemplates.LongDesc(`
+	Validate the services defined in the devfile against the schemas of their custom resources.
+
+	Unknown fields, invalid types and values, and missing required fields are reported with their path.
+	The schemas are cached locally for an hour, so the cluster is not contacted again to validate the same kind of service.`)
+)
+
+// ValidateOptions encapsulates the options for the odo service validate command
+type ValidateOptions struct {
+	// Context
+	*genericclioptions.Context
+
+	// Parameters
+	serviceName string
+
+	// Flags
+	contextFlag string
+}
+
+// NewValidateOptions creates a new ValidateOptions instance
+func NewValidateOptions() *ValidateOptions {
+	return &ValidateOptions{}
+}
+
+// Complete completes ValidateOptions after they've been created
+func (o *ValidateOptions) Complete(cmdline cmdline.Cmdline, args []string) (err error) {
+	o.Context, err = genericclioptions.New(genericclioptions.NewCreateParameters(cmdline).NeedDevfile(o.contextFlag))
+	if err != nil {
+		return err
+	}
+
+	err = validDevfileDirectory(o.contextFlag)
+	if err != nil {
+		return err
+	}
+
+	if len(args) == 1 {
+		o.serviceName = args[0]
+		_, _, err = svc.SplitServiceKindName(o.serviceName)
+		if err != nil {
+			return fmt.Errorf("invalid service name")
+		}
+	}
+	return nil
+}
+
+// Validate validates the ValidateOptions based on completed values
+func (o *ValidateOptions) Validate() error {
+	if o.serviceName == "" {
+		return nil
+	}
+	svcDefined, err := getServiceBackend(o.serviceName).ServiceDefined(o.Context, o.serviceName)
+	if err != nil {
+		return err
+	}
+	if !svcDefined {
+		return fmt.Errorf("couldn't find service named %q in the devfile. Refer %q to see list of defined services", o.serviceName, "odo service list")
+	}
+	return nil
+}
+
+// Run contains the logic for the odo service validate command
+func (o *ValidateOptions) Run() error {
+	k8sComponents, err := devfile.GetKubernetesComponentsToPush(o.EnvSpecificInfo.GetDevfileObj())
+	if err != nil {
+		return err
+	}
+	results, err := svc.GetResourcesSchemaErrors(o.KClient, k8sComponents, o.contextFlag)
+	if err != nil {
+		return err
+	}
+
+	invalid := 0
+	validated := 0
+	for _, result := range results {
+		name := result.Resource.GetKind() + "/" + result.Resource.GetName()
+		if o.serviceName != "" && name != o.serviceName && svc.HelmReleaseKind+"/"+result.Resource.GetLabels()[svc.HelmReleaseLabel] != o.serviceName {
+			continue
+		}
+		validated++
+		if len(result.Errors) == 0 {
+			log.Successf("%s is valid", name)
+			continue
+		}
+		invalid++
+		log.Errorf("%s is invalid:", name)
+		for _, fieldError := range result.Errors {
+			log.Infof("  - %s", fieldError)
+		}
+	}
+
+	if validated == 0 {
+		log.Info("No services defined in the devfile")
+	}
+	if invalid > 0 {
+		return fmt.Errorf("%d invalid resource(s) found in the devfile", invalid)
+	}
+	return nil
+}
+
+// NewCmdServiceValidate implements the odo service validate command
+func NewCmdServiceValidate(name, fullName string) *cobra.Command {
+	o := NewValidateOptions()
+
+	var validateCmd = &cobra.Command{
+		Use:     fmt.Sprintf("%s [service_name]", name),
+		Short:   "Validate the services defined in the devfile",
+		Long:    validateLongDesc,
+		Example: fmt.Sprintf(validateExample, fullName),
+		Args:    cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+
+	odoutil.AddContextFlag(validateCmd, &o.contextFlag)
+	return validateCmd
+}
@@ -0,0 +1,159 @@
+package storage
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/machineoutput"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+	"github.com/redhat-developer/odo/pkg/odo/util/completion"
+	"github.com/redhat-developer/odo/pkg/storage"
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+)
+
+const cpRecommendedCommandName = "cp"
+
+var (
+	storageCpShortDesc = `Copy files in and out of a storage of the component`
+	storageCpLongDesc  = ktemplates.LongDesc(`Copy files in and out of a persistent storage of the component.
+
+A storage is referenced as STORAGE:PATH, PATH being relative to the root of the storage.
+The source file or directory is copied, with its name, into the destination directory.
+
+The files are copied through the component's container when the component is running,
+or through a temporary pod mounting the storage otherwise.`)
+	storageCpExample = ktemplates.Examples(`
+	# Copy the local directory seed into the directory init of the storage mydb
+  %[1]s ./seed mydb:/init
+
+	# Copy the directory dump of the storage mydb into the local directory backup
+  %[1]s mydb:/dump ./backup
+	`)
+)
+
+type CpOptions struct {
+	// Context
+	*genericclioptions.Context
+
+	// Parameters
+	storageName string
+	remotePath  string
+	localPath   string
+	toStorage   bool
+
+	// Flags
+	contextFlag string
+
+	// Backend
+	client storage.Client
+}
+
+// NewStorageCpOptions creates a new CpOptions instance
+func NewStorageCpOptions() *CpOptions {
+	return &CpOptions{}
+}
+
+// Complete completes CpOptions after they've been created
+func (o *CpOptions) Complete(cmdline cmdline.Cmdline, args []string) (err error) {
+	o.Context, err = genericclioptions.New(genericclioptions.NewCreateParameters(cmdline).NeedDevfile(o.contextFlag))
+	if err != nil {
+		return err
+	}
+
+	srcStorage, srcPath, err := o.parseStorageReference(args[0])
+	if err != nil {
+		return err
+	}
+	dstStorage, dstPath, err := o.parseStorageReference(args[1])
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case srcStorage == "" && dstStorage != "":
+		o.toStorage = true
+		o.localPath, o.storageName, o.remotePath = args[0], dstStorage, dstPath
+	case srcStorage != "" && dstStorage == "":
+		o.toStorage = false
+		o.storageName, o.remotePath, o.localPath = srcStorage, srcPath, args[1]
+	default:
+		return fmt.Errorf("exactly one of the source and the destination must be a storage of the component, referenced as STORAGE:PATH")
+	}
+
+	o.client = storage.NewClient(storage.ClientOptions{
+		LocalConfigProvider: o.Context.LocalConfigProvider,
+		Client:              o.Context.KClient,
+	})
+	return nil
+}
+
+// parseStorageReference returns the storage name and the path of a STORAGE:PATH argument
+// an empty storage name is returned if the argument doesn't reference a storage of the component
+func (o *CpOptions) parseStorageReference(arg string) (string, string, error) {
+	i := strings.Index(arg, ":")
+	if i <= 0 {
+		return "", "", nil
+	}
+	name := arg[:i]
+	gotStorage, err := o.LocalConfigProvider.GetStorage(name)
+	if err != nil {
+		return "", "", err
+	}
+	if gotStorage == nil {
+		// e.g. a Windows path
+		return "", "", nil
+	}
+	return name, arg[i+1:], nil
+}
+
+// Validate validates the CpOptions based on completed values
+func (o *CpOptions) Validate() (err error) {
+	return validatePersistentStorage(o.Context, o.storageName)
+}
+
+// Run contains the logic for the odo storage cp command
+func (o *CpOptions) Run() (err error) {
+	var successMessage string
+	if o.toStorage {
+		err = o.client.CopyTo(o.localPath, o.storageName, o.remotePath)
+		successMessage = fmt.Sprintf("Copied %v to %v:%v", o.localPath, o.storageName, o.remotePath)
+	} else {
+		err = o.client.CopyFrom(o.storageName, o.remotePath, o.localPath)
+		successMessage = fmt.Sprintf("Copied %v:%v to %v", o.storageName, o.remotePath, o.localPath)
+	}
+	if err != nil {
+		return err
+	}
+
+	if log.IsJSON() {
+		machineoutput.SuccessStatus(storage.StorageKind, o.storageName, successMessage)
+		return nil
+	}
+	log.Successf(successMessage)
+	return nil
+}
+
+// NewCmdStorageCp implements the odo storage cp command.
+func NewCmdStorageCp(name, fullName string) *cobra.Command {
+	o := NewStorageCpOptions()
+	storageCpCmd := &cobra.Command{
+		Use:         name,
+		Short:       storageCpShortDesc,
+		Long:        storageCpLongDesc,
+		Example:     fmt.Sprintf(storageCpExample, fullName),
+		Args:        cobra.ExactArgs(2),
+		Annotations: map[string]string{"machineoutput": "json"},
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+
+	odoutil.AddContextFlag(storageCpCmd, &o.contextFlag)
+	completion.RegisterCommandFlagHandler(storageCpCmd, "context", completion.FileCompletionHandler)
+
+	return storageCpCmd
+}
@@ -27,6 +27,9 @@ var (
 
 	# Create storage with ephemeral volume of size 2Gi to a component
   %[1]s mystorage --path=/opt/app-root/src/storage/ --size=2Gi --ephemeral
+
+	# Create storage of size 5Gi shared between the pods of the component, using the storage class fast
+  %[1]s mystorage --path=/opt/app-root/src/cache/ --size=5Gi --storage-class=fast --access-mode=ReadWriteMany
 	`)
 )
 
@@ -43,6 +46,8 @@ type CreateOptions struct {
 	contextFlag   string
 	containerFlag string
 	ephemeralFlag bool
+	classFlag     string
+	modeFlag      string
 
 	storage localConfigProvider.LocalStorage
 }
@@ -70,11 +75,13 @@ func (o *CreateOptions) Complete(cmdline cmdline.Cmdline, args []string) (err er
 		eph = &o.ephemeralFlag
 	}
 	o.storage = localConfigProvider.LocalStorage{
-		Name:      o.storageName,
-		Size:      o.sizeFlag,
-		Ephemeral: eph,
-		Path:      o.pathFlag,
-		Container: o.containerFlag,
+		Name:         o.storageName,
+		Size:         o.sizeFlag,
+		Ephemeral:    eph,
+		Path:         o.pathFlag,
+		StorageClass: o.classFlag,
+		AccessMode:   o.modeFlag,
+		Container:    o.containerFlag,
 	}
 
 	o.Context.LocalConfigProvider.CompleteStorage(&o.storage)
@@ -97,6 +104,8 @@ func (o *CreateOptions) Run() (err error) {
 
 	if log.IsJSON() {
 		storageResultMachineReadable := storage.NewStorage(o.storage.Name, o.storage.Size, o.storage.Path, nil)
+		storageResultMachineReadable.Spec.StorageClass = o.storage.StorageClass
+		storageResultMachineReadable.Spec.AccessMode = o.storage.AccessMode
 		machineoutput.OutputSuccess(storageResultMachineReadable)
 	} else {
 		log.Successf("Added storage %v to %v", o.storageName, o.Context.LocalConfigProvider.GetName())
@@ -125,6 +134,8 @@ func NewCmdStorageCreate(name, fullName string) *cobra.Command {
 	storageCreateCmd.Flags().StringVar(&o.pathFlag, "path", "", "Path to mount the storage on")
 	storageCreateCmd.Flags().StringVar(&o.containerFlag, "container", "", "Name of container to attach the storage to in devfile")
 	storageCreateCmd.Flags().BoolVar(&o.ephemeralFlag, "ephemeral", false, "Set volume as ephemeral")
+	storageCreateCmd.Flags().StringVar(&o.classFlag, "storage-class", "", "Storage class of the volume, the default storage class of the cluster is used if not specified")
+	storageCreateCmd.Flags().StringVar(&o.modeFlag, "access-mode", "", "Access mode of the volume (ReadWriteOnce, ReadOnlyMany, ReadWriteMany or ReadWriteOncePod), ReadWriteOnce is used if not specified")
 
 	odoutil.AddContextFlag(storageCreateCmd, &o.contextFlag)
 	completion.RegisterCommandFlagHandler(storageCreateCmd, "context", completion.FileCompletionHandler)
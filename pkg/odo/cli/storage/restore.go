@@ -0,0 +1,121 @@
+package storage
+
+import (
+	"fmt"
+
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/machineoutput"
+	"github.com/redhat-developer/odo/pkg/odo/cli/ui"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+	"github.com/redhat-developer/odo/pkg/odo/util/completion"
+	"github.com/redhat-developer/odo/pkg/storage"
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+)
+
+const restoreRecommendedCommandName = "restore"
+
+var (
+	storageRestoreShortDesc = `Restore a storage of the component from a snapshot`
+	storageRestoreLongDesc  = ktemplates.LongDesc(`Restore a persistent storage of the component from a snapshot.
+
+A new volume is created from the snapshot and replaces the current volume of the storage,
+the component uses the restored volume after the next 'odo push'. The data of the current volume is lost.`)
+	storageRestoreExample = ktemplates.Examples(`
+	# Restore the storage mystorage from the snapshot mysnapshot
+  %[1]s mystorage --from mysnapshot
+	`)
+)
+
+type RestoreOptions struct {
+	// Context
+	*genericclioptions.Context
+
+	// Parameters
+	storageName string
+
+	// Flags
+	fromFlag    string
+	forceFlag   bool
+	contextFlag string
+
+	// Backend
+	client storage.Client
+}
+
+// NewStorageRestoreOptions creates a new RestoreOptions instance
+func NewStorageRestoreOptions() *RestoreOptions {
+	return &RestoreOptions{}
+}
+
+// Complete completes RestoreOptions after they've been created
+func (o *RestoreOptions) Complete(cmdline cmdline.Cmdline, args []string) (err error) {
+	o.Context, err = genericclioptions.New(genericclioptions.NewCreateParameters(cmdline).NeedDevfile(o.contextFlag))
+	if err != nil {
+		return err
+	}
+
+	o.storageName = args[0]
+
+	o.client = storage.NewClient(storage.ClientOptions{
+		LocalConfigProvider: o.Context.LocalConfigProvider,
+		Client:              o.Context.KClient,
+	})
+	return nil
+}
+
+// Validate validates the RestoreOptions based on completed values
+func (o *RestoreOptions) Validate() (err error) {
+	if o.fromFlag == "" {
+		return fmt.Errorf("the snapshot to restore from must be provided with the --from flag")
+	}
+	return validatePersistentStorage(o.Context, o.storageName)
+}
+
+// Run contains the logic for the odo storage restore command
+func (o *RestoreOptions) Run() (err error) {
+	restoreMsg := fmt.Sprintf("Are you sure you want to replace the content of the storage %v with the snapshot %v", o.storageName, o.fromFlag)
+	if !(log.IsJSON() || o.forceFlag || ui.Proceed(restoreMsg)) {
+		return fmt.Errorf("aborting restore of storage: %v", o.storageName)
+	}
+
+	err = o.client.Restore(o.storageName, o.fromFlag)
+	if err != nil {
+		return err
+	}
+
+	successMessage := fmt.Sprintf("Restored storage %v from snapshot %v", o.storageName, o.fromFlag)
+	if log.IsJSON() {
+		machineoutput.SuccessStatus(storage.StorageKind, o.storageName, successMessage)
+		return nil
+	}
+	log.Successf(successMessage)
+	log.Italic("\nPlease use `odo push` command to make the restored storage accessible to the component")
+	return nil
+}
+
+// NewCmdStorageRestore implements the odo storage restore command.
+func NewCmdStorageRestore(name, fullName string) *cobra.Command {
+	o := NewStorageRestoreOptions()
+	storageRestoreCmd := &cobra.Command{
+		Use:         name,
+		Short:       storageRestoreShortDesc,
+		Long:        storageRestoreLongDesc,
+		Example:     fmt.Sprintf(storageRestoreExample, fullName),
+		Args:        cobra.ExactArgs(1),
+		Annotations: map[string]string{"machineoutput": "json"},
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+
+	storageRestoreCmd.Flags().StringVar(&o.fromFlag, "from", "", "Name of the snapshot to restore the storage from")
+	storageRestoreCmd.Flags().BoolVarP(&o.forceFlag, "force", "f", false, "Restore storage without prompting")
+
+	odoutil.AddContextFlag(storageRestoreCmd, &o.contextFlag)
+	completion.RegisterCommandFlagHandler(storageRestoreCmd, "context", completion.FileCompletionHandler)
+
+	return storageRestoreCmd
+}
@@ -0,0 +1,138 @@
+package storage
+
+import (
+	"fmt"
+
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/machineoutput"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+	"github.com/redhat-developer/odo/pkg/odo/util/completion"
+	"github.com/redhat-developer/odo/pkg/storage"
+	"github.com/redhat-developer/odo/pkg/util"
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+)
+
+const snapshotRecommendedCommandName = "snapshot"
+
+// SnapshotKind is the kind used in the machine readable output of odo storage snapshot
+const SnapshotKind = "VolumeSnapshot"
+
+var (
+	storageSnapshotShortDesc = `Take a snapshot of a storage of the component`
+	storageSnapshotLongDesc  = ktemplates.LongDesc(`Take a snapshot of a persistent storage of the component.
+
+The snapshot is created as a CSI VolumeSnapshot and can later be used to restore the storage with 'odo storage restore'.
+The cluster needs to have the CSI snapshot CRDs and a CSI driver supporting snapshots installed.`)
+	storageSnapshotExample = ktemplates.Examples(`
+	# Take a snapshot of the storage mystorage, with a generated snapshot name
+  %[1]s mystorage
+
+	# Take a snapshot of the storage mystorage named mysnapshot, using the VolumeSnapshotClass csi-snapclass
+  %[1]s mystorage mysnapshot --snapshot-class csi-snapclass
+	`)
+)
+
+type SnapshotOptions struct {
+	// Context
+	*genericclioptions.Context
+
+	// Parameters
+	storageName  string
+	snapshotName string
+
+	// Flags
+	snapshotClassFlag string
+	contextFlag       string
+
+	// Backend
+	client storage.Client
+}
+
+// NewStorageSnapshotOptions creates a new SnapshotOptions instance
+func NewStorageSnapshotOptions() *SnapshotOptions {
+	return &SnapshotOptions{}
+}
+
+// Complete completes SnapshotOptions after they've been created
+func (o *SnapshotOptions) Complete(cmdline cmdline.Cmdline, args []string) (err error) {
+	o.Context, err = genericclioptions.New(genericclioptions.NewCreateParameters(cmdline).NeedDevfile(o.contextFlag))
+	if err != nil {
+		return err
+	}
+
+	o.storageName = args[0]
+	if len(args) > 1 {
+		o.snapshotName = args[1]
+	} else {
+		o.snapshotName = fmt.Sprintf("%s-%s", o.storageName, util.GenerateRandomString(4))
+	}
+
+	o.client = storage.NewClient(storage.ClientOptions{
+		LocalConfigProvider: o.Context.LocalConfigProvider,
+		Client:              o.Context.KClient,
+	})
+	return nil
+}
+
+// Validate validates the SnapshotOptions based on completed values
+func (o *SnapshotOptions) Validate() (err error) {
+	return validatePersistentStorage(o.Context, o.storageName)
+}
+
+// Run contains the logic for the odo storage snapshot command
+func (o *SnapshotOptions) Run() (err error) {
+	err = o.client.Snapshot(o.storageName, o.snapshotName, o.snapshotClassFlag)
+	if err != nil {
+		return err
+	}
+
+	successMessage := fmt.Sprintf("Created snapshot %v of storage %v", o.snapshotName, o.storageName)
+	if log.IsJSON() {
+		machineoutput.SuccessStatus(SnapshotKind, o.snapshotName, successMessage)
+		return nil
+	}
+	log.Successf(successMessage)
+	log.Italic(fmt.Sprintf("\nPlease use `odo storage restore %s --from %s` command to restore the storage from this snapshot", o.storageName, o.snapshotName))
+	return nil
+}
+
+// NewCmdStorageSnapshot implements the odo storage snapshot command.
+func NewCmdStorageSnapshot(name, fullName string) *cobra.Command {
+	o := NewStorageSnapshotOptions()
+	storageSnapshotCmd := &cobra.Command{
+		Use:         name,
+		Short:       storageSnapshotShortDesc,
+		Long:        storageSnapshotLongDesc,
+		Example:     fmt.Sprintf(storageSnapshotExample, fullName),
+		Args:        cobra.RangeArgs(1, 2),
+		Annotations: map[string]string{"machineoutput": "json"},
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+
+	storageSnapshotCmd.Flags().StringVar(&o.snapshotClassFlag, "snapshot-class", "", "Name of the VolumeSnapshotClass to use, the default class of the cluster is used if not specified")
+
+	odoutil.AddContextFlag(storageSnapshotCmd, &o.contextFlag)
+	completion.RegisterCommandFlagHandler(storageSnapshotCmd, "context", completion.FileCompletionHandler)
+
+	return storageSnapshotCmd
+}
+
+// validatePersistentStorage checks that the storage exists in the devfile and is not ephemeral
+func validatePersistentStorage(context *genericclioptions.Context, storageName string) error {
+	gotStorage, err := context.LocalConfigProvider.GetStorage(storageName)
+	if err != nil {
+		return err
+	}
+	if gotStorage == nil {
+		return fmt.Errorf("the storage %v does not exist in the component %v", storageName, context.LocalConfigProvider.GetName())
+	}
+	if gotStorage.Ephemeral != nil && *gotStorage.Ephemeral {
+		return fmt.Errorf("the storage %v is ephemeral, only persistent storage is supported by this command", storageName)
+	}
+	return nil
+}
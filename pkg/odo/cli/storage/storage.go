@@ -21,20 +21,29 @@ func NewCmdStorage(name, fullName string) *cobra.Command {
 	storageCreateCmd := NewCmdStorageCreate(createRecommendedCommandName, odoutil.GetFullName(fullName, createRecommendedCommandName))
 	storageDeleteCmd := NewCmdStorageDelete(deleteRecommendedCommandName, odoutil.GetFullName(fullName, deleteRecommendedCommandName))
 	storageListCmd := NewCmdStorageList(listRecommendedCommandName, odoutil.GetFullName(fullName, listRecommendedCommandName))
+	storageSnapshotCmd := NewCmdStorageSnapshot(snapshotRecommendedCommandName, odoutil.GetFullName(fullName, snapshotRecommendedCommandName))
+	storageRestoreCmd := NewCmdStorageRestore(restoreRecommendedCommandName, odoutil.GetFullName(fullName, restoreRecommendedCommandName))
+	storageCpCmd := NewCmdStorageCp(cpRecommendedCommandName, odoutil.GetFullName(fullName, cpRecommendedCommandName))
 
 	var storageCmd = &cobra.Command{
 		Use:   name,
 		Short: storageShortDesc,
 		Long:  storageLongDesc,
-		Example: fmt.Sprintf("%s\n\n%s\n\n%s",
+		Example: fmt.Sprintf("%s\n\n%s\n\n%s\n\n%s\n\n%s\n\n%s",
 			storageCreateCmd.Example,
 			storageDeleteCmd.Example,
-			storageListCmd.Example),
+			storageListCmd.Example,
+			storageSnapshotCmd.Example,
+			storageRestoreCmd.Example,
+			storageCpCmd.Example),
 	}
 
 	storageCmd.AddCommand(storageCreateCmd)
 	storageCmd.AddCommand(storageDeleteCmd)
 	storageCmd.AddCommand(storageListCmd)
+	storageCmd.AddCommand(storageSnapshotCmd)
+	storageCmd.AddCommand(storageRestoreCmd)
+	storageCmd.AddCommand(storageCpCmd)
 
 	// Add a defined annotation in order to appear in the help menu
 	storageCmd.Annotations = map[string]string{"command": "main"}
@@ -41,6 +41,9 @@ type odoSettings struct {
 	// RegistryCacheTime how long odo should cache information from registry
 	RegistryCacheTime *int `yaml:"RegistryCacheTime,omitempty"`
 
+	// RegistryTimeout how long odo should wait for the index of a registry
+	RegistryTimeout *int `yaml:"RegistryTimeout,omitempty"`
+
 	// Ephemeral if true creates odo emptyDir to store odo source code
 	Ephemeral *bool `yaml:"Ephemeral,omitempty"`
 
@@ -53,6 +56,34 @@ type Registry struct {
 	Name   string `yaml:"Name,omitempty"`
 	URL    string `yaml:"URL,omitempty"`
 	Secure bool
+	// Auth configures the authentication to the registry; a secure registry without Auth uses a bearer token
+	Auth *RegistryAuth `yaml:"Auth,omitempty" json:",omitempty"`
+	// PublicKey is the path of the public key verifying the signatures of the stacks of the registry
+	PublicKey string `yaml:"PublicKey,omitempty" json:",omitempty"`
+}
+
+// Authentication types of the registries
+const (
+	// RegistryAuthBasic sends the username and the password stored in the keyring with the basic authentication scheme
+	RegistryAuthBasic = "basic"
+	// RegistryAuthBearer sends the token stored in the keyring with the bearer authentication scheme
+	RegistryAuthBearer = "bearer"
+	// RegistryAuthDocker uses the credentials of the registry host stored in the docker config.json file,
+	// or by the docker credential helpers it configures
+	RegistryAuthDocker = "docker"
+)
+
+// RegistryAuth holds the authentication configuration of a registry, the secrets are stored in the keyring
+type RegistryAuth struct {
+	// Type is the authentication type, one of basic, bearer or docker; empty when only TLS is configured
+	Type string `yaml:"Type,omitempty" json:",omitempty"`
+	// Username is the username of the basic authentication
+	Username string `yaml:"Username,omitempty" json:",omitempty"`
+	// ClientCert and ClientKey are the paths of the client TLS certificate and of its private key
+	ClientCert string `yaml:"ClientCert,omitempty" json:",omitempty"`
+	ClientKey  string `yaml:"ClientKey,omitempty" json:",omitempty"`
+	// CABundle is the path of the bundle of the certificate authorities trusted to verify the registry certificate
+	CABundle string `yaml:"CABundle,omitempty" json:",omitempty"`
 }
 
 // Preference stores all the preferences related to odo
@@ -86,6 +117,15 @@ func getPreferenceFile() (string, error) {
 	return filepath.Join(currentUser.HomeDir, ".odo", configFileName), nil
 }
 
+// GetConfigDir returns the directory containing the preference file, where the global configuration of odo is stored
+func GetConfigDir() (string, error) {
+	preferenceFile, err := getPreferenceFile()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Dir(preferenceFile), nil
+}
+
 func NewClient() (Client, error) {
 	return newPreferenceInfo()
 }
@@ -154,14 +194,14 @@ func newPreferenceInfo() (*preferenceInfo, error) {
 }
 
 // RegistryHandler handles registry add, update and delete operations
-func (c *preferenceInfo) RegistryHandler(operation string, registryName string, registryURL string, forceFlag bool, isSecure bool) error {
+func (c *preferenceInfo) RegistryHandler(operation string, registryName string, registryURL string, forceFlag bool, isSecure bool, auth *RegistryAuth, publicKey string) error {
 	var registryList []Registry
 	var err error
 	var registryExist bool
 
 	// Registry list is empty
 	if c.OdoSettings.RegistryList == nil {
-		registryList, err = handleWithoutRegistryExist(registryList, operation, registryName, registryURL, isSecure)
+		registryList, err = handleWithoutRegistryExist(registryList, operation, registryName, registryURL, isSecure, auth, publicKey)
 		if err != nil {
 			return err
 		}
@@ -171,7 +211,7 @@ func (c *preferenceInfo) RegistryHandler(operation string, registryName string,
 		for index, registry := range registryList {
 			if registry.Name == registryName {
 				registryExist = true
-				registryList, err = handleWithRegistryExist(index, registryList, operation, registryName, registryURL, forceFlag, isSecure)
+				registryList, err = handleWithRegistryExist(index, registryList, operation, registryName, registryURL, forceFlag, isSecure, auth, publicKey)
 				if err != nil {
 					return err
 				}
@@ -180,7 +220,7 @@ func (c *preferenceInfo) RegistryHandler(operation string, registryName string,
 
 		// The target registry doesn't exist in the registry list
 		if !registryExist {
-			registryList, err = handleWithoutRegistryExist(registryList, operation, registryName, registryURL, isSecure)
+			registryList, err = handleWithoutRegistryExist(registryList, operation, registryName, registryURL, isSecure, auth, publicKey)
 			if err != nil {
 				return err
 			}
@@ -196,14 +236,16 @@ func (c *preferenceInfo) RegistryHandler(operation string, registryName string,
 	return nil
 }
 
-func handleWithoutRegistryExist(registryList []Registry, operation string, registryName string, registryURL string, isSecure bool) ([]Registry, error) {
+func handleWithoutRegistryExist(registryList []Registry, operation string, registryName string, registryURL string, isSecure bool, auth *RegistryAuth, publicKey string) ([]Registry, error) {
 	switch operation {
 
 	case "add":
 		registry := Registry{
-			Name:   registryName,
-			URL:    registryURL,
-			Secure: isSecure,
+			Name:      registryName,
+			URL:       registryURL,
+			Secure:    isSecure,
+			Auth:      auth,
+			PublicKey: publicKey,
 		}
 		registryList = append(registryList, registry)
 
@@ -214,7 +256,7 @@ func handleWithoutRegistryExist(registryList []Registry, operation string, regis
 	return registryList, nil
 }
 
-func handleWithRegistryExist(index int, registryList []Registry, operation string, registryName string, registryURL string, forceFlag bool, isSecure bool) ([]Registry, error) {
+func handleWithRegistryExist(index int, registryList []Registry, operation string, registryName string, registryURL string, forceFlag bool, isSecure bool, auth *RegistryAuth, publicKey string) ([]Registry, error) {
 	switch operation {
 
 	case "add":
@@ -230,6 +272,8 @@ func handleWithRegistryExist(index int, registryList []Registry, operation strin
 
 		registryList[index].URL = registryURL
 		registryList[index].Secure = isSecure
+		registryList[index].Auth = auth
+		registryList[index].PublicKey = publicKey
 		log.Info("Successfully updated registry")
 
 	case "delete":
@@ -296,6 +340,16 @@ func (c *preferenceInfo) SetConfiguration(parameter string, value string) error
 			}
 			c.OdoSettings.RegistryCacheTime = &typedval
 
+		case "registrytimeout":
+			typedval, err := strconv.Atoi(value)
+			if err != nil {
+				return errors.Errorf("unable to set %q to %q, value must be an integer", parameter, value)
+			}
+			if typedval < 0 {
+				return errors.Errorf("cannot set timeout to less than 0")
+			}
+			c.OdoSettings.RegistryTimeout = &typedval
+
 		case "updatenotification":
 			val, err := strconv.ParseBool(strings.ToLower(value))
 			if err != nil {
@@ -380,6 +434,11 @@ func (c *preferenceInfo) GetRegistryCacheTime() int {
 	return util.GetIntOrDefault(c.OdoSettings.RegistryCacheTime, DefaultRegistryCacheTime)
 }
 
+// GetRegistryTimeout gets the value set by RegistryTimeout
+func (c *preferenceInfo) GetRegistryTimeout() int {
+	return util.GetIntOrDefault(c.OdoSettings.RegistryTimeout, DefaultRegistryTimeout)
+}
+
 // GetUpdateNotification returns the value of UpdateNotification from preferences
 // and if absent then returns default
 func (c *preferenceInfo) GetUpdateNotification() bool {
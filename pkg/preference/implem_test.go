@@ -436,6 +436,21 @@ func TestSetConfiguration(t *testing.T) {
 			wantErr: false,
 			want:    false,
 		},
+		{
+			name:           fmt.Sprintf("Case 22: set %s to 10", RegistryTimeoutSetting),
+			parameter:      "registrytimeout",
+			value:          "10",
+			existingConfig: Preference{},
+			want:           10,
+			wantErr:        false,
+		},
+		{
+			name:           fmt.Sprintf("Case 23: set %s to negative value", RegistryTimeoutSetting),
+			parameter:      RegistryTimeoutSetting,
+			value:          "-1",
+			existingConfig: Preference{},
+			wantErr:        true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -463,6 +478,10 @@ func TestSetConfiguration(t *testing.T) {
 					if *cfg.OdoSettings.RegistryCacheTime != tt.want {
 						t.Errorf("unexpected value after execution of SetConfiguration\ngot: %v \nexpected: %d\n", *cfg.OdoSettings.RegistryCacheTime, tt.want)
 					}
+				case "registrytimeout":
+					if *cfg.OdoSettings.RegistryTimeout != tt.want {
+						t.Errorf("unexpected value after execution of SetConfiguration\ngot: %v \nexpected: %d\n", *cfg.OdoSettings.RegistryTimeout, tt.want)
+					}
 				}
 			} else if tt.wantErr && err != nil {
 				// negative cases
@@ -712,7 +731,7 @@ func TestHandleWithoutRegistryExist(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := handleWithoutRegistryExist(tt.registryList, tt.operation, tt.registryName, tt.registryURL, false)
+			got, err := handleWithoutRegistryExist(tt.registryList, tt.operation, tt.registryName, tt.registryURL, false, nil, "")
 			if err != nil {
 				t.Logf("Error message is %v", err)
 			}
@@ -788,7 +807,7 @@ func TestHandleWithRegistryExist(t *testing.T) {
 	}
 
 	for _, tt := range tests {
-		got, err := handleWithRegistryExist(tt.index, tt.registryList, tt.operation, tt.registryName, tt.registryURL, tt.forceFlag, false)
+		got, err := handleWithRegistryExist(tt.index, tt.registryList, tt.operation, tt.registryName, tt.registryURL, tt.forceFlag, false, nil, "")
 		if err != nil {
 			t.Logf("Error message is %v", err)
 		}
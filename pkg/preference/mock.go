@@ -173,6 +173,20 @@ func (mr *MockClientMockRecorder) GetRegistryCacheTime() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegistryCacheTime", reflect.TypeOf((*MockClient)(nil).GetRegistryCacheTime))
 }
 
+// GetRegistryTimeout mocks base method.
+func (m *MockClient) GetRegistryTimeout() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRegistryTimeout")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// GetRegistryTimeout indicates an expected call of GetRegistryTimeout.
+func (mr *MockClientMockRecorder) GetRegistryTimeout() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegistryTimeout", reflect.TypeOf((*MockClient)(nil).GetRegistryTimeout))
+}
+
 // GetTimeout mocks base method.
 func (m *MockClient) GetTimeout() int {
 	m.ctrl.T.Helper()
@@ -258,17 +272,17 @@ func (mr *MockClientMockRecorder) PushTimeout() *gomock.Call {
 }
 
 // RegistryHandler mocks base method.
-func (m *MockClient) RegistryHandler(operation, registryName, registryURL string, forceFlag, isSecure bool) error {
+func (m *MockClient) RegistryHandler(operation, registryName, registryURL string, forceFlag, isSecure bool, auth *RegistryAuth, publicKey string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RegistryHandler", operation, registryName, registryURL, forceFlag, isSecure)
+	ret := m.ctrl.Call(m, "RegistryHandler", operation, registryName, registryURL, forceFlag, isSecure, auth, publicKey)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // RegistryHandler indicates an expected call of RegistryHandler.
-func (mr *MockClientMockRecorder) RegistryHandler(operation, registryName, registryURL, forceFlag, isSecure interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) RegistryHandler(operation, registryName, registryURL, forceFlag, isSecure, auth, publicKey interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegistryHandler", reflect.TypeOf((*MockClient)(nil).RegistryHandler), operation, registryName, registryURL, forceFlag, isSecure)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegistryHandler", reflect.TypeOf((*MockClient)(nil).RegistryHandler), operation, registryName, registryURL, forceFlag, isSecure, auth, publicKey)
 }
 
 // RegistryList mocks base method.
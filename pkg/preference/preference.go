@@ -13,7 +13,8 @@ type Client interface {
 	GetEphemeralSourceVolume() bool
 	GetConsentTelemetry() bool
 	GetRegistryCacheTime() int
-	RegistryHandler(operation string, registryName string, registryURL string, forceFlag bool, isSecure bool) error
+	GetRegistryTimeout() int
+	RegistryHandler(operation string, registryName string, registryURL string, forceFlag bool, isSecure bool, auth *RegistryAuth, publicKey string) error
 
 	UpdateNotification() *bool
 	NamePrefix() *string
@@ -46,6 +46,9 @@ const (
 	// RegistryCacheTimeSetting is human-readable description for the registrycachetime setting
 	RegistryCacheTimeSetting = "RegistryCacheTime"
 
+	// RegistryTimeoutSetting is the name of the setting controlling RegistryTimeout
+	RegistryTimeoutSetting = "RegistryTimeout"
+
 	// DefaultDevfileRegistryName is the name of default devfile registry
 	DefaultDevfileRegistryName = "DefaultDevfileRegistry"
 
@@ -58,6 +61,9 @@ const (
 	// DefaultRegistryCacheTime is time (in minutes) for how long odo will cache information from Devfile registry
 	DefaultRegistryCacheTime = 15
 
+	// DefaultRegistryTimeout is the default timeout (in seconds) for getting the index of a Devfile registry
+	DefaultRegistryTimeout = 30
+
 	// EphemeralSetting specifies if ephemeral volumes needs to be used as source volume.
 	EphemeralSetting = "Ephemeral"
 
@@ -83,6 +89,9 @@ var BuildTimeoutSettingDescription = fmt.Sprintf("BuildTimeout (in seconds) for
 // RegistryCacheTimeDescription adds a description for RegistryCacheTime
 var RegistryCacheTimeDescription = fmt.Sprintf("For how long (in minutes) odo will cache information from Devfile registry (Default: %d)", DefaultRegistryCacheTime)
 
+// RegistryTimeoutDescription adds a description for RegistryTimeout
+var RegistryTimeoutDescription = fmt.Sprintf("RegistryTimeout (in seconds) for getting the index of a Devfile registry (Default: %d)", DefaultRegistryTimeout)
+
 // EphemeralDescription adds a description for EphemeralSourceVolume
 var EphemeralDescription = fmt.Sprintf("If true, odo will create an emptyDir volume to store source code (Default: %t)", DefaultEphemeralSettings)
 
@@ -102,6 +111,7 @@ var (
 		BuildTimeoutSetting:       BuildTimeoutSettingDescription,
 		PushTimeoutSetting:        PushTimeoutSettingDescription,
 		RegistryCacheTimeSetting:  RegistryCacheTimeDescription,
+		RegistryTimeoutSetting:    RegistryTimeoutDescription,
 		EphemeralSetting:          EphemeralDescription,
 		ConsentTelemetrySetting:   ConsentTelemetryDescription,
 	}
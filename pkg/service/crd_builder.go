@@ -1,21 +1,31 @@
 package service
 
 import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 
+	"github.com/ghodss/yaml"
 	"github.com/go-openapi/spec"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
 	"github.com/pkg/errors"
 )
 
 // BuildCRDFromParams iterates over the parameter maps provided by the user and builds the CRD
+// a parameter is expressed as <path>=<value>, where the path is a dot-separated list of fields of the spec, each field
+// being optionally followed by indexes of list elements, e.g. nodes[0].size; the value is either
+// - @<file>, to use the content of a YAML or JSON file as value,
+// - a JSON object or array, e.g. {"name":"tls","port":9093},
+// - or a scalar, converted to the type of the field defined by the schema of the CRD
 func BuildCRDFromParams(paramMap map[string]string, crd *spec.Schema, group, version, kind string) (map[string]interface{}, error) {
-	spec := map[string]interface{}{}
-	for k, v := range paramMap {
-		err := addParam(spec, crd, k, v)
-		if err != nil {
-			return nil, err
-		}
+	spec, err := setParams(map[string]interface{}{}, paramMap, crd, false)
+	if err != nil {
+		return nil, err
 	}
 
 	result := map[string]interface{}{}
@@ -26,38 +36,247 @@ func BuildCRDFromParams(paramMap map[string]string, crd *spec.Schema, group, ver
 	return result, nil
 }
 
-func addParam(m map[string]interface{}, crd *spec.Schema, key string, value string) error {
-	if strings.Contains(key, ".") {
-		parts := strings.SplitN(key, ".", 2)
-		property := parts[0]
-		_, found := m[property]
-		if !found {
-			m[property] = map[string]interface{}{}
+// UpdateSpecFromParams sets the values of the parameters provided by the user in the spec of an existing service,
+// replacing the values already defined; the parameters are expressed as for BuildCRDFromParams
+func UpdateSpecFromParams(spec map[string]interface{}, paramMap map[string]string, crd *spec.Schema) (map[string]interface{}, error) {
+	if spec == nil {
+		spec = map[string]interface{}{}
+	}
+	return setParams(spec, paramMap, crd, true)
+}
+
+// setParams sets the values of the parameters in the spec; when overwrite is false, setting a value already defined is an error
+func setParams(spec map[string]interface{}, paramMap map[string]string, crd *spec.Schema, overwrite bool) (map[string]interface{}, error) {
+	// process the parameters in a stable order, to report errors consistently
+	keys := make([]string, 0, len(paramMap))
+	for k := range paramMap {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var result interface{} = spec
+	for _, k := range keys {
+		path, err := parseParamPath(k)
+		if err != nil {
+			return nil, err
 		}
-		submap, ok := m[property].(map[string]interface{})
-		if !ok {
-			return errors.New("already defined")
+		result, err = addParam(result, crd, path, 0, k, paramMap[k], overwrite)
+		if err != nil {
+			return nil, err
 		}
-		var subCRD *spec.Schema
-		if crd != nil {
-			s := crd.Properties[property]
-			subCRD = &s
+	}
+	if err := checkListElements(result, ""); err != nil {
+		return nil, err
+	}
+	return result.(map[string]interface{}), nil
+}
+
+// ValidateParamValue returns an error if the value of a parameter, as given on the command line,
+// doesn't match the schema of the field it sets once converted to the type of the field
+func ValidateParamValue(crd *spec.Schema, value string) error {
+	if crd == nil {
+		return nil
+	}
+	return validate.AgainstSchema(crd, convertType(crd, value), strfmt.Default)
+}
+
+// paramPathElement is an element of the path of a parameter, either the name of a field or the index of a list element
+type paramPathElement struct {
+	field   string
+	index   int
+	isIndex bool
+}
+
+// paramPathRegexp matches an element of the path of a parameter: a field name followed by optional indexes, e.g. nodes[0]
+var paramPathRegexp = regexp.MustCompile(`^([^.\[\]]+)((?:\[[0-9]+\])*)$`)
+
+// parseParamPath parses the path of a parameter, e.g. listeners[0].port
+func parseParamPath(key string) ([]paramPathElement, error) {
+	var path []paramPathElement
+	for _, part := range strings.Split(key, ".") {
+		matches := paramPathRegexp.FindStringSubmatch(part)
+		if matches == nil {
+			return nil, fmt.Errorf("invalid parameter %q: use a path of the form <field>.<field>[<index>].<field>", key)
+		}
+		path = append(path, paramPathElement{field: matches[1]})
+		for _, index := range strings.Split(strings.TrimPrefix(matches[2], "["), "[") {
+			if index == "" {
+				continue
+			}
+			i, err := strconv.Atoi(strings.TrimSuffix(index, "]"))
+			if err != nil {
+				return nil, fmt.Errorf("invalid parameter %q: invalid index %q", key, index)
+			}
+			path = append(path, paramPathElement{index: i, isIndex: true})
+		}
+	}
+	return path, nil
+}
+
+// formatParamPath returns the representation of the first elements of the path of a parameter
+func formatParamPath(path []paramPathElement) string {
+	var b strings.Builder
+	for i, elem := range path {
+		if elem.isIndex {
+			fmt.Fprintf(&b, "[%d]", elem.index)
+			continue
+		}
+		if i > 0 {
+			b.WriteString(".")
 		}
-		err := addParam(submap, subCRD, parts[1], value)
+		b.WriteString(elem.field)
+	}
+	return b.String()
+}
+
+// addParam sets the value of the parameter at the position i of its path in current, the value built so far at this position,
+// crd being the schema at this position if known, and returns the new value at this position
+// a value already defined at the end of the path is replaced only if overwrite is true
+func addParam(current interface{}, crd *spec.Schema, path []paramPathElement, i int, key string, value string, overwrite bool) (interface{}, error) {
+	if i == len(path) {
+		if current != nil && !overwrite {
+			return nil, fmt.Errorf("invalid parameter %q: %q is already defined", key, formatParamPath(path))
+		}
+		return parseParamValue(crd, key, value)
+	}
+
+	elem := path[i]
+	if elem.isIndex {
+		list, ok := current.([]interface{})
+		if current != nil && !ok {
+			return nil, fmt.Errorf("invalid parameter %q: %q is already defined and is not a list", key, formatParamPath(path[:i]))
+		}
+		subCRD, err := getItemsSchema(crd, path[:i], key)
 		if err != nil {
-			return err
+			return nil, err
 		}
-	} else {
-		if _, found := m[key]; found {
-			return errors.New("already defined")
+		for len(list) <= elem.index {
+			list = append(list, nil)
 		}
+		list[elem.index], err = addParam(list[elem.index], subCRD, path, i+1, key, value, overwrite)
+		if err != nil {
+			return nil, err
+		}
+		return list, nil
+	}
+
+	m, ok := current.(map[string]interface{})
+	if current == nil {
+		m = map[string]interface{}{}
+	} else if !ok {
+		return nil, fmt.Errorf("invalid parameter %q: %q is already defined and is not an object", key, formatParamPath(path[:i]))
+	}
+	subCRD, err := getPropertySchema(crd, path[:i+1], key)
+	if err != nil {
+		return nil, err
+	}
+	m[elem.field], err = addParam(m[elem.field], subCRD, path, i+1, key, value, overwrite)
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// getPropertySchema returns the schema of the last field of the path in crd, the schema of the object containing it,
+// or nil if the schema of the field is unknown; it returns an error if the schema doesn't allow the field
+func getPropertySchema(crd *spec.Schema, path []paramPathElement, key string) (*spec.Schema, error) {
+	if crd == nil {
+		return nil, nil
+	}
+	field := path[len(path)-1].field
+	if len(crd.Type) > 0 && !crd.Type.Contains("object") {
+		return nil, fmt.Errorf("invalid parameter %q: %q is of type %s and has no field %q", key, formatParamPath(path[:len(path)-1]), strings.Join(crd.Type, ", "), field)
+	}
+	if property, ok := crd.Properties[field]; ok {
+		return &property, nil
+	}
+	if crd.AdditionalProperties != nil && crd.AdditionalProperties.Schema != nil {
+		return crd.AdditionalProperties.Schema, nil
+	}
+	preserveUnknownFields, _ := crd.Extensions.GetBool("x-kubernetes-preserve-unknown-fields")
+	if len(crd.Properties) == 0 || preserveUnknownFields || (crd.AdditionalProperties != nil && crd.AdditionalProperties.Allows) {
+		// the object accepts any field
+		return nil, nil
+	}
 
-		var subCRD *spec.Schema
-		if crd != nil {
-			s := crd.Properties[key]
-			subCRD = &s
+	fields := make([]string, 0, len(crd.Properties))
+	for name := range crd.Properties {
+		fields = append(fields, name)
+	}
+	sort.Strings(fields)
+	return nil, fmt.Errorf("invalid parameter %q: %q doesn't exist in the schema of the service; the possible fields are: %s", key, formatParamPath(path), strings.Join(fields, ", "))
+}
+
+// getItemsSchema returns the schema of the elements of the list at the given path, or nil if unknown;
+// it returns an error if the schema defines the field as something else than a list
+func getItemsSchema(crd *spec.Schema, path []paramPathElement, key string) (*spec.Schema, error) {
+	if crd == nil || len(crd.Type) == 0 {
+		return nil, nil
+	}
+	if !crd.Type.Contains("array") {
+		return nil, fmt.Errorf("invalid parameter %q: %q is of type %s and not a list", key, formatParamPath(path), strings.Join(crd.Type, ", "))
+	}
+	if crd.Items == nil || crd.Items.Schema == nil {
+		return nil, nil
+	}
+	return crd.Items.Schema, nil
+}
+
+// parseParamValue returns the value of a parameter: the content of a file when prefixed by @, a JSON object or array,
+// or a scalar converted to the type defined by the schema; @@ can be used for a value starting with @
+func parseParamValue(crd *spec.Schema, key string, value string) (interface{}, error) {
+	switch {
+	case strings.HasPrefix(value, "@@"):
+		return convertType(crd, value[1:]), nil
+
+	case strings.HasPrefix(value, "@"):
+		content, err := ioutil.ReadFile(value[1:])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid parameter %q: unable to read the file %q", key, value[1:])
+		}
+		var v interface{}
+		if err = yaml.Unmarshal(content, &v); err != nil {
+			return nil, errors.Wrapf(err, "invalid parameter %q: unable to parse the file %q", key, value[1:])
+		}
+		return v, nil
+
+	case (strings.HasPrefix(value, "{") || strings.HasPrefix(value, "[")) && (crd == nil || !crd.Type.Contains("string")):
+		var v interface{}
+		err := json.Unmarshal([]byte(value), &v)
+		if err == nil {
+			return v, nil
+		}
+		if crd != nil && len(crd.Type) > 0 {
+			return nil, errors.Wrapf(err, "invalid parameter %q: invalid JSON value", key)
+		}
+		// without a schema, the value can be a string
+		return value, nil
+	}
+	return convertType(crd, value), nil
+}
+
+// checkListElements returns an error if an element of a list has not been defined by the parameters, e.g. nodes[1] without nodes[0]
+func checkListElements(value interface{}, path string) error {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			p := key
+			if path != "" {
+				p = path + "." + key
+			}
+			if err := checkListElements(item, p); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			if item == nil {
+				return fmt.Errorf("the element %d of the list %q is not defined; the elements of a list must be defined from index 0", i, path)
+			}
+			if err := checkListElements(item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
 		}
-		m[key] = convertType(subCRD, value)
 	}
 	return nil
 }
@@ -2,6 +2,9 @@ package service
 
 import (
 	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 
@@ -183,3 +186,235 @@ func TestBuildCRDFromParams(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateParamValue(t *testing.T) {
+	minimum := float64(1)
+	tests := []struct {
+		name    string
+		crd     *spec.Schema
+		value   string
+		wantErr bool
+	}{
+		{
+			name:  "no schema",
+			value: "anything",
+		},
+		{
+			name:  "valid integer",
+			crd:   &spec.Schema{SchemaProps: spec.SchemaProps{Type: []string{"integer"}, Minimum: &minimum}},
+			value: "3",
+		},
+		{
+			name:    "invalid integer",
+			crd:     &spec.Schema{SchemaProps: spec.SchemaProps{Type: []string{"integer"}}},
+			value:   "three",
+			wantErr: true,
+		},
+		{
+			name:    "integer below the minimum",
+			crd:     &spec.Schema{SchemaProps: spec.SchemaProps{Type: []string{"integer"}, Minimum: &minimum}},
+			value:   "0",
+			wantErr: true,
+		},
+		{
+			name:  "value of the enum",
+			crd:   &spec.Schema{SchemaProps: spec.SchemaProps{Type: []string{"string"}, Enum: []interface{}{"ClusterIP", "NodePort"}}},
+			value: "NodePort",
+		},
+		{
+			name:    "value out of the enum",
+			crd:     &spec.Schema{SchemaProps: spec.SchemaProps{Type: []string{"string"}, Enum: []interface{}{"ClusterIP", "NodePort"}}},
+			value:   "LoadBalancer",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateParamValue(tt.crd, tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateParamValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBuildCRDFromParamsWithStructuredValues(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "params")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+	usersFile := filepath.Join(tmpDir, "users.yaml")
+	err = ioutil.WriteFile(usersFile, []byte("- name: admin\n  databases: [app]\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	listener := spec.Schema{SchemaProps: spec.SchemaProps{
+		Type: spec.StringOrArray{"object"},
+		Properties: map[string]spec.Schema{
+			"name": *spec.StringProperty(),
+			"port": *spec.Int64Property(),
+			"tls":  *spec.BoolProperty(),
+		},
+	}}
+	crd := &spec.Schema{SchemaProps: spec.SchemaProps{
+		Type: spec.StringOrArray{"object"},
+		Properties: map[string]spec.Schema{
+			"listeners": *spec.ArrayProperty(&listener),
+			"users":     *spec.ArrayProperty(new(spec.Schema).Typed("object", "")),
+			"config":    *spec.MapProperty(nil),
+			"password":  *spec.StringProperty(),
+			"replicas":  *spec.Int64Property(),
+		},
+	}}
+
+	tests := []struct {
+		name    string
+		params  map[string]string
+		want    map[string]interface{}
+		wantErr bool
+	}{
+		{
+			name: "indexed lists",
+			params: map[string]string{
+				"listeners[0].name": "plain",
+				"listeners[0].port": "9092",
+				"listeners[1].name": "tls",
+				"listeners[1].tls":  "true",
+			},
+			want: map[string]interface{}{
+				"listeners": []interface{}{
+					map[string]interface{}{"name": "plain", "port": int64(9092)},
+					map[string]interface{}{"name": "tls", "tls": true},
+				},
+			},
+		},
+		{
+			name: "JSON literals and file",
+			params: map[string]string{
+				"listeners[0]": `{"name":"plain","port":9092}`,
+				"config":       `{"log.retention.hours":168}`,
+				"users":        "@" + usersFile,
+				"password":     "@@secret",
+			},
+			want: map[string]interface{}{
+				"listeners": []interface{}{
+					map[string]interface{}{"name": "plain", "port": float64(9092)},
+				},
+				"config": map[string]interface{}{"log.retention.hours": float64(168)},
+				"users": []interface{}{
+					map[string]interface{}{"name": "admin", "databases": []interface{}{"app"}},
+				},
+				"password": "@secret",
+			},
+		},
+		{
+			name:    "field not in the schema",
+			params:  map[string]string{"listeners[0].address": "0.0.0.0"},
+			wantErr: true,
+		},
+		{
+			name:    "index of a field which is not a list",
+			params:  map[string]string{"replicas[0]": "1"},
+			wantErr: true,
+		},
+		{
+			name:    "missing list element",
+			params:  map[string]string{"listeners[1].name": "tls"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid JSON",
+			params:  map[string]string{"listeners[0]": `{"name":}`},
+			wantErr: true,
+		},
+		{
+			name:    "missing file",
+			params:  map[string]string{"users": "@" + filepath.Join(tmpDir, "missing.yaml")},
+			wantErr: true,
+		},
+		{
+			name:    "invalid path",
+			params:  map[string]string{"listeners[a].name": "tls"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BuildCRDFromParams(tt.params, crd, "a group", "a version", "a kind")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("BuildCRDFromParams() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && !reflect.DeepEqual(got["spec"], tt.want) {
+				jsonGot, _ := json.Marshal(got["spec"])
+				jsonWant, _ := json.Marshal(tt.want)
+				t.Errorf("\ngot:  %+v\n\nwant: %v\n", string(jsonGot), string(jsonWant))
+			}
+		})
+	}
+}
+
+func TestUpdateSpecFromParams(t *testing.T) {
+	crd := &spec.Schema{SchemaProps: spec.SchemaProps{
+		Type: spec.StringOrArray{"object"},
+		Properties: map[string]spec.Schema{
+			"size":    *spec.Int64Property(),
+			"version": *spec.StringProperty(),
+			"nodes": *spec.ArrayProperty(&spec.Schema{SchemaProps: spec.SchemaProps{
+				Type:       spec.StringOrArray{"object"},
+				Properties: map[string]spec.Schema{"name": *spec.StringProperty(), "size": *spec.Int64Property()},
+			}}),
+		},
+	}}
+	existing := func() map[string]interface{} {
+		return map[string]interface{}{
+			"size":    float64(3),
+			"version": "3.2.13",
+			"nodes": []interface{}{
+				map[string]interface{}{"name": "node-0", "size": float64(1)},
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		params  map[string]string
+		want    map[string]interface{}
+		wantErr bool
+	}{
+		{
+			name:   "replace and add values",
+			params: map[string]string{"size": "5", "nodes[0].size": "2", "nodes[1].name": "node-1"},
+			want: map[string]interface{}{
+				"size":    int64(5),
+				"version": "3.2.13",
+				"nodes": []interface{}{
+					map[string]interface{}{"name": "node-0", "size": int64(2)},
+					map[string]interface{}{"name": "node-1"},
+				},
+			},
+		},
+		{
+			name:    "list element not defined",
+			params:  map[string]string{"nodes[2].name": "node-2"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown field",
+			params:  map[string]string{"sise": "5"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := UpdateSpecFromParams(existing(), tt.params, crd)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
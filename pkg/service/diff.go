@@ -0,0 +1,291 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	devfile "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	devfilefs "github.com/devfile/library/pkg/testingutil/filesystem"
+	"github.com/redhat-developer/odo/pkg/kclient"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog"
+)
+
+// immutableXDescriptor is the x-descriptor used by the operators to mark the fields of the spec which should not be changed
+// once the service is created
+const immutableXDescriptor = "urn:alm:descriptor:io.kubernetes:immutable"
+
+// SpecChangeType is the type of change of a field of the spec of a service
+type SpecChangeType string
+
+const (
+	SpecFieldAdded   SpecChangeType = "added"
+	SpecFieldRemoved SpecChangeType = "removed"
+	SpecFieldChanged SpecChangeType = "changed"
+)
+
+// SpecChange is the change of a field of the spec of a service, between the service deployed on the cluster and its definition in the devfile
+type SpecChange struct {
+	// Path is the path of the field in the spec, e.g. nodes[0].size
+	Path      string
+	Type      SpecChangeType
+	OldValue  interface{}
+	NewValue  interface{}
+	Immutable bool
+}
+
+func (c SpecChange) String() string {
+	var s string
+	switch c.Type {
+	case SpecFieldAdded:
+		s = fmt.Sprintf("+ spec.%s: %s", c.Path, formatStatusValue(c.NewValue))
+	case SpecFieldRemoved:
+		s = fmt.Sprintf("- spec.%s: %s", c.Path, formatStatusValue(c.OldValue))
+	default:
+		s = fmt.Sprintf("~ spec.%s: %s -> %s", c.Path, formatStatusValue(c.OldValue), formatStatusValue(c.NewValue))
+	}
+	if c.Immutable {
+		s += " (immutable)"
+	}
+	return s
+}
+
+// ServiceChanges contains the changes of the spec of a service
+type ServiceChanges struct {
+	// Name is the name of the service, of the form <kind>/<name>
+	Name    string
+	Changes []SpecChange
+}
+
+// HasImmutableChanges returns true if fields marked as immutable by the operator are changed
+func (s ServiceChanges) HasImmutableChanges() bool {
+	for _, c := range s.Changes {
+		if c.Immutable {
+			return true
+		}
+	}
+	return false
+}
+
+// GetServicesChanges returns the changes of the spec of the operator backed services of the devfile already deployed on the cluster
+// the fields of the deployed services which are not in the devfile are reported as removed only if they were set by odo
+// the cluster is not accessed when the devfile declares no service
+func GetServicesChanges(client kclient.ClientInterface, k8sComponents []devfile.Component, context string) ([]ServiceChanges, error) {
+	var services []devfile.Component
+	var resources []unstructured.Unstructured
+	for _, c := range k8sComponents {
+		u, err := GetK8sComponentAsUnstructured(c.Kubernetes, context, devfilefs.DefaultFs{})
+		if err != nil {
+			return nil, err
+		}
+		if isLinkResource(u.GetKind()) {
+			continue
+		}
+		services = append(services, c)
+		resources = append(resources, u)
+	}
+	if len(services) == 0 {
+		return nil, nil
+	}
+
+	csvSupported, err := client.IsCSVSupported()
+	if err != nil || !csvSupported {
+		return nil, err
+	}
+
+	var result []ServiceChanges
+	for i, c := range services {
+		u := resources[i]
+		substituted, err := IsServiceSubstituted(client, c, u)
+		if err != nil {
+			return nil, err
+		}
+		if substituted {
+			continue
+		}
+		isOp, err := isOperatorBackedService(client, u)
+		if err != nil {
+			return nil, err
+		}
+		if !isOp {
+			continue
+		}
+
+		restMapping, err := client.GetRestMappingFromUnstructured(u)
+		if err != nil {
+			return nil, err
+		}
+		live, err := client.GetDynamicResource(restMapping.Resource.Group, restMapping.Resource.Version, restMapping.Resource.Resource, u.GetName())
+		if err != nil {
+			if kerrors.IsNotFound(err) {
+				// the service will be created
+				continue
+			}
+			return nil, err
+		}
+
+		changes := diffSpecs(live.Object["spec"], u.Object["spec"], getOwnedSpecFields(*live), false, "")
+		if len(changes) == 0 {
+			continue
+		}
+		markImmutableChanges(changes, getImmutablePaths(client, u.GetKind()))
+		result = append(result, ServiceChanges{Name: u.GetKind() + "/" + u.GetName(), Changes: changes})
+	}
+	return result, nil
+}
+
+// GetSpecChanges returns the changes between two versions of the spec of a service of the given kind,
+// marking the changes of the fields declared as immutable by its operator
+func GetSpecChanges(client kclient.ClientInterface, kind string, oldSpec, newSpec interface{}) []SpecChange {
+	changes := diffSpecs(oldSpec, newSpec, nil, true, "")
+	if len(changes) > 0 {
+		markImmutableChanges(changes, getImmutablePaths(client, kind))
+	}
+	return changes
+}
+
+// getOwnedSpecFields returns the fields of the spec set by odo when applying the service, as recorded in its managed fields,
+// or nil if unknown
+func getOwnedSpecFields(u unstructured.Unstructured) map[string]interface{} {
+	for _, entry := range u.GetManagedFields() {
+		if entry.Manager != kclient.FieldManager || entry.FieldsV1 == nil {
+			continue
+		}
+		var fields map[string]interface{}
+		if err := json.Unmarshal(entry.FieldsV1.Raw, &fields); err != nil {
+			klog.V(4).Infof("unable to parse the managed fields of %s/%s: %v", u.GetKind(), u.GetName(), err)
+			return nil
+		}
+		owned, _ := fields["f:spec"].(map[string]interface{})
+		return owned
+	}
+	return nil
+}
+
+// diffSpecs returns the changes between the live and the desired values at the given path
+// owned contains the fields at this path set by odo, and ownedAll is true when all the fields at this path are set by odo,
+// to report as removed only the fields which will be removed when applying the desired value
+func diffSpecs(live, desired interface{}, owned map[string]interface{}, ownedAll bool, path string) []SpecChange {
+	var changes []SpecChange
+	switch d := desired.(type) {
+	case map[string]interface{}:
+		l, ok := live.(map[string]interface{})
+		if !ok {
+			break
+		}
+		keys := make([]string, 0, len(d)+len(l))
+		for k := range d {
+			keys = append(keys, k)
+		}
+		for k := range l {
+			if _, found := d[k]; !found {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			fieldPath := k
+			if path != "" {
+				fieldPath = path + "." + k
+			}
+			ownedField, isOwned := owned["f:"+k]
+			ownedChild, _ := ownedField.(map[string]interface{})
+			desiredValue, inDesired := d[k]
+			liveValue, inLive := l[k]
+			switch {
+			case inDesired && inLive:
+				changes = append(changes, diffSpecs(liveValue, desiredValue, ownedChild, ownedAll, fieldPath)...)
+			case inDesired:
+				changes = append(changes, SpecChange{Path: fieldPath, Type: SpecFieldAdded, NewValue: desiredValue})
+			case ownedAll || isOwned:
+				changes = append(changes, SpecChange{Path: fieldPath, Type: SpecFieldRemoved, OldValue: liveValue})
+			}
+		}
+		return changes
+
+	case []interface{}:
+		l, ok := live.([]interface{})
+		if !ok {
+			break
+		}
+		// the elements of the lists are owned as a whole
+		ownedAll = ownedAll || owned != nil
+		for i := 0; i < len(d) || i < len(l); i++ {
+			elemPath := fmt.Sprintf("%s[%d]", path, i)
+			switch {
+			case i < len(d) && i < len(l):
+				changes = append(changes, diffSpecs(l[i], d[i], nil, ownedAll, elemPath)...)
+			case i < len(d):
+				changes = append(changes, SpecChange{Path: elemPath, Type: SpecFieldAdded, NewValue: d[i]})
+			case ownedAll:
+				changes = append(changes, SpecChange{Path: elemPath, Type: SpecFieldRemoved, OldValue: l[i]})
+			}
+		}
+		return changes
+	}
+
+	if !equalValues(live, desired) {
+		changes = append(changes, SpecChange{Path: path, Type: SpecFieldChanged, OldValue: live, NewValue: desired})
+	}
+	return changes
+}
+
+// equalValues returns true if the values are equal, the numbers being compared by value whatever their type
+func equalValues(a, b interface{}) bool {
+	fa, aIsNumber := toFloat(a)
+	fb, bIsNumber := toFloat(b)
+	if aIsNumber && bIsNumber {
+		return fa == fb
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case int:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case float32:
+		return float64(n), true
+	case float64:
+		return n, true
+	}
+	return 0, false
+}
+
+// getImmutablePaths returns the paths of the fields of the spec marked as immutable by the operator providing the given kind
+func getImmutablePaths(client kclient.ClientInterface, kind string) []string {
+	cr := getCRDDescription(client, kind)
+	if cr == nil {
+		return nil
+	}
+	var paths []string
+	for _, descriptor := range cr.SpecDescriptors {
+		for _, x := range descriptor.XDescriptors {
+			if x == immutableXDescriptor {
+				paths = append(paths, descriptor.Path)
+			}
+		}
+	}
+	return paths
+}
+
+// markImmutableChanges marks the changes of the fields at the given paths, or inside them
+func markImmutableChanges(changes []SpecChange, immutablePaths []string) {
+	for i := range changes {
+		for _, p := range immutablePaths {
+			if changes[i].Path == p || strings.HasPrefix(changes[i].Path, p+".") || strings.HasPrefix(changes[i].Path, p+"[") {
+				changes[i].Immutable = true
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestDiffSpecs(t *testing.T) {
+	live := map[string]interface{}{
+		"size":     int64(3),
+		"version":  "3.2.13",
+		"storage":  "ephemeral",
+		"defaults": map[string]interface{}{"timeout": int64(30)},
+		"nodes": []interface{}{
+			map[string]interface{}{"name": "node-0", "zone": "a"},
+			map[string]interface{}{"name": "node-1"},
+		},
+	}
+	desired := map[string]interface{}{
+		"size":    float64(3),
+		"version": "3.4.0",
+		"tls":     true,
+		"nodes": []interface{}{
+			map[string]interface{}{"name": "node-0"},
+		},
+	}
+
+	u := unstructured.Unstructured{}
+	u.SetManagedFields([]metav1.ManagedFieldsEntry{
+		{
+			Manager:  "etcd-operator",
+			FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:defaults":{"f:timeout":{}}}}`)},
+		},
+		{
+			Manager:  "odo",
+			FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:size":{},"f:version":{},"f:storage":{},"f:nodes":{}}}`)},
+		},
+	})
+
+	got := diffSpecs(live, desired, getOwnedSpecFields(u), false, "")
+	markImmutableChanges(got, []string{"storage", "nodes"})
+	want := []SpecChange{
+		{Path: "nodes[0].zone", Type: SpecFieldRemoved, OldValue: "a", Immutable: true},
+		{Path: "nodes[1]", Type: SpecFieldRemoved, OldValue: map[string]interface{}{"name": "node-1"}, Immutable: true},
+		{Path: "storage", Type: SpecFieldRemoved, OldValue: "ephemeral", Immutable: true},
+		{Path: "tls", Type: SpecFieldAdded, NewValue: true},
+		{Path: "version", Type: SpecFieldChanged, OldValue: "3.2.13", NewValue: "3.4.0"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got changes %v, want %v", got, want)
+	}
+
+	wantStrings := []string{
+		`- spec.nodes[0].zone: a (immutable)`,
+		`- spec.nodes[1]: {"name":"node-1"} (immutable)`,
+		`- spec.storage: ephemeral (immutable)`,
+		`+ spec.tls: true`,
+		`~ spec.version: 3.2.13 -> 3.4.0`,
+	}
+	for i, change := range got {
+		if change.String() != wantStrings[i] {
+			t.Errorf("got %q, want %q", change.String(), wantStrings[i])
+		}
+	}
+}
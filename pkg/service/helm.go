@@ -0,0 +1,344 @@
+package service
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	devfile "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/pkg/devfile/parser"
+	"github.com/devfile/library/pkg/devfile/parser/data/v2/common"
+	parsercommon "github.com/devfile/library/pkg/devfile/parser/data/v2/common"
+	devfilefs "github.com/devfile/library/pkg/testingutil/filesystem"
+	"github.com/pkg/errors"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/util"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// HelmReleaseLabel is the label set on the resources rendered from a Helm chart, with the name of the release
+const HelmReleaseLabel = "odo.dev/helm-release"
+
+// HelmChartAnnotation is the annotation set on the resources rendered from a Helm chart, with the chart they are rendered from
+const HelmChartAnnotation = "odo.dev/helm-chart"
+
+// HelmReleaseKind is the kind used by odo to refer to the Helm releases, e.g. HelmRelease/mydb
+const HelmReleaseKind = "HelmRelease"
+
+// helmReleaseAPIVersion is the API version of the summaries of the Helm releases returned by odo
+const helmReleaseAPIVersion = "odo.dev/v1alpha1"
+
+// keys of the data of the ConfigMaps recording the Helm releases pushed to the cluster
+const (
+	helmReleaseChartKey     = "chart"
+	helmReleaseResourcesKey = "resources"
+)
+
+// HelmResource identifies a resource of a Helm release
+type HelmResource struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+}
+
+// String returns the kind and name of the resource
+func (r HelmResource) String() string {
+	return r.Kind + "/" + r.Name
+}
+
+// helmRelease is a Helm release and the resources rendered from its chart
+type helmRelease struct {
+	chart     string
+	resources []HelmResource
+}
+
+// GetHelmReleaseComponentName returns the name of the devfile component holding a resource of a Helm release
+func GetHelmReleaseComponentName(release string, u unstructured.Unstructured) string {
+	name := util.GetDNS1123Name(strings.Join([]string{release, strings.ToLower(u.GetKind()), u.GetName()}, "-"))
+	return util.TruncateString(name, 63)
+}
+
+// getHelmReleaseRecordName returns the name of the ConfigMap recording a Helm release pushed to the cluster
+func getHelmReleaseRecordName(release string) string {
+	return util.TruncateString("odo-helm-release-"+release, 253)
+}
+
+// groupHelmReleases groups the resources rendered from Helm charts by release
+func groupHelmReleases(resources []unstructured.Unstructured) map[string]*helmRelease {
+	releases := map[string]*helmRelease{}
+	for _, u := range resources {
+		name, ok := u.GetLabels()[HelmReleaseLabel]
+		if !ok {
+			continue
+		}
+		if _, ok := releases[name]; !ok {
+			releases[name] = &helmRelease{chart: u.GetAnnotations()[HelmChartAnnotation]}
+		}
+		releases[name].resources = append(releases[name].resources, HelmResource{
+			APIVersion: u.GetAPIVersion(),
+			Kind:       u.GetKind(),
+			Name:       u.GetName(),
+		})
+	}
+	return releases
+}
+
+// getHelmReleaseSummary returns the description of a Helm release as an unstructured object,
+// so that it can be listed and described as the other services
+func getHelmReleaseSummary(name, chart string, resources []HelmResource) unstructured.Unstructured {
+	resourceNames := make([]interface{}, 0, len(resources))
+	for _, r := range resources {
+		resourceNames = append(resourceNames, r.String())
+	}
+	return unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": helmReleaseAPIVersion,
+		"kind":       HelmReleaseKind,
+		"metadata": map[string]interface{}{
+			"name": name,
+		},
+		"spec": map[string]interface{}{
+			"chart":     chart,
+			"resources": resourceNames,
+		},
+	}}
+}
+
+// ListDevfileHelmReleases returns the Helm releases whose resources are defined in a Devfile, indexed by HelmRelease/<name>
+func ListDevfileHelmReleases(devfileObj parser.DevfileObj, componentContext string) (map[string]unstructured.Unstructured, error) {
+	return listDevfileHelmReleases(devfileObj, componentContext, devfilefs.DefaultFs{})
+}
+
+func listDevfileHelmReleases(devfileObj parser.DevfileObj, componentContext string, fs devfilefs.Filesystem) (map[string]unstructured.Unstructured, error) {
+	resources, _, err := getDevfileHelmResources(devfileObj, componentContext, fs)
+	if err != nil {
+		return nil, err
+	}
+	releases := map[string]unstructured.Unstructured{}
+	for name, release := range groupHelmReleases(resources) {
+		releases[HelmReleaseKind+"/"+name] = getHelmReleaseSummary(name, release.chart, release.resources)
+	}
+	return releases, nil
+}
+
+// FindDevfileHelmReleaseComponents returns the names of the devfile components holding the resources of the given Helm release
+func FindDevfileHelmReleaseComponents(devfileObj parser.DevfileObj, release, componentContext string) ([]string, error) {
+	resources, componentNames, err := getDevfileHelmResources(devfileObj, componentContext, devfilefs.DefaultFs{})
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for i, u := range resources {
+		if u.GetLabels()[HelmReleaseLabel] == release {
+			names = append(names, componentNames[i])
+		}
+	}
+	return names, nil
+}
+
+// getDevfileHelmResources returns the resources of Helm releases defined in a Devfile, and the names of the components holding them
+func getDevfileHelmResources(devfileObj parser.DevfileObj, componentContext string, fs devfilefs.Filesystem) ([]unstructured.Unstructured, []string, error) {
+	if devfileObj.Data == nil {
+		return nil, nil, nil
+	}
+	components, err := devfileObj.Data.GetComponents(common.DevfileOptions{
+		ComponentOptions: parsercommon.ComponentOptions{ComponentType: devfile.KubernetesComponentType},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var resources []unstructured.Unstructured
+	var names []string
+	for _, c := range components {
+		u, err := GetK8sComponentAsUnstructured(c.Kubernetes, componentContext, fs)
+		if err != nil {
+			return nil, nil, err
+		}
+		if _, ok := u.GetLabels()[HelmReleaseLabel]; ok {
+			resources = append(resources, u)
+			names = append(names, c.Name)
+		}
+	}
+	return resources, names, nil
+}
+
+// ListDeployedHelmReleases returns the Helm releases pushed to the cluster by odo, for all the components
+func ListDeployedHelmReleases(client kclient.ClientInterface) ([]unstructured.Unstructured, error) {
+	records, err := client.ListConfigMaps(HelmReleaseLabel)
+	if err != nil {
+		return nil, err
+	}
+	var releases []unstructured.Unstructured
+	for _, record := range records {
+		resources, err := getHelmReleaseRecordResources(record)
+		if err != nil {
+			return nil, err
+		}
+		u := getHelmReleaseSummary(record.Labels[HelmReleaseLabel], record.Data[helmReleaseChartKey], resources)
+		u.SetLabels(record.Labels)
+		u.SetCreationTimestamp(record.CreationTimestamp)
+		releases = append(releases, u)
+	}
+	return releases, nil
+}
+
+// HelmReleaseExists returns true if the Helm release of the given name has been pushed to the cluster
+func HelmReleaseExists(client kclient.ClientInterface, name string) (bool, error) {
+	records, err := client.ListConfigMaps(util.ConvertLabelsToSelector(map[string]string{HelmReleaseLabel: name}))
+	if err != nil {
+		return false, err
+	}
+	return len(records) > 0, nil
+}
+
+// getHelmReleaseRecordResources returns the resources recorded for a Helm release
+func getHelmReleaseRecordResources(record corev1.ConfigMap) ([]HelmResource, error) {
+	var resources []HelmResource
+	if data, ok := record.Data[helmReleaseResourcesKey]; ok {
+		if err := json.Unmarshal([]byte(data), &resources); err != nil {
+			return nil, errors.Wrapf(err, "invalid resources recorded for the Helm release %q", record.Labels[HelmReleaseLabel])
+		}
+	}
+	return resources, nil
+}
+
+// pushHelmReleases records the Helm releases of the component pushed to the cluster, and deletes the resources
+// which are no longer part of a release, as well as the resources of the releases removed from the devfile
+// it returns true if resources or releases have been deleted
+func pushHelmReleases(client kclient.ClientInterface, resources []unstructured.Unstructured, labels map[string]string) (bool, error) {
+	records, err := client.ListConfigMaps(util.ConvertLabelsToSelector(mergeLabels(labels, map[string]string{HelmReleaseLabel: ""})))
+	if err != nil {
+		return false, err
+	}
+
+	releases := groupHelmReleases(resources)
+	madeChange := false
+	for i := range records {
+		record := records[i]
+		name := record.Labels[HelmReleaseLabel]
+		recordedResources, err := getHelmReleaseRecordResources(record)
+		if err != nil {
+			return false, err
+		}
+
+		release, inDevfile := releases[name]
+		current := map[HelmResource]bool{}
+		if inDevfile {
+			for _, r := range release.resources {
+				current[r] = true
+			}
+		}
+		for _, r := range recordedResources {
+			if current[r] {
+				continue
+			}
+			if err = deleteHelmResource(client, r); err != nil {
+				return false, err
+			}
+			madeChange = true
+		}
+
+		if !inDevfile {
+			if err = client.DeleteConfigMap(record.Name); err != nil {
+				return false, err
+			}
+			log.Successf("Deleted Helm release %q from the cluster", HelmReleaseKind+"/"+name)
+			madeChange = true
+			continue
+		}
+
+		data, err := getHelmReleaseRecordData(release)
+		if err != nil {
+			return false, err
+		}
+		record.Data = data
+		if _, err = client.UpdateConfigMap(&record); err != nil {
+			return false, err
+		}
+		delete(releases, name)
+	}
+
+	names := make([]string, 0, len(releases))
+	for name := range releases {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		data, err := getHelmReleaseRecordData(releases[name])
+		if err != nil {
+			return false, err
+		}
+		_, err = client.CreateConfigMap(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   getHelmReleaseRecordName(name),
+				Labels: mergeLabels(labels, map[string]string{HelmReleaseLabel: name}),
+			},
+			Data: data,
+		})
+		if err != nil {
+			return false, err
+		}
+		log.Successf("Created Helm release %q on the cluster", HelmReleaseKind+"/"+name)
+	}
+	return madeChange, nil
+}
+
+// getHelmReleaseRecordData returns the data of the ConfigMap recording a Helm release
+func getHelmReleaseRecordData(release *helmRelease) (map[string]string, error) {
+	resources, err := json.Marshal(release.resources)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{
+		helmReleaseChartKey:     release.chart,
+		helmReleaseResourcesKey: string(resources),
+	}, nil
+}
+
+// deleteHelmResource deletes a resource of a Helm release from the cluster, if it still exists
+func deleteHelmResource(client kclient.ClientInterface, r HelmResource) error {
+	u := unstructured.Unstructured{}
+	u.SetAPIVersion(r.APIVersion)
+	u.SetKind(r.Kind)
+	u.SetName(r.Name)
+	restMapping, err := client.GetRestMappingFromUnstructured(u)
+	if err != nil {
+		return err
+	}
+	err = client.DeleteDynamicResource(r.Name, restMapping.Resource.Group, restMapping.Resource.Version, restMapping.Resource.Resource)
+	if err != nil && !kerrors.IsNotFound(errors.Cause(err)) {
+		return errors.Wrapf(err, "unable to delete %q", r.String())
+	}
+	return nil
+}
+
+// UpdateHelmReleasesWithOwnerReferences adds an owner reference to the ConfigMaps recording the Helm releases of the component,
+// so that they are deleted with the component
+func UpdateHelmReleasesWithOwnerReferences(client kclient.ClientInterface, labels map[string]string, ownerReference metav1.OwnerReference) error {
+	records, err := client.ListConfigMaps(util.ConvertLabelsToSelector(mergeLabels(labels, map[string]string{HelmReleaseLabel: ""})))
+	if err != nil {
+		return err
+	}
+	for i := range records {
+		found := false
+		for _, ownerRef := range records[i].OwnerReferences {
+			if ownerRef.UID == ownerReference.UID {
+				found = true
+				break
+			}
+		}
+		if found {
+			continue
+		}
+		records[i].OwnerReferences = append(records[i].OwnerReferences, ownerReference)
+		if _, err = client.UpdateConfigMap(&records[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
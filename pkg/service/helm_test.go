@@ -0,0 +1,166 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	devfiletesting "github.com/redhat-developer/odo/pkg/devfile/testing"
+	"github.com/redhat-developer/odo/pkg/kclient"
+
+	"github.com/devfile/library/pkg/devfile/parser"
+	devfileCtx "github.com/devfile/library/pkg/devfile/parser/context"
+	devfileFileSystem "github.com/devfile/library/pkg/testingutil/filesystem"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestListDevfileHelmReleases(t *testing.T) {
+	fs := devfileFileSystem.NewFakeFs()
+
+	devfileObj := parser.DevfileObj{
+		Data: devfiletesting.GetDevfileData(t, []devfiletesting.InlinedComponent{
+			{
+				Name: "db-service-db-postgresql",
+				Inlined: `
+apiVersion: v1
+kind: Service
+metadata:
+  name: db-postgresql
+  labels:
+    odo.dev/helm-release: db
+  annotations:
+    odo.dev/helm-chart: bitnami/postgresql@10.3.0`,
+			},
+			{
+				Name: "db-secret-db-postgresql",
+				Inlined: `
+apiVersion: v1
+kind: Secret
+metadata:
+  name: db-postgresql
+  labels:
+    odo.dev/helm-release: db
+  annotations:
+    odo.dev/helm-chart: bitnami/postgresql@10.3.0`,
+			},
+			{
+				Name: "redis",
+				Inlined: `
+apiVersion: redis.redis.opstreelabs.in/v1beta1
+kind: Redis
+metadata:
+  name: redis`,
+			},
+		}, nil),
+		Ctx: devfileCtx.FakeContext(fs, parser.OutputDevfileYamlPath),
+	}
+
+	got, err := listDevfileHelmReleases(devfileObj, "", fs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 1 {
+		t.Fatalf("got %d releases, want only HelmRelease/db", len(got))
+	}
+	release, ok := got["HelmRelease/db"]
+	if !ok {
+		t.Fatalf("got releases %v, want HelmRelease/db", got)
+	}
+	wantSpec := map[string]interface{}{
+		"chart":     "bitnami/postgresql@10.3.0",
+		"resources": []interface{}{"Service/db-postgresql", "Secret/db-postgresql"},
+	}
+	if !reflect.DeepEqual(release.Object["spec"], wantSpec) {
+		t.Errorf("got spec %v, want %v", release.Object["spec"], wantSpec)
+	}
+
+	names, err := FindDevfileHelmReleaseComponents(devfileObj, "db", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(names, []string{"db-service-db-postgresql", "db-secret-db-postgresql"}) {
+		t.Errorf("got components %v, want the components of the release", names)
+	}
+}
+
+func TestPushHelmReleases(t *testing.T) {
+	labels := map[string]string{"app.kubernetes.io/instance": "nodejs"}
+
+	newResource := func(release, apiVersion, kind, name string) unstructured.Unstructured {
+		u := unstructured.Unstructured{}
+		u.SetAPIVersion(apiVersion)
+		u.SetKind(kind)
+		u.SetName(name)
+		u.SetLabels(map[string]string{HelmReleaseLabel: release})
+		u.SetAnnotations(map[string]string{HelmChartAnnotation: "bitnami/postgresql@10.3.0"})
+		return u
+	}
+
+	dbRecord := corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "odo-helm-release-db",
+			Labels: mergeLabels(labels, map[string]string{HelmReleaseLabel: "db"}),
+		},
+		Data: map[string]string{
+			helmReleaseChartKey:     "bitnami/postgresql@10.2.0",
+			helmReleaseResourcesKey: `[{"apiVersion":"v1","kind":"Service","name":"db-postgresql"},{"apiVersion":"v1","kind":"ConfigMap","name":"db-config"}]`,
+		},
+	}
+	oldRecord := corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "odo-helm-release-old",
+			Labels: mergeLabels(labels, map[string]string{HelmReleaseLabel: "old"}),
+		},
+		Data: map[string]string{
+			helmReleaseResourcesKey: `[{"apiVersion":"apps/v1","kind":"Deployment","name":"old"}]`,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	fkClient := kclient.NewMockClientInterface(ctrl)
+	fkClient.EXPECT().ListConfigMaps("app.kubernetes.io/instance=nodejs,odo.dev/helm-release").Return([]corev1.ConfigMap{dbRecord, oldRecord}, nil)
+
+	// the ConfigMap removed from the release db, and the Deployment of the release old removed from the devfile are deleted
+	fkClient.EXPECT().GetRestMappingFromUnstructured(gomock.Any()).DoAndReturn(func(u unstructured.Unstructured) (*meta.RESTMapping, error) {
+		gvk := u.GroupVersionKind()
+		resources := map[string]string{"ConfigMap": "configmaps", "Deployment": "deployments"}
+		return &meta.RESTMapping{Resource: schema.GroupVersionResource{Group: gvk.Group, Version: gvk.Version, Resource: resources[gvk.Kind]}}, nil
+	}).Times(2)
+	fkClient.EXPECT().DeleteDynamicResource("db-config", "", "v1", "configmaps").Return(nil)
+	fkClient.EXPECT().DeleteDynamicResource("old", "apps", "v1", "deployments").Return(nil)
+	fkClient.EXPECT().DeleteConfigMap("odo-helm-release-old").Return(nil)
+
+	// the record of the release db is updated, and the release cache is recorded
+	fkClient.EXPECT().UpdateConfigMap(gomock.Any()).DoAndReturn(func(configMap *corev1.ConfigMap) (*corev1.ConfigMap, error) {
+		wantResources := `[{"apiVersion":"v1","kind":"Service","name":"db-postgresql"}]`
+		if configMap.Name != "odo-helm-release-db" || configMap.Data[helmReleaseResourcesKey] != wantResources || configMap.Data[helmReleaseChartKey] != "bitnami/postgresql@10.3.0" {
+			t.Errorf("got updated record %v, want the record of the release db with the current resources", configMap)
+		}
+		return configMap, nil
+	})
+	fkClient.EXPECT().CreateConfigMap(gomock.Any()).DoAndReturn(func(configMap *corev1.ConfigMap) (*corev1.ConfigMap, error) {
+		if configMap.Name != "odo-helm-release-cache" || configMap.Labels[HelmReleaseLabel] != "cache" || configMap.Labels["app.kubernetes.io/instance"] != "nodejs" {
+			t.Errorf("got created record %v, want the record of the release cache", configMap)
+		}
+		return configMap, nil
+	})
+
+	resources := []unstructured.Unstructured{
+		newResource("db", "v1", "Service", "db-postgresql"),
+		newResource("cache", "apps/v1", "StatefulSet", "cache-redis"),
+	}
+	madeChange, err := pushHelmReleases(fkClient, resources, labels)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !madeChange {
+		t.Errorf("expected changes to be reported when resources are deleted")
+	}
+}
@@ -15,12 +15,15 @@ import (
 	componentlabels "github.com/redhat-developer/odo/pkg/component/labels"
 	"github.com/redhat-developer/odo/pkg/kclient"
 	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/util"
 	servicebinding "github.com/redhat-developer/service-binding-operator/apis/binding/v1alpha1"
 	v1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/redhat-developer/service-binding-operator/pkg/reconcile/pipeline"
+	"github.com/redhat-developer/service-binding-operator/pkg/reconcile/pipeline/builder"
 	"github.com/redhat-developer/service-binding-operator/pkg/reconcile/pipeline/context"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -35,11 +38,55 @@ func PushLinks(client kclient.ClientInterface, k8sComponents []devfile.Component
 		return false, err
 	}
 
+	var restartNeeded bool
 	if !serviceBindingSupport {
-		return pushLinksWithoutOperator(client, k8sComponents, labels, deployment, context)
+		restartNeeded, err = pushLinksWithoutOperator(client, k8sComponents, labels, deployment, context)
+	} else {
+		// links to plain Secrets and ConfigMaps are always created by odo, without the operator
+		var operatorComponents, directComponents []devfile.Component
+		operatorComponents, directComponents, err = splitDirectLinks(k8sComponents, context)
+		if err != nil {
+			return false, err
+		}
+		var directRestartNeeded bool
+		restartNeeded, err = pushLinksWithOperator(client, operatorComponents, labels, deployment, context)
+		if err != nil {
+			return false, err
+		}
+		directRestartNeeded, err = pushLinksWithoutOperator(client, directComponents, labels, deployment, context)
+		restartNeeded = restartNeeded || directRestartNeeded
+	}
+	if err != nil {
+		return false, err
 	}
 
-	return pushLinksWithOperator(client, k8sComponents, labels, deployment, context)
+	if !restartNeeded {
+		log.Success("Links are in sync with the cluster, no changes are required")
+	}
+	return restartNeeded, nil
+}
+
+// splitDirectLinks separates the Kubernetes components defining links to plain Secrets and ConfigMaps
+// from the other Kubernetes components
+func splitDirectLinks(k8sComponents []devfile.Component, context string) (others []devfile.Component, directLinks []devfile.Component, _ error) {
+	for _, c := range k8sComponents {
+		u, err := GetK8sComponentAsUnstructured(c.Kubernetes, context, devfilefs.DefaultFs{})
+		if err != nil {
+			return nil, nil, err
+		}
+		if isLinkResource(u.GetKind()) {
+			var sb servicebinding.ServiceBinding
+			if err = runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &sb); err != nil {
+				return nil, nil, err
+			}
+			if isDirectLink(sb) {
+				directLinks = append(directLinks, c)
+				continue
+			}
+		}
+		others = append(others, c)
+	}
+	return others, directLinks, nil
 }
 
 // pushLinksWithOperator creates links or deletes links (if service binding operator is installed) between components and services
@@ -118,9 +165,6 @@ func pushLinksWithOperator(client kclient.ClientInterface, k8sComponents []devfi
 		restartNeeded = true
 	}
 
-	if !restartNeeded {
-		log.Success("Links are in sync with the cluster, no changes are required")
-	}
 	return restartNeeded, nil
 }
 
@@ -175,35 +219,11 @@ func pushLinksWithoutOperator(client kclient.ClientInterface, k8sComponents []de
 
 	var processingPipeline pipeline.Pipeline
 
-	deploymentGVR, err := client.GetDeploymentAPIVersion()
-	if err != nil {
-		return false, err
-	}
-
 	var restartRequired bool
 
 	// delete the links not present on the devfile
 	for linkName, secretName := range clusterLinksMap {
 		if _, ok := localLinksMap[linkName]; !ok {
-
-			// recreate parts of the service binding request for deletion
-			var newServiceBinding servicebinding.ServiceBinding
-			newServiceBinding.Name = linkName
-			newServiceBinding.Namespace = client.GetCurrentNamespace()
-			newServiceBinding.Spec.Application = servicebinding.Application{
-				Ref: servicebinding.Ref{
-					Name:     deployment.Name,
-					Group:    deploymentGVR.Group,
-					Version:  deploymentGVR.Version,
-					Resource: deploymentGVR.Resource,
-				},
-			}
-			newServiceBinding.Status.Secret = secretName
-
-			// set the deletion time stamp to trigger deletion
-			timeNow := metav1.Now()
-			newServiceBinding.DeletionTimestamp = &timeNow
-
 			// if the pipeline was created before
 			// skip deletion
 			if processingPipeline == nil {
@@ -212,14 +232,7 @@ func pushLinksWithoutOperator(client kclient.ClientInterface, k8sComponents []de
 					return false, err
 				}
 			}
-			_, err = processingPipeline.Process(&newServiceBinding)
-			if err != nil {
-				return false, err
-			}
-
-			// since the library currently doesn't delete the secret after unbinding
-			// delete the secret manually
-			err = client.DeleteSecret(secretName, client.GetCurrentNamespace())
+			err = unbindLink(client, processingPipeline, linkName, secretName, deployment)
 			if err != nil {
 				return false, err
 			}
@@ -235,103 +248,325 @@ func pushLinksWithoutOperator(client kclient.ClientInterface, k8sComponents []de
 		if _, ok := clusterLinksMap[linkName]; !ok {
 			if serviceCompMap == nil {
 				// prevent listing of services unless required
-				services, e := client.ListServices("")
-				if e != nil {
-					return false, e
-				}
-
-				// get the services and get match them against the component
-				serviceCompMap = make(map[string]string)
-				for _, service := range services {
-					serviceCompMap[service.Name] = service.Labels[componentlabels.ComponentLabel]
+				serviceCompMap, err = getServiceComponents(client)
+				if err != nil {
+					return false, err
 				}
 			}
 
-			// get the string representation of the YAML definition of a CRD
-			var serviceBinding servicebinding.ServiceBinding
-			err = yaml.Unmarshal([]byte(strCRD), &serviceBinding)
+			created, err := bindLink(client, &processingPipeline, linkName, strCRD, labels, ownerReferences, csvSupport, serviceCompMap)
 			if err != nil {
 				return false, err
 			}
-
-			if len(serviceBinding.Spec.Services) != 1 {
-				continue
+			if created {
+				restartRequired = true
+				log.Successf("Created link %q on the cluster; component will be restarted", linkName)
 			}
+		}
+	}
 
-			if !csvSupport && !isLinkResource(serviceBinding.Spec.Services[0].Kind) {
-				// ignore service binding objects linked to services if csv support is not present on the cluster
-				continue
-			}
+	return restartRequired, nil
+}
+
+// getServiceComponents returns the names of the components of the services, indexed by the names of the services
+func getServiceComponents(client kclient.ClientInterface) (map[string]string, error) {
+	services, err := client.ListServices("")
+	if err != nil {
+		return nil, err
+	}
 
-			// set the labels and namespace
-			serviceBinding.SetLabels(labels)
-			serviceBinding.Namespace = client.GetCurrentNamespace()
-			ns := client.GetCurrentNamespace()
-			serviceBinding.Spec.Services[0].Namespace = &ns
+	serviceCompMap := make(map[string]string)
+	for _, service := range services {
+		serviceCompMap[service.Name] = service.Labels[componentlabels.ComponentLabel]
+	}
+	return serviceCompMap, nil
+}
 
-			_, err = json.MarshalIndent(serviceBinding, " ", " ")
-			if err != nil {
-				return false, err
-			}
+// bindLink creates the secret of the link defined by strCRD without the service binding operator, and adds it to the
+// deployment; the processing pipeline is created when first required
+// returns false if the link is ignored, as it binds more than one service or requires the CSV support missing on the cluster
+func bindLink(client kclient.ClientInterface, processingPipeline *pipeline.Pipeline, linkName, strCRD string, labels map[string]string, ownerReferences metav1.OwnerReference, csvSupport bool, serviceCompMap map[string]string) (bool, error) {
+	// get the string representation of the YAML definition of a CRD
+	var serviceBinding servicebinding.ServiceBinding
+	err := yaml.Unmarshal([]byte(strCRD), &serviceBinding)
+	if err != nil {
+		return false, err
+	}
 
-			if processingPipeline == nil {
-				processingPipeline, err = getPipeline(client)
-				if err != nil {
-					return false, err
-				}
-			}
+	if len(serviceBinding.Spec.Services) != 1 {
+		return false, nil
+	}
 
-			_, err = processingPipeline.Process(&serviceBinding)
-			if err != nil {
-				if kerrors.IsForbidden(err) {
-					// due to https://github.com/redhat-developer/service-binding-operator/issues/1003
-					return false, fmt.Errorf("please install the service binding operator")
-				}
-				return false, err
-			}
+	if !csvSupport && !isLinkResource(serviceBinding.Spec.Services[0].Kind) && !isDirectLink(serviceBinding) {
+		// ignore service binding objects linked to services if csv support is not present on the cluster
+		return false, nil
+	}
 
-			if len(serviceBinding.Status.Secret) == 0 {
-				return false, fmt.Errorf("no secret was provided by service binding's pipleine")
-			}
+	// set the labels and namespace
+	serviceBinding.SetLabels(labels)
+	serviceBinding.Namespace = client.GetCurrentNamespace()
+	ns := client.GetCurrentNamespace()
+	serviceBinding.Spec.Services[0].Namespace = &ns
 
-			// get the generated secret and update it with the labels and owner reference
-			secret, err := client.GetSecret(serviceBinding.Status.Secret, client.GetCurrentNamespace())
-			if err != nil {
-				return false, err
-			}
-			secret.Labels = labels
-			secret.Labels[LinkLabel] = linkName
-			if _, ok := serviceCompMap[serviceBinding.Spec.Services[0].Name]; ok {
-				secret.Labels[ServiceLabel] = serviceCompMap[serviceBinding.Spec.Services[0].Name]
-			} else {
-				secret.Labels[ServiceLabel] = serviceBinding.Spec.Services[0].Name
-			}
-			secret.Labels[ServiceKind] = serviceBinding.Spec.Services[0].Kind
-			if serviceBinding.Spec.Services[0].Kind != "Service" {
-				// the service name is stored as kind-name as `/` is not a valid char for labels of kubernetes secrets
-				secret.Labels[ServiceLabel] = fmt.Sprintf("%v-%v", serviceBinding.Spec.Services[0].Kind, serviceBinding.Spec.Services[0].Name)
-			}
-			secret.SetOwnerReferences([]metav1.OwnerReference{ownerReferences})
-			_, err = client.UpdateSecret(secret, client.GetCurrentNamespace())
-			if err != nil {
-				return false, err
-			}
-			restartRequired = true
-			log.Successf("Created link %q on the cluster; component will be restarted", linkName)
+	_, err = json.MarshalIndent(serviceBinding, " ", " ")
+	if err != nil {
+		return false, err
+	}
+
+	if *processingPipeline == nil {
+		*processingPipeline, err = getPipeline(client)
+		if err != nil {
+			return false, err
 		}
 	}
 
-	if restartRequired {
-		return true, nil
+	_, err = (*processingPipeline).Process(&serviceBinding)
+	if err != nil {
+		if kerrors.IsForbidden(err) {
+			// due to https://github.com/redhat-developer/service-binding-operator/issues/1003
+			return false, fmt.Errorf("please install the service binding operator")
+		}
+		return false, err
+	}
+
+	if len(serviceBinding.Status.Secret) == 0 {
+		return false, fmt.Errorf("no secret was provided by service binding's pipleine")
+	}
+
+	// get the generated secret and update it with the labels and owner reference
+	secret, err := client.GetSecret(serviceBinding.Status.Secret, client.GetCurrentNamespace())
+	if err != nil {
+		return false, err
+	}
+	secret.Labels = labels
+	secret.Labels[LinkLabel] = linkName
+	if _, ok := serviceCompMap[serviceBinding.Spec.Services[0].Name]; ok {
+		secret.Labels[ServiceLabel] = serviceCompMap[serviceBinding.Spec.Services[0].Name]
 	} else {
-		log.Success("Links are in sync with the cluster, no changes are required")
+		secret.Labels[ServiceLabel] = serviceBinding.Spec.Services[0].Name
+	}
+	secret.Labels[ServiceKind] = serviceBinding.Spec.Services[0].Kind
+	if serviceBinding.Spec.Services[0].Kind != "Service" {
+		// the service name is stored as kind-name as `/` is not a valid char for labels of kubernetes secrets
+		secret.Labels[ServiceLabel] = fmt.Sprintf("%v-%v", serviceBinding.Spec.Services[0].Kind, serviceBinding.Spec.Services[0].Name)
 	}
+	secret.SetOwnerReferences([]metav1.OwnerReference{ownerReferences})
 
-	return false, nil
+	// record the link and the binding data it exposes to detect when the secret gets out of date
+	spec, err := json.Marshal(serviceBinding.Spec)
+	if err != nil {
+		return false, err
+	}
+	if secret.Annotations == nil {
+		secret.Annotations = make(map[string]string)
+	}
+	secret.Annotations[ServiceBindingSpecAnnotation] = string(spec)
+	secret.Annotations[BindingDataHashAnnotation] = getBindingDataHash(secretData(secret.Data))
+	_, err = client.UpdateSecret(secret, client.GetCurrentNamespace())
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// unbindLink removes the secret of a link created without the service binding operator from the deployment
+// and deletes it
+func unbindLink(client kclient.ClientInterface, processingPipeline pipeline.Pipeline, linkName, secretName string, deployment *v1.Deployment) error {
+	deploymentGVR, err := client.GetDeploymentAPIVersion()
+	if err != nil {
+		return err
+	}
+
+	// recreate parts of the service binding request for deletion
+	var newServiceBinding servicebinding.ServiceBinding
+	newServiceBinding.Name = linkName
+	newServiceBinding.Namespace = client.GetCurrentNamespace()
+	newServiceBinding.Spec.Application = servicebinding.Application{
+		Ref: servicebinding.Ref{
+			Name:     deployment.Name,
+			Group:    deploymentGVR.Group,
+			Version:  deploymentGVR.Version,
+			Resource: deploymentGVR.Resource,
+		},
+	}
+	newServiceBinding.Status.Secret = secretName
+
+	// set the deletion time stamp to trigger deletion
+	timeNow := metav1.Now()
+	newServiceBinding.DeletionTimestamp = &timeNow
+
+	_, err = processingPipeline.Process(&newServiceBinding)
+	if err != nil {
+		return err
+	}
+
+	// since the library currently doesn't delete the secret after unbinding
+	// delete the secret manually
+	return client.DeleteSecret(secretName, client.GetCurrentNamespace())
+}
+
+// RefreshLink re-runs the binding of the link defined by the Kubernetes component of the devfile, so that the
+// component gets the current state of the service: the current secret of the link is removed from the component and
+// created again; the links created by the service binding operator are kept in sync by the operator and left unchanged
+// returns true if the binding has been re-run
+func RefreshLink(client kclient.ClientInterface, linkComponent devfile.Component, labels map[string]string, deployment *v1.Deployment, context string) (bool, error) {
+	serviceBindingSupport, err := client.IsServiceBindingSupported()
+	if err != nil {
+		return false, err
+	}
+
+	_, directComponents, err := splitDirectLinks([]devfile.Component{linkComponent}, context)
+	if err != nil {
+		return false, err
+	}
+	if serviceBindingSupport && len(directComponents) == 0 {
+		return false, nil
+	}
+
+	strCRD := linkComponent.Kubernetes.Inlined
+	if linkComponent.Kubernetes.Uri != "" {
+		strCRD, err = getDataFromURI(linkComponent.Kubernetes.Uri, context, devfilefs.DefaultFs{})
+		if err != nil {
+			return false, err
+		}
+	}
+
+	csvSupport, err := client.IsCSVSupported()
+	if err != nil {
+		return false, err
+	}
+
+	secrets, err := client.ListSecrets(componentlabels.GetSelector(labels[componentlabels.ComponentLabel], labels[applabels.ApplicationLabel]))
+	if err != nil {
+		return false, err
+	}
+
+	linkName := linkComponent.Name
+	for _, secret := range secrets {
+		if secret.GetLabels()[LinkLabel] != linkName {
+			continue
+		}
+		processingPipeline, err := getPipeline(client)
+		if err != nil {
+			return false, err
+		}
+		err = unbindLink(client, processingPipeline, linkName, secret.Name, deployment)
+		if err != nil {
+			return false, err
+		}
+
+		serviceCompMap, err := getServiceComponents(client)
+		if err != nil {
+			return false, err
+		}
+		return bindLink(client, &processingPipeline, linkName, strCRD, labels, generator.GetOwnerReference(deployment), csvSupport, serviceCompMap)
+	}
+	return false, fmt.Errorf("the link %q doesn't exist on the cluster, please use `odo push` to create it", linkName)
+}
+
+// IsLinkStale returns true if the binding data exposed by the service of the link of the given secret changed since
+// the link was created, meaning the values projected into the secret don't reflect the current state of the service
+// only the links created without the service binding operator are checked, as the operator keeps the others in sync
+func IsLinkStale(client kclient.ClientInterface, secret corev1.Secret) (bool, error) {
+	return isLinkStale(secret, func(serviceBinding servicebinding.ServiceBinding) (map[string]string, error) {
+		serviceBinding.Namespace = client.GetCurrentNamespace()
+		return getBindingData(client, serviceBinding)
+	})
+}
+
+func isLinkStale(secret corev1.Secret, getData func(servicebinding.ServiceBinding) (map[string]string, error)) (bool, error) {
+	hash, ok := secret.Annotations[BindingDataHashAnnotation]
+	if !ok {
+		return false, nil
+	}
+
+	var serviceBinding servicebinding.ServiceBinding
+	err := json.Unmarshal([]byte(secret.Annotations[ServiceBindingSpecAnnotation]), &serviceBinding.Spec)
+	if err != nil {
+		return false, err
+	}
+	serviceBinding.Name = secret.Labels[LinkLabel]
+
+	data, err := getData(serviceBinding)
+	if kerrors.IsNotFound(err) {
+		// the service has been deleted
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return getBindingDataHash(data) != hash, nil
+}
+
+// getBindingData returns the binding data the service binding would project into the component, collected from the
+// current state of its services; nothing is projected nor persisted on the cluster
+func getBindingData(client kclient.ClientInterface, serviceBinding servicebinding.ServiceBinding) (map[string]string, error) {
+	contextProvider, err := getContextProvider(client)
+	if err != nil {
+		return nil, err
+	}
+
+	var data map[string]string
+	handlers := append([]pipeline.Handler{}, bindingDataFlow...)
+	handlers = append(handlers, pipeline.HandlerFunc(func(ctx pipeline.Context) {
+		items := ctx.BindingItems()
+		data = items.AsMap()
+	}))
+	_, err = builder.Builder().WithContextProvider(readOnlyContextProvider{contextProvider}).WithHandlers(handlers...).Build().Process(&serviceBinding)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// readOnlyContextProvider provides pipeline contexts which are never persisted on the cluster
+type readOnlyContextProvider struct {
+	pipeline.ContextProvider
+}
+
+func (p readOnlyContextProvider) Get(binding interface{}) (pipeline.Context, error) {
+	ctx, err := p.ContextProvider.Get(binding)
+	if err != nil {
+		return nil, err
+	}
+	return readOnlyContext{ctx}, nil
+}
+
+type readOnlyContext struct {
+	pipeline.Context
+}
+
+func (readOnlyContext) Close() error {
+	return nil
+}
+
+// secretData returns the data of a secret as strings
+func secretData(data map[string][]byte) map[string]string {
+	result := make(map[string]string, len(data))
+	for key, value := range data {
+		result[key] = string(value)
+	}
+	return result
+}
+
+// getBindingDataHash returns a hash of binding data, independent of the order of its keys
+func getBindingDataHash(data map[string]string) string {
+	// the keys of the maps are sorted when marshalled
+	content, _ := json.Marshal(data)
+	return util.GetChecksum(content)
 }
 
 // getPipeline gets the pipeline to process service binding requests
 func getPipeline(client kclient.ClientInterface) (pipeline.Pipeline, error) {
+	contextProvider, err := getContextProvider(client)
+	if err != nil {
+		return nil, err
+	}
+	return OdoDefaultBuilder.WithContextProvider(contextProvider).Build(), nil
+}
+
+// getContextProvider gets the provider of the contexts of the pipelines processing service binding requests
+func getContextProvider(client kclient.ClientInterface) (pipeline.ContextProvider, error) {
 	mgr, err := ctrl.NewManager(client.GetClientConfig(), ctrl.Options{
 		Scheme: runtime.NewScheme(),
 		// disable the health probes to prevent binding to them
@@ -342,5 +577,5 @@ func getPipeline(client kclient.ClientInterface) (pipeline.Pipeline, error) {
 	if err != nil {
 		return nil, err
 	}
-	return OdoDefaultBuilder.WithContextProvider(context.Provider(client.GetDynamicClient(), context.ResourceLookup(mgr.GetRESTMapper()))).Build(), nil
+	return context.Provider(client.GetDynamicClient(), context.ResourceLookup(mgr.GetRESTMapper())), nil
 }
@@ -0,0 +1,157 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	devfile "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	servicebinding "github.com/redhat-developer/service-binding-operator/apis/binding/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func Test_splitDirectLinks(t *testing.T) {
+	newK8sComponent := func(name, inlined string) devfile.Component {
+		return devfile.Component{
+			Name: name,
+			ComponentUnion: devfile.ComponentUnion{
+				Kubernetes: &devfile.KubernetesComponent{
+					K8sLikeComponent: devfile.K8sLikeComponent{
+						K8sLikeComponentLocation: devfile.K8sLikeComponentLocation{
+							Inlined: inlined,
+						},
+					},
+				},
+			},
+		}
+	}
+	newLink := func(name, group, version, kind string) devfile.Component {
+		return newK8sComponent(name, `
+apiVersion: binding.operators.coreos.com/v1alpha1
+kind: ServiceBinding
+metadata:
+ name: `+name+`
+spec:
+ application:
+   group: apps
+   name: nodejs-app
+   resource: deployments
+   version: v1
+ detectBindingResources: true
+ services:
+ - group: "`+group+`"
+   kind: `+kind+`
+   name: backend
+   version: `+version)
+	}
+
+	redis := newK8sComponent("redis", `
+apiVersion: redis.redis.opstreelabs.in/v1beta1
+kind: Redis
+metadata:
+ name: redis`)
+	operatorLink := newLink("nodejs-redis-redis", "redis.redis.opstreelabs.in", "v1beta1", "Redis")
+	componentLink := newLink("nodejs-backend", "", "v1", "Service")
+	secretLink := newLink("nodejs-secret-backend", "", "v1", SecretKind)
+	configMapLink := newLink("nodejs-configmap-backend", "", "v1", ConfigMapKind)
+
+	others, directLinks, err := splitDirectLinks([]devfile.Component{redis, operatorLink, secretLink, componentLink, configMapLink}, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	gotOthers := make([]string, 0, len(others))
+	for _, c := range others {
+		gotOthers = append(gotOthers, c.Name)
+	}
+	gotDirectLinks := make([]string, 0, len(directLinks))
+	for _, c := range directLinks {
+		gotDirectLinks = append(gotDirectLinks, c.Name)
+	}
+
+	wantOthers := []string{"redis", "nodejs-redis-redis", "nodejs-backend"}
+	wantDirectLinks := []string{"nodejs-secret-backend", "nodejs-configmap-backend"}
+	if !reflect.DeepEqual(gotOthers, wantOthers) {
+		t.Errorf("splitDirectLinks() others = %v, want %v", gotOthers, wantOthers)
+	}
+	if !reflect.DeepEqual(gotDirectLinks, wantDirectLinks) {
+		t.Errorf("splitDirectLinks() direct links = %v, want %v", gotDirectLinks, wantDirectLinks)
+	}
+}
+
+func TestIsLinkStale(t *testing.T) {
+	newSecret := func(annotations map[string]string) corev1.Secret {
+		return corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "nodejs-secret-mydb",
+				Labels: map[string]string{
+					LinkLabel:   "nodejs-secret-mydb",
+					ServiceKind: SecretKind,
+				},
+				Annotations: annotations,
+			},
+		}
+	}
+	boundAnnotations := map[string]string{
+		ServiceBindingSpecAnnotation: `{"application":{"name":"nodejs-app","group":"apps","version":"v1","resource":"deployments"},"services":[{"version":"v1","kind":"Secret","name":"mydb"}]}`,
+		BindingDataHashAnnotation:    getBindingDataHash(map[string]string{"password": "secret", "username": "admin"}),
+	}
+	secretsGR := schema.GroupResource{Resource: "secrets"}
+
+	tests := []struct {
+		name    string
+		secret  corev1.Secret
+		data    map[string]string
+		getErr  error
+		want    bool
+		wantErr bool
+	}{
+		{
+			name:   "case 1: link created by the service binding operator",
+			secret: newSecret(nil),
+			want:   false,
+		},
+		{
+			name:   "case 2: binding data unchanged since the link was created",
+			secret: newSecret(boundAnnotations),
+			data:   map[string]string{"username": "admin", "password": "secret"},
+			want:   false,
+		},
+		{
+			name:   "case 3: binding data changed since the link was created",
+			secret: newSecret(boundAnnotations),
+			data:   map[string]string{"username": "admin", "password": "changed"},
+			want:   true,
+		},
+		{
+			name:   "case 4: service deleted since the link was created",
+			secret: newSecret(boundAnnotations),
+			getErr: kerrors.NewNotFound(secretsGR, "mydb"),
+			want:   true,
+		},
+		{
+			name:    "case 5: error getting the binding data",
+			secret:  newSecret(boundAnnotations),
+			getErr:  kerrors.NewForbidden(secretsGR, "mydb", nil),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := isLinkStale(tt.secret, func(serviceBinding servicebinding.ServiceBinding) (map[string]string, error) {
+				if serviceBinding.Name != "nodejs-secret-mydb" || len(serviceBinding.Spec.Services) != 1 || serviceBinding.Spec.Services[0].Name != "mydb" {
+					t.Errorf("unexpected service binding %v", serviceBinding)
+				}
+				return tt.data, tt.getErr
+			})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("IsLinkStale() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("IsLinkStale() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
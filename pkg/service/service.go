@@ -39,6 +39,19 @@ const ServiceLabel = "app.kubernetes.io/service-name"
 // ServiceKind is the kind of the service in the service binding object
 const ServiceKind = "app.kubernetes.io/service-kind"
 
+// annotations set on the secrets of the links created without the service binding operator,
+// describing the link and the binding data it exposed when it was created
+const (
+	ServiceBindingSpecAnnotation = "odo.dev/service-binding-spec"
+	BindingDataHashAnnotation    = "odo.dev/binding-data-hash"
+)
+
+// kinds of the plain Kubernetes resources which can be linked without an operator
+const (
+	SecretKind    = "Secret"
+	ConfigMapKind = "ConfigMap"
+)
+
 // DeleteOperatorService deletes an Operator backed service
 // TODO: make it unlink the service from component as a part of
 // https://github.com/redhat-developer/odo/issues/3563
@@ -378,6 +391,10 @@ func listDevfileServices(client kclient.ClientInterface, devfileObj parser.Devfi
 				break
 			}
 		}
+		if _, ok := u.GetLabels()[HelmReleaseLabel]; ok {
+			// the resources rendered from Helm charts are listed as Helm releases
+			match = false
+		}
 		if match {
 			services[strings.Join([]string{u.GetKind(), c.Name}, "/")] = u
 		}
@@ -393,30 +410,53 @@ func FindDevfileServiceBinding(devfileObj parser.DevfileObj, kind string, name,
 }
 
 func findDevfileServiceBinding(devfileObj parser.DevfileObj, kind string, name, context string, fs devfilefs.Filesystem) (string, bool, error) {
+	component, sbName, err := findDevfileServiceBindingComponent(devfileObj, kind, name, context, fs)
+	return sbName, component != nil, err
+}
+
+// FindDevfileLinkComponent returns the Kubernetes component of a Devfile defining the ServiceBinding matching kind and name
+func FindDevfileLinkComponent(devfileObj parser.DevfileObj, kind string, name, context string) (devfile.Component, error) {
+	return findDevfileLinkComponent(devfileObj, kind, name, context, devfilefs.DefaultFs{})
+}
+
+func findDevfileLinkComponent(devfileObj parser.DevfileObj, kind string, name, context string, fs devfilefs.Filesystem) (devfile.Component, error) {
+	component, _, err := findDevfileServiceBindingComponent(devfileObj, kind, name, context, fs)
+	if err != nil {
+		return devfile.Component{}, err
+	}
+	if component == nil {
+		return devfile.Component{}, fmt.Errorf("no link to the %s %q is defined in the devfile", kind, name)
+	}
+	return *component, nil
+}
+
+// findDevfileServiceBindingComponent returns the Kubernetes component of a Devfile defining the ServiceBinding matching
+// kind and name, and the name of this ServiceBinding; the component is nil when no ServiceBinding matches
+func findDevfileServiceBindingComponent(devfileObj parser.DevfileObj, kind string, name, context string, fs devfilefs.Filesystem) (*devfile.Component, string, error) {
 	if devfileObj.Data == nil {
-		return "", false, nil
+		return nil, "", nil
 	}
 	components, err := devfileObj.Data.GetComponents(common.DevfileOptions{
 		ComponentOptions: parsercommon.ComponentOptions{ComponentType: devfile.KubernetesComponentType},
 	})
 	if err != nil {
-		return "", false, err
+		return nil, "", err
 	}
 
-	for _, c := range components {
+	for i, c := range components {
 		u, err := GetK8sComponentAsUnstructured(c.Kubernetes, context, fs)
 		if err != nil {
-			return "", false, err
+			return nil, "", err
 		}
 		if isLinkResource(u.GetKind()) {
 			var sbr servicebinding.ServiceBinding
 			js, err := u.MarshalJSON()
 			if err != nil {
-				return "", false, err
+				return nil, "", err
 			}
 			err = json.Unmarshal(js, &sbr)
 			if err != nil {
-				return "", false, err
+				return nil, "", err
 			}
 			services := sbr.Spec.Services
 			if len(services) != 1 {
@@ -424,11 +464,11 @@ func findDevfileServiceBinding(devfileObj parser.DevfileObj, kind string, name,
 			}
 			service := services[0]
 			if service.Kind == kind && service.Name == name {
-				return u.GetName(), true, nil
+				return &components[i], u.GetName(), nil
 			}
 		}
 	}
-	return "", false, nil
+	return nil, "", nil
 }
 
 // PushKubernetesResources updates service(s) from Kubernetes Inlined component in a devfile by creating new ones or removing old ones
@@ -456,12 +496,18 @@ func PushKubernetesResources(client kclient.ClientInterface, k8sComponents []dev
 
 	madeChange := false
 
+	// the resources rendered from Helm charts, to keep track of the Helm releases
+	var helmResources []unstructured.Unstructured
+
 	// create an object on the kubernetes cluster for all the Kubernetes Inlined components
 	for _, c := range k8sComponents {
 		u, er := GetK8sComponentAsUnstructured(c.Kubernetes, context, devfilefs.DefaultFs{})
 		if er != nil {
 			return er
 		}
+		if _, ok := u.GetLabels()[HelmReleaseLabel]; ok {
+			helmResources = append(helmResources, u)
+		}
 
 		isOperatorBackedService, er := PushKubernetesResource(client, u, labels)
 		if er != nil {
@@ -492,6 +538,12 @@ func PushKubernetesResources(client kclient.ClientInterface, k8sComponents []dev
 		}
 	}
 
+	deletedHelmResources, err := pushHelmReleases(client, helmResources, labels)
+	if err != nil {
+		return err
+	}
+	madeChange = madeChange || deletedHelmResources
+
 	if !madeChange {
 		log.Success("Services are in sync with the cluster, no changes are required")
 	}
@@ -657,6 +709,28 @@ func isLinkResource(kind string) bool {
 	return kind == "ServiceBinding"
 }
 
+// GetDirectLinkKind returns the kind of the plain Kubernetes resource (Secret or ConfigMap) matching
+// the given service type, case insensitive, and false if the service type is not such a resource
+func GetDirectLinkKind(serviceType string) (string, bool) {
+	for _, kind := range []string{SecretKind, ConfigMapKind} {
+		if strings.EqualFold(serviceType, kind) {
+			return kind, true
+		}
+	}
+	return "", false
+}
+
+// isDirectLink returns true if the given service binding links to a plain Secret or ConfigMap,
+// which odo binds itself, without the Service Binding Operator
+func isDirectLink(sb servicebinding.ServiceBinding) bool {
+	if len(sb.Spec.Services) != 1 {
+		return false
+	}
+	service := sb.Spec.Services[0]
+	kind, ok := GetDirectLinkKind(service.Kind)
+	return ok && kind == service.Kind && service.Group == "" && service.Version == "v1"
+}
+
 // createOperatorService creates the given operator on the cluster
 // it returns the CR,Kind and errors
 func createOperatorService(client kclient.ClientInterface, u unstructured.Unstructured) error {
@@ -36,6 +36,7 @@ var defaultFlow = []pipeline.Handler{
 	pipeline.HandlerFunc(collect.PreFlight),
 	pipeline.HandlerFunc(ProvisionedService),
 	pipeline.HandlerFunc(collect.DirectSecretReference),
+	pipeline.HandlerFunc(DirectConfigMapReference),
 	pipeline.HandlerFunc(BindingDefinitions),
 	pipeline.HandlerFunc(collect.BindingItems),
 	pipeline.HandlerFunc(collect.OwnedResources),
@@ -50,6 +51,19 @@ var defaultFlow = []pipeline.Handler{
 
 var OdoDefaultBuilder = builder.Builder().WithHandlers(defaultFlow...)
 
+// bindingDataFlow only collects the binding data of the services, without projecting it into the application
+var bindingDataFlow = []pipeline.Handler{
+	pipeline.HandlerFunc(collect.PreFlight),
+	pipeline.HandlerFunc(ProvisionedService),
+	pipeline.HandlerFunc(collect.DirectSecretReference),
+	pipeline.HandlerFunc(DirectConfigMapReference),
+	pipeline.HandlerFunc(BindingDefinitions),
+	pipeline.HandlerFunc(collect.BindingItems),
+	pipeline.HandlerFunc(collect.OwnedResources),
+	pipeline.HandlerFunc(mapping.Handle),
+	pipeline.HandlerFunc(naming.Handle),
+}
+
 func ProvisionedService(ctx pipeline.Context) {
 	services, _ := ctx.Services()
 
@@ -92,6 +106,42 @@ func ProvisionedService(ctx pipeline.Context) {
 	}
 }
 
+// DirectConfigMapReference collects the data of the ConfigMaps directly referenced as services,
+// the same way collect.DirectSecretReference collects the data of the Secrets
+func DirectConfigMapReference(ctx pipeline.Context) {
+	services, _ := ctx.Services()
+
+	for _, service := range services {
+		res := service.Resource()
+		if res.GetKind() != ConfigMapKind || res.GetAPIVersion() != "v1" || hasBindingAnnotations(res) {
+			// the binding definitions of the annotations are used for annotated ConfigMaps
+			continue
+		}
+		configMap, err := ctx.ReadConfigMap(res.GetNamespace(), res.GetName())
+		if err != nil {
+			requestRetry(ctx, collect.ErrorReadingBindingReason, err)
+			return
+		}
+		data, _, err := unstructured.NestedStringMap(configMap.Object, "data")
+		if err != nil {
+			requestRetry(ctx, collect.ErrorReadingBindingReason, err)
+			return
+		}
+		for name, value := range data {
+			ctx.AddBindingItem(&pipeline.BindingItem{Name: name, Value: value, Source: service})
+		}
+	}
+}
+
+func hasBindingAnnotations(res *unstructured.Unstructured) bool {
+	for k := range res.GetAnnotations() {
+		if strings.HasPrefix(k, binding.AnnotationPrefix) {
+			return true
+		}
+	}
+	return false
+}
+
 func BindingDefinitions(ctx pipeline.Context) {
 	services, _ := ctx.Services()
 
@@ -428,6 +428,73 @@ spec:
 	}
 }
 
+func TestFindDevfileLinkComponent(t *testing.T) {
+	fs := devfileFileSystem.NewFakeFs()
+
+	devfileObj := parser.DevfileObj{
+		Data: devfiletesting.GetDevfileData(t, []devfiletesting.InlinedComponent{
+			{
+				Name: "link1",
+				Inlined: `
+apiVersion: binding.operators.coreos.com/v1alpha1
+kind: ServiceBinding
+metadata:
+ name: nodejs-prj1-api-vtzg-redis-redis
+spec:
+ application:
+   group: apps
+   name: nodejs-prj1-api-vtzg-app
+   resource: deployments
+   version: v1
+ bindAsFiles: false
+ detectBindingResources: true
+ services:
+ - group: redis.redis.opstreelabs.in
+   kind: Redis
+   name: redis
+   version: v1beta1`,
+			},
+		}, nil),
+		Ctx: devfileCtx.FakeContext(fs, parser.OutputDevfileYamlPath),
+	}
+
+	tests := []struct {
+		name     string
+		kind     string
+		linkName string
+		want     string
+		wantErr  bool
+	}{
+		{
+			name:     "found, named differently from its ServiceBinding",
+			kind:     "Redis",
+			linkName: "redis",
+			want:     "link1",
+		},
+		{
+			name:     "not found",
+			kind:     "Redis",
+			linkName: "other",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := findDevfileLinkComponent(devfileObj, tt.kind, tt.linkName, "", fs)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got error %v, want error %v", err, tt.wantErr)
+			}
+			if got.Name != tt.want {
+				t.Errorf("got component %q, want %q", got.Name, tt.want)
+			}
+			if err == nil && got.Kubernetes == nil {
+				t.Errorf("got component %q without its Kubernetes definition", got.Name)
+			}
+		})
+	}
+}
+
 func TestGetAlmExample(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -579,3 +646,25 @@ func TestGetAlmExample(t *testing.T) {
 		})
 	}
 }
+
+func TestGetDirectLinkKind(t *testing.T) {
+	tests := []struct {
+		serviceType string
+		want        string
+		wantOK      bool
+	}{
+		{serviceType: "secret", want: SecretKind, wantOK: true},
+		{serviceType: "Secret", want: SecretKind, wantOK: true},
+		{serviceType: "configmap", want: ConfigMapKind, wantOK: true},
+		{serviceType: "ConfigMap", want: ConfigMapKind, wantOK: true},
+		{serviceType: "EtcdCluster", want: "", wantOK: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.serviceType, func(t *testing.T) {
+			got, gotOK := GetDirectLinkKind(tt.serviceType)
+			if got != tt.want || gotOK != tt.wantOK {
+				t.Errorf("GetDirectLinkKind() = %v, %v, want %v, %v", got, gotOK, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
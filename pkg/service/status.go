@@ -0,0 +1,351 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	devfile "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	devfilefs "github.com/devfile/library/pkg/testingutil/filesystem"
+	olm "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/pkg/errors"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/log"
+	servicebinding "github.com/redhat-developer/service-binding-operator/apis/binding/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog"
+)
+
+// ServiceState is the state of an operator backed service, as reported by its operator
+type ServiceState string
+
+const (
+	// ServiceStateReady indicates that the service is ready to be used
+	ServiceStateReady ServiceState = "Ready"
+	// ServiceStateProgressing indicates that the operator is still deploying the service
+	ServiceStateProgressing ServiceState = "Progressing"
+	// ServiceStateFailed indicates that the operator failed to deploy the service
+	ServiceStateFailed ServiceState = "Failed"
+	// ServiceStateUnknown indicates that the operator doesn't report the readiness of the service
+	ServiceStateUnknown ServiceState = "Unknown"
+)
+
+// x-descriptors used by the operators to describe the fields of the status of their resources
+const (
+	conditionsXDescriptor = "urn:alm:descriptor:io.kubernetes.conditions"
+	phaseXDescriptor      = "urn:alm:descriptor:io.kubernetes.phase"
+)
+
+// types of the conditions indicating that a service is ready, or failed, when their status is True
+var (
+	readyConditionTypes  = []string{"Ready", "Available", "Succeeded", "Complete", "Reconciled", "Running", "Deployed"}
+	failedConditionTypes = []string{"Failed", "Failure", "Error", "ReconcileError", "Degraded"}
+)
+
+// phases indicating that a service is ready, or failed, compared case insensitive
+var (
+	readyPhases  = []string{"Ready", "Running", "Available", "Succeeded", "Completed", "Healthy", "Active", "Deployed"}
+	failedPhases = []string{"Failed", "Error", "Degraded"}
+)
+
+// waitServicesInterval is the interval at which the status of the services is fetched when waiting for them
+var waitServicesInterval = 2 * time.Second
+
+// ServiceCondition is a condition reported in the status of a service
+type ServiceCondition struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// ServiceStatusField is a field of the status of a service, described by the statusDescriptors of the operator
+type ServiceStatusField struct {
+	Name  string `json:"name"`
+	Path  string `json:"path"`
+	Value string `json:"value"`
+}
+
+// ServiceStatus is the status of an operator backed service
+type ServiceStatus struct {
+	State      ServiceState         `json:"state"`
+	Message    string               `json:"message,omitempty"`
+	Conditions []ServiceCondition   `json:"conditions,omitempty"`
+	Fields     []ServiceStatusField `json:"fields,omitempty"`
+}
+
+// GetServiceStatus returns the status of a service deployed on the cluster, from the conditions and the phase of its status
+// the statusDescriptors of the operator, if any, indicate where the conditions and the phase are found, and the fields to display
+func GetServiceStatus(u unstructured.Unstructured, statusDescriptors []olm.StatusDescriptor) ServiceStatus {
+	status, found, err := unstructured.NestedMap(u.Object, "status")
+	if err != nil || !found || len(status) == 0 {
+		return ServiceStatus{State: ServiceStateProgressing, Message: "waiting for the operator to report the status of the service"}
+	}
+
+	conditionsPath, phasePath := []string{"conditions"}, []string{"phase"}
+	var result ServiceStatus
+	for _, descriptor := range statusDescriptors {
+		path := strings.Split(descriptor.Path, ".")
+		switch {
+		case hasXDescriptor(descriptor, conditionsXDescriptor):
+			conditionsPath = path
+		case hasXDescriptor(descriptor, phaseXDescriptor):
+			phasePath = path
+		default:
+			value, found, err := unstructured.NestedFieldNoCopy(status, path...)
+			if err != nil || !found {
+				continue
+			}
+			name := descriptor.DisplayName
+			if name == "" {
+				name = descriptor.Path
+			}
+			result.Fields = append(result.Fields, ServiceStatusField{Name: name, Path: descriptor.Path, Value: formatStatusValue(value)})
+		}
+	}
+
+	result.Conditions = getServiceConditions(status, conditionsPath)
+	phase, _, _ := unstructured.NestedString(status, phasePath...)
+	result.State, result.Message = getServiceState(result.Conditions, phase)
+
+	// the status may not reflect the last changes of the spec yet
+	if observedGeneration, found, _ := unstructured.NestedInt64(status, "observedGeneration"); found && observedGeneration < u.GetGeneration() && result.State != ServiceStateFailed {
+		result.State, result.Message = ServiceStateProgressing, "the operator has not processed the last changes of the service yet"
+	}
+	return result
+}
+
+// getServiceState returns the state of a service from its conditions, then from its phase
+func getServiceState(conditions []ServiceCondition, phase string) (ServiceState, string) {
+	for _, c := range conditions {
+		if containsFold(failedConditionTypes, c.Type) && c.Status == "True" {
+			return ServiceStateFailed, getConditionMessage(c)
+		}
+	}
+	for _, c := range conditions {
+		if !containsFold(readyConditionTypes, c.Type) {
+			continue
+		}
+		switch {
+		case c.Status == "True":
+			return ServiceStateReady, getConditionMessage(c)
+		case strings.Contains(strings.ToLower(c.Reason), "fail") || strings.Contains(strings.ToLower(c.Reason), "error"):
+			return ServiceStateFailed, getConditionMessage(c)
+		default:
+			return ServiceStateProgressing, getConditionMessage(c)
+		}
+	}
+	for _, c := range conditions {
+		if strings.EqualFold(c.Type, "Progressing") && c.Status == "True" {
+			return ServiceStateProgressing, getConditionMessage(c)
+		}
+	}
+
+	switch {
+	case phase == "":
+		return ServiceStateUnknown, "the operator doesn't report the readiness of the service"
+	case containsFold(readyPhases, phase):
+		return ServiceStateReady, fmt.Sprintf("phase %s", phase)
+	case containsFold(failedPhases, phase):
+		return ServiceStateFailed, fmt.Sprintf("phase %s", phase)
+	default:
+		return ServiceStateProgressing, fmt.Sprintf("phase %s", phase)
+	}
+}
+
+// getServiceConditions returns the conditions found at the given path of the status
+func getServiceConditions(status map[string]interface{}, path []string) []ServiceCondition {
+	items, found, err := unstructured.NestedSlice(status, path...)
+	if err != nil || !found {
+		return nil
+	}
+	var conditions []ServiceCondition
+	for _, item := range items {
+		condition, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		c := ServiceCondition{}
+		c.Type, _, _ = unstructured.NestedString(condition, "type")
+		c.Status, _, _ = unstructured.NestedString(condition, "status")
+		c.Reason, _, _ = unstructured.NestedString(condition, "reason")
+		c.Message, _, _ = unstructured.NestedString(condition, "message")
+		if c.Type != "" {
+			conditions = append(conditions, c)
+		}
+	}
+	return conditions
+}
+
+// getConditionMessage returns the message of a condition, or its reason if it has no message
+func getConditionMessage(c ServiceCondition) string {
+	if c.Message != "" {
+		return c.Message
+	}
+	return c.Reason
+}
+
+// formatStatusValue returns the value of a field of the status, as displayed to the user
+func formatStatusValue(value interface{}) string {
+	switch v := value.(type) {
+	case map[string]interface{}, []interface{}:
+		data, err := json.Marshal(v)
+		if err == nil {
+			return string(data)
+		}
+	}
+	return fmt.Sprint(value)
+}
+
+func hasXDescriptor(descriptor olm.StatusDescriptor, xDescriptor string) bool {
+	for _, x := range descriptor.XDescriptors {
+		if x == xDescriptor {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFold(list []string, s string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetStatusDescriptors returns the statusDescriptors defined by the operator for the custom resource of the given kind,
+// or nil if the operator doesn't define any or if the cluster doesn't support Operators
+func GetStatusDescriptors(client kclient.ClientInterface, kind string) []olm.StatusDescriptor {
+	cr := getCRDDescription(client, kind)
+	if cr == nil {
+		return nil
+	}
+	return cr.StatusDescriptors
+}
+
+// getCRDDescription returns the description of the custom resource of the given kind by the operator providing it,
+// or nil if the cluster doesn't support Operators or if no operator provides it
+func getCRDDescription(client kclient.ClientInterface, kind string) *olm.CRDDescription {
+	csvSupported, err := client.IsCSVSupported()
+	if err != nil || !csvSupported {
+		return nil
+	}
+	csv, err := client.GetCSVWithCR(kind)
+	if err != nil {
+		klog.V(4).Infof("unable to get the Operator providing %s: %v", kind, err)
+		return nil
+	}
+	hasCR, cr := client.CheckCustomResourceInCSV(kind, csv)
+	if !hasCR {
+		return nil
+	}
+	return cr
+}
+
+// GetDeployedServiceStatus returns the status of the given service, as deployed on the cluster in the namespace of the
+// service, or in the current namespace when the service has no namespace
+func GetDeployedServiceStatus(client kclient.ClientInterface, u unstructured.Unstructured) (ServiceStatus, error) {
+	restMapping, err := client.GetRestMappingFromUnstructured(u)
+	if err != nil {
+		return ServiceStatus{}, err
+	}
+	var deployed *unstructured.Unstructured
+	if ns := u.GetNamespace(); ns != "" && ns != client.GetCurrentNamespace() {
+		deployed, err = client.GetDynamicClient().Resource(restMapping.Resource).Namespace(ns).Get(context.TODO(), u.GetName(), metav1.GetOptions{})
+	} else {
+		deployed, err = client.GetDynamicResource(restMapping.Resource.Group, restMapping.Resource.Version, restMapping.Resource.Resource, u.GetName())
+	}
+	if err != nil {
+		return ServiceStatus{}, err
+	}
+	return GetServiceStatus(*deployed, GetStatusDescriptors(client, u.GetKind())), nil
+}
+
+// GetLinkedServices returns the operator backed services the component is linked to by the ServiceBindings of the devfile,
+// with the namespaces set in the ServiceBindings
+// the links to other components, Secrets and ConfigMaps are ignored
+func GetLinkedServices(k8sComponents []devfile.Component, context string) ([]unstructured.Unstructured, error) {
+	var services []unstructured.Unstructured
+	for _, c := range k8sComponents {
+		u, err := GetK8sComponentAsUnstructured(c.Kubernetes, context, devfilefs.DefaultFs{})
+		if err != nil {
+			return nil, err
+		}
+		if !isLinkResource(u.GetKind()) {
+			continue
+		}
+		var sb servicebinding.ServiceBinding
+		js, err := u.MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		err = json.Unmarshal(js, &sb)
+		if err != nil {
+			return nil, err
+		}
+		for _, service := range sb.Spec.Services {
+			// the built-in resources, such as the Services of other components, have no readiness to wait for
+			if !strings.Contains(service.Group, ".") {
+				continue
+			}
+			s := unstructured.Unstructured{}
+			s.SetAPIVersion(service.Group + "/" + service.Version)
+			s.SetKind(service.Kind)
+			s.SetName(service.Name)
+			if service.Namespace != nil {
+				s.SetNamespace(*service.Namespace)
+			}
+			services = append(services, s)
+		}
+	}
+	return services, nil
+}
+
+// WaitForServicesReady waits until the given services are ready, as reported by their operators
+// it returns an error if one of the services failed, or if they are not ready before the timeout
+// the services whose readiness is unknown are considered ready
+func WaitForServicesReady(client kclient.ClientInterface, services []unstructured.Unstructured, timeout time.Duration) error {
+	if len(services) == 0 {
+		return nil
+	}
+	spinner := log.Spinnerf("Waiting for %d linked service(s) to be ready", len(services))
+	defer spinner.End(false)
+
+	deadline := time.Now().Add(timeout)
+	pending := services
+	for {
+		var notReady []unstructured.Unstructured
+		var messages []string
+		for _, s := range pending {
+			name := s.GetKind() + "/" + s.GetName()
+			status, err := GetDeployedServiceStatus(client, s)
+			if err != nil {
+				return errors.Wrapf(err, "unable to get the status of the service %q", name)
+			}
+			switch status.State {
+			case ServiceStateFailed:
+				return fmt.Errorf("the service %q failed: %s", name, status.Message)
+			case ServiceStateProgressing:
+				notReady = append(notReady, s)
+				messages = append(messages, fmt.Sprintf("%s: %s", name, status.Message))
+			}
+		}
+		if len(notReady) == 0 {
+			spinner.End(true)
+			return nil
+		}
+		if time.Now().After(deadline) {
+			sort.Strings(messages)
+			return fmt.Errorf("timeout while waiting for the linked services to be ready:\n  - %s", strings.Join(messages, "\n  - "))
+		}
+		pending = notReady
+		time.Sleep(waitServicesInterval)
+	}
+}
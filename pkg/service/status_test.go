@@ -0,0 +1,219 @@
+package service
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	devfile "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/golang/mock/gomock"
+	olm "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/redhat-developer/odo/pkg/kclient"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func getServiceWithStatus(status map[string]interface{}) unstructured.Unstructured {
+	u := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "redis.redis.opstreelabs.in/v1beta1",
+		"kind":       "Redis",
+		"metadata":   map[string]interface{}{"name": "myredis", "generation": int64(2)},
+	}}
+	if status != nil {
+		u.Object["status"] = status
+	}
+	return u
+}
+
+func TestGetServiceStatus(t *testing.T) {
+	tests := []struct {
+		name              string
+		status            map[string]interface{}
+		statusDescriptors []olm.StatusDescriptor
+		wantState         ServiceState
+		wantMessage       string
+		wantFields        []ServiceStatusField
+	}{
+		{
+			name:      "no status",
+			wantState: ServiceStateProgressing,
+		},
+		{
+			name: "ready condition",
+			status: map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Ready", "status": "True", "reason": "ClusterReady"},
+				},
+			},
+			wantState:   ServiceStateReady,
+			wantMessage: "ClusterReady",
+		},
+		{
+			name: "ready condition not true",
+			status: map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Ready", "status": "False", "reason": "Creating", "message": "1 of 3 members ready"},
+				},
+			},
+			wantState:   ServiceStateProgressing,
+			wantMessage: "1 of 3 members ready",
+		},
+		{
+			name: "failed condition",
+			status: map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Ready", "status": "False"},
+					map[string]interface{}{"type": "Degraded", "status": "True", "message": "invalid storage class"},
+				},
+			},
+			wantState:   ServiceStateFailed,
+			wantMessage: "invalid storage class",
+		},
+		{
+			name:        "phase",
+			status:      map[string]interface{}{"phase": "Running"},
+			wantState:   ServiceStateReady,
+			wantMessage: "phase Running",
+		},
+		{
+			name:        "stale status",
+			status:      map[string]interface{}{"phase": "Running", "observedGeneration": int64(1)},
+			wantState:   ServiceStateProgressing,
+			wantMessage: "the operator has not processed the last changes of the service yet",
+		},
+		{
+			name:      "unknown readiness",
+			status:    map[string]interface{}{"members": int64(3)},
+			wantState: ServiceStateUnknown,
+		},
+		{
+			name: "status descriptors",
+			status: map[string]interface{}{
+				"state":    map[string]interface{}{"value": "failed"},
+				"endpoint": "redis://myredis:6379",
+			},
+			statusDescriptors: []olm.StatusDescriptor{
+				{Path: "state.value", XDescriptors: []string{phaseXDescriptor}},
+				{Path: "endpoint", DisplayName: "Endpoint"},
+				{Path: "missing", DisplayName: "Missing"},
+			},
+			wantState:   ServiceStateFailed,
+			wantMessage: "phase failed",
+			wantFields:  []ServiceStatusField{{Name: "Endpoint", Path: "endpoint", Value: "redis://myredis:6379"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := GetServiceStatus(getServiceWithStatus(tt.status), tt.statusDescriptors)
+			if got.State != tt.wantState {
+				t.Errorf("got state %q, want %q", got.State, tt.wantState)
+			}
+			if tt.wantMessage != "" && got.Message != tt.wantMessage {
+				t.Errorf("got message %q, want %q", got.Message, tt.wantMessage)
+			}
+			if !reflect.DeepEqual(got.Fields, tt.wantFields) {
+				t.Errorf("got fields %v, want %v", got.Fields, tt.wantFields)
+			}
+		})
+	}
+}
+
+func TestWaitForServicesReady(t *testing.T) {
+	defer func(interval time.Duration) { waitServicesInterval = interval }(waitServicesInterval)
+	waitServicesInterval = time.Millisecond
+
+	progressing := getServiceWithStatus(map[string]interface{}{"phase": "Creating"})
+	ready := getServiceWithStatus(map[string]interface{}{"phase": "Running"})
+	failed := getServiceWithStatus(map[string]interface{}{"phase": "Failed"})
+
+	tests := []struct {
+		name     string
+		statuses []unstructured.Unstructured
+		timeout  time.Duration
+		wantErr  string
+	}{
+		{
+			name:     "service becomes ready",
+			statuses: []unstructured.Unstructured{progressing, progressing, ready},
+			timeout:  time.Minute,
+		},
+		{
+			name:     "service fails",
+			statuses: []unstructured.Unstructured{progressing, failed},
+			timeout:  time.Minute,
+			wantErr:  "the service \"Redis/myredis\" failed: phase Failed",
+		},
+		{
+			name:     "timeout",
+			statuses: []unstructured.Unstructured{progressing},
+			timeout:  0,
+			wantErr:  "timeout while waiting for the linked services to be ready",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			fkClient := kclient.NewMockClientInterface(ctrl)
+			fkClient.EXPECT().GetRestMappingFromUnstructured(gomock.Any()).Return(&meta.RESTMapping{
+				Resource: schema.GroupVersionResource{Group: "redis.redis.opstreelabs.in", Version: "v1beta1", Resource: "redis"},
+			}, nil).AnyTimes()
+			fkClient.EXPECT().IsCSVSupported().Return(false, nil).AnyTimes()
+			calls := 0
+			fkClient.EXPECT().GetDynamicResource("redis.redis.opstreelabs.in", "v1beta1", "redis", "myredis").DoAndReturn(func(group, version, resource, name string) (*unstructured.Unstructured, error) {
+				u := tt.statuses[calls]
+				if calls < len(tt.statuses)-1 {
+					calls++
+				}
+				return &u, nil
+			}).AnyTimes()
+
+			err := WaitForServicesReady(fkClient, []unstructured.Unstructured{getServiceWithStatus(nil)}, tt.timeout)
+			if tt.wantErr == "" && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Errorf("got error %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetLinkedServices(t *testing.T) {
+	k8sComponents := []devfile.Component{
+		{
+			Name: "link-redis",
+			ComponentUnion: devfile.ComponentUnion{Kubernetes: &devfile.KubernetesComponent{K8sLikeComponent: devfile.K8sLikeComponent{
+				K8sLikeComponentLocation: devfile.K8sLikeComponentLocation{Inlined: `
+apiVersion: binding.operators.coreos.com/v1alpha1
+kind: ServiceBinding
+metadata:
+  name: link-redis
+spec:
+  services:
+  - group: redis.redis.opstreelabs.in
+    version: v1beta1
+    kind: Redis
+    name: myredis
+    namespace: databases
+  - group: ""
+    version: v1
+    kind: Service
+    name: backend`},
+			}}},
+		},
+	}
+	services, err := GetLinkedServices(k8sComponents, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(services) != 1 {
+		t.Fatalf("got %d services, want 1", len(services))
+	}
+	if services[0].GetKind() != "Redis" || services[0].GetName() != "myredis" || services[0].GetNamespace() != "databases" {
+		t.Errorf("got service %s/%s in namespace %q, want Redis/myredis in namespace databases", services[0].GetKind(), services[0].GetName(), services[0].GetNamespace())
+	}
+}
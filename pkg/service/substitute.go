@@ -0,0 +1,317 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	devfile "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	devfilefs "github.com/devfile/library/pkg/testingutil/filesystem"
+	"github.com/ghodss/yaml"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/log"
+	servicebinding "github.com/redhat-developer/service-binding-operator/apis/binding/v1alpha1"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// SubstituteAttribute is the attribute of the Kubernetes component of a service holding the definition of its dev substitute
+	SubstituteAttribute = "dev.odo.service.substitute"
+	// SubstituteLabel is the label of the resources of the dev substitutes, holding the name of the component they are deployed for
+	SubstituteLabel = "odo.dev/service-substitute"
+	// SubstituteNameLabel is the label of the resources of a dev substitute, holding the name of the service it replaces
+	SubstituteNameLabel = "odo.dev/service-substitute-name"
+	// SubstituteServiceAnnotation is the annotation of the resources of a dev substitute, holding the service it replaces, as <kind>/<name>
+	SubstituteServiceAnnotation = "odo.dev/service-substitute-for"
+)
+
+// ServiceSubstitute is a lightweight stand-in of a service, deployed in place of the service during development when its operator
+// is not available on the cluster
+type ServiceSubstitute struct {
+	// Image is the image of the container of the stand-in
+	Image string `json:"image"`
+	// Ports are the ports of the container, exposed by a Kubernetes Service named as the service
+	Ports []int32 `json:"ports,omitempty"`
+	// Env are the environment variables of the container
+	Env map[string]string `json:"env,omitempty"`
+	// Binding is the binding data the service would provide, stored in the Secret the links to the service are bound to
+	Binding map[string]string `json:"binding,omitempty"`
+}
+
+// GetSubstituteSecretName returns the name of the Secret holding the binding data of the dev substitute of the service
+func GetSubstituteSecretName(serviceName string) string {
+	return serviceName + "-binding"
+}
+
+// SubstituteUnavailableServices replaces the services defining a dev substitute and whose operator is not available on the cluster:
+// it returns the Kubernetes components where the services are replaced by the resources of their substitutes, and the links
+// to these services by links to the Secrets holding the binding data of the substitutes, keeping the names of the bindings
+func SubstituteUnavailableServices(client kclient.ClientInterface, k8sComponents []devfile.Component, componentName string, context string) ([]devfile.Component, error) {
+	var available map[string]bool
+	substituted := map[string]unstructured.Unstructured{}
+	var result []devfile.Component
+	for _, c := range k8sComponents {
+		if !c.Attributes.Exists(SubstituteAttribute) {
+			result = append(result, c)
+			continue
+		}
+		u, err := GetK8sComponentAsUnstructured(c.Kubernetes, context, devfilefs.DefaultFs{})
+		if err != nil {
+			return nil, err
+		}
+		if available == nil {
+			available, err = getAvailableServiceKinds(client)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if available[u.GetKind()] {
+			result = append(result, c)
+			continue
+		}
+
+		var substitute ServiceSubstitute
+		if err = c.Attributes.GetInto(SubstituteAttribute, &substitute); err != nil {
+			return nil, fmt.Errorf("invalid %q attribute of component %q: %w", SubstituteAttribute, c.Name, err)
+		}
+		if substitute.Image == "" {
+			return nil, fmt.Errorf("the dev substitute of component %q must define an image", c.Name)
+		}
+		for _, r := range getSubstituteResources(substitute, u, componentName) {
+			comp, err := newInlinedKubernetesComponent(c.Name+"-"+strings.ToLower(r.GetKind()), r)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, comp)
+		}
+		substituted[u.GetKind()+"/"+u.GetName()] = u
+		log.Warningf("The Operator providing %q is not available, its dev substitute is used instead", u.GetKind()+"/"+u.GetName())
+	}
+	if len(substituted) == 0 {
+		return result, nil
+	}
+
+	// bind the links to the substituted services to the Secrets of the substitutes
+	for i, c := range result {
+		if c.Kubernetes == nil {
+			continue
+		}
+		u, err := GetK8sComponentAsUnstructured(c.Kubernetes, context, devfilefs.DefaultFs{})
+		if err != nil {
+			return nil, err
+		}
+		if !isLinkResource(u.GetKind()) {
+			continue
+		}
+		var sb servicebinding.ServiceBinding
+		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &sb); err != nil {
+			return nil, err
+		}
+		if len(sb.Spec.Services) != 1 {
+			continue
+		}
+		service, found := substituted[sb.Spec.Services[0].Kind+"/"+sb.Spec.Services[0].Name]
+		if !found || service.GroupVersionKind().Group != sb.Spec.Services[0].Group {
+			continue
+		}
+		substituteBinding(&sb, service.GetKind())
+		linkObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&sb)
+		if err != nil {
+			return nil, err
+		}
+		result[i], err = newInlinedKubernetesComponent(c.Name, unstructured.Unstructured{Object: linkObj})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// substituteBinding binds the link to the Secret of the substitute of the service of the given kind, the names of the
+// bindings being generated as for the service
+func substituteBinding(sb *servicebinding.ServiceBinding, kind string) {
+	sb.Spec.NamingStrategy = strings.ReplaceAll(sb.Spec.NamingTemplate(), ".service.kind", fmt.Sprintf("%q", kind))
+	sb.Spec.Services[0].Group = ""
+	sb.Spec.Services[0].Version = "v1"
+	sb.Spec.Services[0].Kind = SecretKind
+	sb.Spec.Services[0].Name = GetSubstituteSecretName(sb.Spec.Services[0].Name)
+}
+
+// getAvailableServiceKinds returns the kinds of the services provided by the Operators installed on the cluster
+func getAvailableServiceKinds(client kclient.ClientInterface) (map[string]bool, error) {
+	kinds := map[string]bool{}
+	csvSupported, err := client.IsCSVSupported()
+	if err != nil || !csvSupported {
+		return kinds, err
+	}
+	csvs, err := client.ListClusterServiceVersions()
+	if err != nil {
+		return nil, err
+	}
+	for i := range csvs.Items {
+		for _, cr := range *client.GetCustomResourcesFromCSV(&csvs.Items[i]) {
+			kinds[cr.Kind] = true
+		}
+	}
+	return kinds, nil
+}
+
+// IsServiceSubstituted returns true if the Kubernetes component defines a dev substitute of the service which is used
+// because the Operator providing the service is not available
+func IsServiceSubstituted(client kclient.ClientInterface, c devfile.Component, u unstructured.Unstructured) (bool, error) {
+	if !c.Attributes.Exists(SubstituteAttribute) {
+		return false, nil
+	}
+	available, err := getAvailableServiceKinds(client)
+	if err != nil {
+		return false, err
+	}
+	return !available[u.GetKind()], nil
+}
+
+// getSubstituteResources returns the Deployment, Service and Secret of the substitute of the service
+func getSubstituteResources(substitute ServiceSubstitute, service unstructured.Unstructured, componentName string) []unstructured.Unstructured {
+	name := service.GetName()
+	labels := map[string]interface{}{
+		SubstituteLabel:     componentName,
+		SubstituteNameLabel: name,
+	}
+	annotations := map[string]interface{}{
+		SubstituteServiceAnnotation: service.GetKind() + "/" + name,
+	}
+	metadata := func(name string) map[string]interface{} {
+		return map[string]interface{}{
+			"name":        name,
+			"labels":      runtime.DeepCopyJSONValue(labels),
+			"annotations": runtime.DeepCopyJSONValue(annotations),
+		}
+	}
+
+	envNames := make([]string, 0, len(substitute.Env))
+	for k := range substitute.Env {
+		envNames = append(envNames, k)
+	}
+	sort.Strings(envNames)
+	var env []interface{}
+	for _, k := range envNames {
+		env = append(env, map[string]interface{}{"name": k, "value": substitute.Env[k]})
+	}
+	var containerPorts, servicePorts []interface{}
+	for _, p := range substitute.Ports {
+		containerPorts = append(containerPorts, map[string]interface{}{"containerPort": int64(p)})
+		servicePorts = append(servicePorts, map[string]interface{}{"name": fmt.Sprintf("port-%d", p), "port": int64(p), "targetPort": int64(p)})
+	}
+	container := map[string]interface{}{
+		"name":  "substitute",
+		"image": substitute.Image,
+	}
+	if len(env) > 0 {
+		container["env"] = env
+	}
+	if len(containerPorts) > 0 {
+		container["ports"] = containerPorts
+	}
+
+	selector := map[string]interface{}{SubstituteLabel: componentName, SubstituteNameLabel: name}
+	resources := []unstructured.Unstructured{
+		{Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata":   metadata(name),
+			"spec": map[string]interface{}{
+				"replicas": int64(1),
+				"selector": map[string]interface{}{"matchLabels": runtime.DeepCopyJSONValue(selector)},
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{"labels": runtime.DeepCopyJSONValue(selector)},
+					"spec":     map[string]interface{}{"containers": []interface{}{container}},
+				},
+			},
+		}},
+	}
+	if len(servicePorts) > 0 {
+		resources = append(resources, unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Service",
+			"metadata":   metadata(name),
+			"spec": map[string]interface{}{
+				"selector": runtime.DeepCopyJSONValue(selector),
+				"ports":    servicePorts,
+			},
+		}})
+	}
+	stringData := map[string]interface{}{}
+	for k, v := range substitute.Binding {
+		stringData[k] = v
+	}
+	resources = append(resources, unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       SecretKind,
+		"metadata":   metadata(GetSubstituteSecretName(name)),
+		"stringData": stringData,
+	}})
+	return resources
+}
+
+// newInlinedKubernetesComponent returns a Kubernetes component with the given name defining the resource
+func newInlinedKubernetesComponent(name string, u unstructured.Unstructured) (devfile.Component, error) {
+	data, err := yaml.Marshal(u.Object)
+	if err != nil {
+		return devfile.Component{}, err
+	}
+	return devfile.Component{
+		Name: name,
+		ComponentUnion: devfile.ComponentUnion{
+			Kubernetes: &devfile.KubernetesComponent{
+				K8sLikeComponent: devfile.K8sLikeComponent{
+					K8sLikeComponentLocation: devfile.K8sLikeComponentLocation{
+						Inlined: string(data),
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+// DeleteUnusedServiceSubstitutes deletes the resources of the dev substitutes deployed for the component which are not part
+// of the Kubernetes components anymore, when the Operators providing the services have been installed or the services removed
+func DeleteUnusedServiceSubstitutes(client kclient.ClientInterface, k8sComponents []devfile.Component, componentName string, context string) error {
+	deployments, err := client.ListDeployments(SubstituteLabel + "=" + componentName)
+	if err != nil {
+		return err
+	}
+	if len(deployments.Items) == 0 {
+		return nil
+	}
+
+	used := map[string]bool{}
+	for _, c := range k8sComponents {
+		u, err := GetK8sComponentAsUnstructured(c.Kubernetes, context, devfilefs.DefaultFs{})
+		if err != nil {
+			return err
+		}
+		if _, ok := u.GetLabels()[SubstituteLabel]; ok && u.GetKind() == "Deployment" {
+			used[u.GetName()] = true
+		}
+	}
+
+	for _, d := range deployments.Items {
+		if used[d.Name] {
+			continue
+		}
+		for _, r := range []struct{ name, group, version, resource string }{
+			{d.Name, "apps", "v1", "deployments"},
+			{d.Name, "", "v1", "services"},
+			{GetSubstituteSecretName(d.Name), "", "v1", "secrets"},
+		} {
+			err = client.DeleteDynamicResource(r.name, r.group, r.version, r.resource)
+			if err != nil && !kerrors.IsNotFound(err) {
+				return err
+			}
+		}
+		log.Successf("Deleted the dev substitute of service %q from the cluster", d.Annotations[SubstituteServiceAnnotation])
+	}
+	return nil
+}
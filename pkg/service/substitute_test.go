@@ -0,0 +1,173 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	devfile "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/attributes"
+	devfilefs "github.com/devfile/library/pkg/testingutil/filesystem"
+	"github.com/golang/mock/gomock"
+	olm "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	servicebinding "github.com/redhat-developer/service-binding-operator/apis/binding/v1alpha1"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const postgresService = `apiVersion: postgres-operator.crunchydata.com/v1beta1
+kind: PostgresCluster
+metadata:
+  name: mydb
+spec:
+  postgresVersion: 13
+`
+
+const postgresLink = `apiVersion: binding.operators.coreos.com/v1alpha1
+kind: ServiceBinding
+metadata:
+  name: nodejs-postgrescluster-mydb
+spec:
+  application:
+    group: apps
+    name: nodejs-app
+    resource: deployments
+    version: v1
+  services:
+  - group: postgres-operator.crunchydata.com
+    kind: PostgresCluster
+    name: mydb
+    version: v1beta1
+`
+
+func getInlinedComponent(name, inlined string, attrs attributes.Attributes) devfile.Component {
+	return devfile.Component{
+		Name:       name,
+		Attributes: attrs,
+		ComponentUnion: devfile.ComponentUnion{
+			Kubernetes: &devfile.KubernetesComponent{
+				K8sLikeComponent: devfile.K8sLikeComponent{
+					K8sLikeComponentLocation: devfile.K8sLikeComponentLocation{Inlined: inlined},
+				},
+			},
+		},
+	}
+}
+
+func TestSubstituteUnavailableServices(t *testing.T) {
+	substitute := attributes.Attributes{}.Put(SubstituteAttribute, ServiceSubstitute{
+		Image:   "postgres:13",
+		Ports:   []int32{5432},
+		Env:     map[string]string{"POSTGRES_PASSWORD": "dev"},
+		Binding: map[string]string{"host": "mydb", "password": "dev"},
+	}, nil)
+
+	tests := []struct {
+		name         string
+		csvSupported bool
+		wantNames    []string
+	}{
+		{
+			name:         "operator not available",
+			csvSupported: false,
+			wantNames:    []string{"mydb-deployment", "mydb-service", "mydb-secret", "nodejs-postgrescluster-mydb"},
+		},
+		{
+			name:         "operator available",
+			csvSupported: true,
+			wantNames:    []string{"mydb", "nodejs-postgrescluster-mydb"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			fkClient := kclient.NewMockClientInterface(ctrl)
+			fkClient.EXPECT().IsCSVSupported().Return(tt.csvSupported, nil)
+			if tt.csvSupported {
+				fkClient.EXPECT().ListClusterServiceVersions().Return(&olm.ClusterServiceVersionList{Items: []olm.ClusterServiceVersion{{}}}, nil)
+				fkClient.EXPECT().GetCustomResourcesFromCSV(gomock.Any()).Return(&[]olm.CRDDescription{{Kind: "PostgresCluster"}})
+			}
+
+			k8sComponents := []devfile.Component{
+				getInlinedComponent("mydb", postgresService, substitute),
+				getInlinedComponent("nodejs-postgrescluster-mydb", postgresLink, nil),
+			}
+			got, err := SubstituteUnavailableServices(fkClient, k8sComponents, "nodejs", "")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var gotNames []string
+			for _, c := range got {
+				gotNames = append(gotNames, c.Name)
+			}
+			if !reflect.DeepEqual(gotNames, tt.wantNames) {
+				t.Fatalf("got components %v, want %v", gotNames, tt.wantNames)
+			}
+			if tt.csvSupported {
+				return
+			}
+
+			u, err := GetK8sComponentAsUnstructured(got[3].Kubernetes, "", devfilefs.DefaultFs{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			var sb servicebinding.ServiceBinding
+			if err = runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &sb); err != nil {
+				t.Fatal(err)
+			}
+			wantService := servicebinding.Service{
+				NamespacedRef: servicebinding.NamespacedRef{
+					Ref: servicebinding.Ref{Version: "v1", Kind: "Secret", Name: "mydb-binding"},
+				},
+			}
+			if !reflect.DeepEqual(sb.Spec.Services, []servicebinding.Service{wantService}) {
+				t.Errorf("got services %+v, want %+v", sb.Spec.Services, wantService)
+			}
+			if want := `{{ "PostgresCluster" | upper }}_{{ .name | upper }}`; sb.Spec.NamingStrategy != want {
+				t.Errorf("got naming strategy %q, want %q", sb.Spec.NamingStrategy, want)
+			}
+
+			secret, err := GetK8sComponentAsUnstructured(got[2].Kubernetes, "", devfilefs.DefaultFs{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if secret.GetName() != "mydb-binding" || secret.GetLabels()[SubstituteLabel] != "nodejs" {
+				t.Errorf("got secret %s with labels %v", secret.GetName(), secret.GetLabels())
+			}
+			if want := map[string]interface{}{"host": "mydb", "password": "dev"}; !reflect.DeepEqual(secret.Object["stringData"], want) {
+				t.Errorf("got binding data %v, want %v", secret.Object["stringData"], want)
+			}
+		})
+	}
+}
+
+func TestDeleteUnusedServiceSubstitutes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	fkClient := kclient.NewMockClientInterface(ctrl)
+	fkClient.EXPECT().ListDeployments(SubstituteLabel+"=nodejs").Return(&appsv1.DeploymentList{Items: []appsv1.Deployment{
+		{ObjectMeta: metav1.ObjectMeta{Name: "mydb", Annotations: map[string]string{SubstituteServiceAnnotation: "PostgresCluster/mydb"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "mycache", Annotations: map[string]string{SubstituteServiceAnnotation: "Redis/mycache"}}},
+	}}, nil)
+	// the substitute of mycache is still used
+	fkClient.EXPECT().DeleteDynamicResource("mydb", "apps", "v1", "deployments").Return(nil)
+	fkClient.EXPECT().DeleteDynamicResource("mydb", "", "v1", "services").Return(nil)
+	fkClient.EXPECT().DeleteDynamicResource("mydb-binding", "", "v1", "secrets").Return(nil)
+
+	k8sComponents := []devfile.Component{
+		getInlinedComponent("mycache-deployment", `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: mycache
+  labels:
+    odo.dev/service-substitute: nodejs
+`, nil),
+	}
+	err := DeleteUnusedServiceSubstitutes(fkClient, k8sComponents, "nodejs", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,260 @@
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	devfile "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	devfilefs "github.com/devfile/library/pkg/testingutil/filesystem"
+	openapierrors "github.com/go-openapi/errors"
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/util"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog"
+)
+
+// schemaCacheDir is the directory where odo caches the schemas of the custom resources, in the cache directory of the
+// user; the schemas are not cached when the directory is empty
+var schemaCacheDir = getSchemaCacheDir()
+
+// schemaCacheTime is the duration during which a cached schema is used without contacting the cluster
+const schemaCacheTime = time.Hour
+
+// cachedSchema is the content of a file of the schema cache; Schema is nil for the resources which are not custom resources
+type cachedSchema struct {
+	Schema *spec.Schema `json:"schema"`
+}
+
+// ResourceSchemaErrors contains the errors found when validating a resource against the schema of its custom resource
+type ResourceSchemaErrors struct {
+	Resource unstructured.Unstructured
+	Errors   []string
+}
+
+// ValidateResourcesSchema validates the Kubernetes components of the devfile against the schemas of their custom resources,
+// and returns an error describing all the invalid fields found
+func ValidateResourcesSchema(client kclient.ClientInterface, k8sComponents []devfile.Component, context string) error {
+	results, err := GetResourcesSchemaErrors(client, k8sComponents, context)
+	if err != nil {
+		return err
+	}
+	var invalid []string
+	for _, result := range results {
+		if len(result.Errors) > 0 {
+			invalid = append(invalid, fmt.Sprintf("%s/%s:\n  - %s", result.Resource.GetKind(), result.Resource.GetName(), strings.Join(result.Errors, "\n  - ")))
+		}
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("following resource(s) in the devfile are invalid; fix them before doing \"odo push\":\n%s", strings.Join(invalid, "\n"))
+	}
+	return nil
+}
+
+// GetResourcesSchemaErrors validates the Kubernetes components of the devfile against the schemas of their custom resources,
+// and returns the errors found for each resource
+func GetResourcesSchemaErrors(client kclient.ClientInterface, k8sComponents []devfile.Component, context string) ([]ResourceSchemaErrors, error) {
+	var results []ResourceSchemaErrors
+	for _, c := range k8sComponents {
+		u, err := GetK8sComponentAsUnstructured(c.Kubernetes, context, devfilefs.DefaultFs{})
+		if err != nil {
+			return nil, err
+		}
+		if isLinkResource(u.GetKind()) {
+			continue
+		}
+		fieldErrors, err := ValidateResourceSchema(client, u)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, ResourceSchemaErrors{Resource: u, Errors: fieldErrors})
+	}
+	return results, nil
+}
+
+// ValidateResourceSchema validates a resource against the OpenAPI v3 schema of its custom resource definition and returns
+// the invalid fields found: unknown fields, invalid types and values, missing required fields
+// the resources which are not custom resources, as well as the service bindings, are not validated
+func ValidateResourceSchema(client kclient.ClientInterface, u unstructured.Unstructured) ([]string, error) {
+	if isLinkResource(u.GetKind()) {
+		return nil, nil
+	}
+	schema, err := getResourceSchema(client, u)
+	if err != nil || schema == nil {
+		return nil, err
+	}
+	return validateAgainstSchema(schema, u.Object), nil
+}
+
+// rootFieldsNotValidated are the fields of a resource which are not validated against the schema of its custom resource:
+// the API server validates the type and the metadata of all the resources itself, whatever the schema declares, and the
+// status is set by the operator
+var rootFieldsNotValidated = []string{"apiVersion", "kind", "metadata", "status"}
+
+// validateAgainstSchema returns the errors found when validating the object against the schema, sorted by field path
+func validateAgainstSchema(schema *spec.Schema, object map[string]interface{}) []string {
+	content := make(map[string]interface{}, len(object))
+	for key, value := range object {
+		if !util.In(rootFieldsNotValidated, key) {
+			content[key] = value
+		}
+	}
+	root := *schema
+	root.Properties = make(map[string]spec.Schema, len(schema.Properties))
+	for key, property := range schema.Properties {
+		if !util.In(rootFieldsNotValidated, key) {
+			root.Properties[key] = property
+		}
+	}
+	root.Required = nil
+	for _, key := range schema.Required {
+		if !util.In(rootFieldsNotValidated, key) {
+			root.Required = append(root.Required, key)
+		}
+	}
+
+	fieldErrors := getUnknownFields(&root, content, "")
+	if err := validate.AgainstSchema(&root, content, strfmt.Default); err != nil {
+		fieldErrors = append(fieldErrors, flattenValidationErrors(err)...)
+	}
+	sort.Strings(fieldErrors)
+	return fieldErrors
+}
+
+// flattenValidationErrors returns the messages of the errors returned by the validation of a schema
+func flattenValidationErrors(err error) []string {
+	composite, ok := err.(*openapierrors.CompositeError)
+	if !ok {
+		return []string{strings.TrimPrefix(err.Error(), ".")}
+	}
+	var messages []string
+	for _, e := range composite.Errors {
+		messages = append(messages, flattenValidationErrors(e)...)
+	}
+	return messages
+}
+
+// getUnknownFields returns the fields of the data which are not defined by the schema, for the objects which don't
+// accept additional fields; such fields are silently pruned by the cluster
+func getUnknownFields(schema *spec.Schema, data interface{}, path string) []string {
+	if schema == nil {
+		return nil
+	}
+	var unknown []string
+	switch v := data.(type) {
+	case map[string]interface{}:
+		preserveUnknownFields, _ := schema.Extensions.GetBool("x-kubernetes-preserve-unknown-fields")
+		for key, value := range v {
+			fieldPath := key
+			if path != "" {
+				fieldPath = path + "." + key
+			}
+			if property, ok := schema.Properties[key]; ok {
+				unknown = append(unknown, getUnknownFields(&property, value, fieldPath)...)
+				continue
+			}
+			if schema.AdditionalProperties != nil && schema.AdditionalProperties.Schema != nil {
+				unknown = append(unknown, getUnknownFields(schema.AdditionalProperties.Schema, value, fieldPath)...)
+				continue
+			}
+			if len(schema.Properties) > 0 && !preserveUnknownFields && (schema.AdditionalProperties == nil || !schema.AdditionalProperties.Allows) {
+				unknown = append(unknown, fmt.Sprintf("%s in body is an unknown field", fieldPath))
+			}
+		}
+	case []interface{}:
+		if schema.Items != nil && schema.Items.Schema != nil {
+			for i, item := range v {
+				unknown = append(unknown, getUnknownFields(schema.Items.Schema, item, fmt.Sprintf("%s[%d]", path, i))...)
+			}
+		}
+	}
+	return unknown
+}
+
+// getResourceSchema returns the schema of the custom resource of the given resource, from the cache if present,
+// or nil if the resource is not a custom resource
+func getResourceSchema(client kclient.ClientInterface, u unstructured.Unstructured) (*spec.Schema, error) {
+	host := ""
+	if config := client.GetClientConfig(); config != nil {
+		host = config.Host
+	}
+	cacheFile := ""
+	if schemaCacheDir != "" {
+		cacheFile = filepath.Join(schemaCacheDir, fmt.Sprintf("%x.json", sha256.Sum256([]byte(strings.Join([]string{host, u.GetAPIVersion(), u.GetKind()}, "/")))))
+		if schema, found := readCachedSchema(cacheFile); found {
+			return schema, nil
+		}
+	}
+
+	restMapping, err := client.GetRestMappingFromUnstructured(u)
+	if err != nil {
+		return nil, err
+	}
+	schema, err := client.GetCRDSchema(restMapping.Resource.Group, restMapping.Resource.Version, restMapping.Resource.Resource)
+	if kerrors.IsForbidden(err) {
+		// the resource is not validated, and the missing permission is not cached as it may be granted at any time
+		klog.V(4).Infof("unable to get the definition of %s/%s: %v", u.GetAPIVersion(), u.GetKind(), err)
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	writeCachedSchema(cacheFile, schema)
+	return schema, nil
+}
+
+// getSchemaCacheDir returns the directory caching the schemas, or an empty string when the user has no cache directory
+func getSchemaCacheDir() string {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		klog.V(4).Infof("the schemas of the custom resources are not cached: %v", err)
+		return ""
+	}
+	return filepath.Join(cacheDir, "odo", "schemas")
+}
+
+// readCachedSchema returns the schema cached in the given file, if the file exists and is recent enough
+func readCachedSchema(cacheFile string) (*spec.Schema, bool) {
+	info, err := os.Stat(cacheFile)
+	if err != nil || info.ModTime().Add(schemaCacheTime).Before(time.Now()) {
+		return nil, false
+	}
+	data, err := ioutil.ReadFile(cacheFile)
+	if err != nil {
+		return nil, false
+	}
+	var cached cachedSchema
+	if err = json.Unmarshal(data, &cached); err != nil {
+		klog.V(4).Infof("Ignoring the invalid schema cache file %s: %v", cacheFile, err)
+		return nil, false
+	}
+	return cached.Schema, true
+}
+
+// writeCachedSchema caches the schema in the given file, readable by the user only; failing to cache the schema is not an error
+func writeCachedSchema(cacheFile string, schema *spec.Schema) {
+	if cacheFile == "" {
+		return
+	}
+	data, err := json.Marshal(cachedSchema{Schema: schema})
+	if err == nil {
+		err = os.MkdirAll(schemaCacheDir, 0700)
+	}
+	if err == nil {
+		err = ioutil.WriteFile(cacheFile, data, 0600)
+	}
+	if err != nil {
+		klog.V(4).Infof("Unable to cache the schema in %s: %v", cacheFile, err)
+	}
+}
@@ -0,0 +1,230 @@
+package service
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/go-openapi/spec"
+	"github.com/golang/mock/gomock"
+	"github.com/redhat-developer/odo/pkg/kclient"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+)
+
+const etcdClusterSchema = `{
+  "type": "object",
+  "properties": {
+    "apiVersion": {"type": "string"},
+    "kind": {"type": "string"},
+    "metadata": {"type": "object"},
+    "spec": {
+      "type": "object",
+      "required": ["size"],
+      "properties": {
+        "size": {"type": "integer", "minimum": 1},
+        "version": {"type": "string"},
+        "storage": {"type": "string", "enum": ["ephemeral", "persistent"]},
+        "labels": {"type": "object", "additionalProperties": {"type": "string"}},
+        "pod": {"type": "object", "x-kubernetes-preserve-unknown-fields": true, "properties": {"resources": {"type": "object"}}},
+        "members": {
+          "type": "array",
+          "items": {"type": "object", "properties": {"name": {"type": "string"}}}
+        }
+      }
+    }
+  }
+}`
+
+func TestValidateAgainstSchema(t *testing.T) {
+	s := new(spec.Schema)
+	if err := s.UnmarshalJSON([]byte(etcdClusterSchema)); err != nil {
+		t.Fatalf("unable to parse the schema: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		spec map[string]interface{}
+		want []string
+	}{
+		{
+			name: "valid spec",
+			spec: map[string]interface{}{
+				"size":    int64(3),
+				"storage": "ephemeral",
+				"labels":  map[string]interface{}{"tier": "db"},
+				"pod":     map[string]interface{}{"nodeSelector": map[string]interface{}{"disk": "ssd"}},
+				"members": []interface{}{map[string]interface{}{"name": "etcd-0"}},
+			},
+		},
+		{
+			name: "missing required field",
+			spec: map[string]interface{}{"version": "3.2.13"},
+			want: []string{"spec.size in body is required"},
+		},
+		{
+			name: "invalid type and value",
+			spec: map[string]interface{}{"size": "3", "storage": "local"},
+			want: []string{
+				"spec.size in body must be of type integer: \"string\"",
+				"spec.storage in body should be one of [ephemeral persistent]",
+			},
+		},
+		{
+			name: "unknown fields",
+			spec: map[string]interface{}{
+				"size":    int64(3),
+				"sise":    int64(3),
+				"members": []interface{}{map[string]interface{}{"nmae": "etcd-0"}},
+			},
+			want: []string{
+				"spec.members[0].nmae in body is an unknown field",
+				"spec.sise in body is an unknown field",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			object := map[string]interface{}{
+				"apiVersion": "etcd.database.coreos.com/v1beta2",
+				"kind":       "EtcdCluster",
+				"metadata":   map[string]interface{}{"name": "example"},
+				"spec":       tt.spec,
+				"status":     map[string]interface{}{"phase": "Running"},
+			}
+			got := validateAgainstSchema(s, object)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got errors %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateAgainstSpecOnlySchema(t *testing.T) {
+	// many CRDs only declare the spec and the status, or restrict the metadata to the name
+	schemas := map[string]string{
+		"spec only": `{
+  "type": "object",
+  "required": ["spec"],
+  "properties": {
+    "spec": {"type": "object", "properties": {"size": {"type": "integer"}}},
+    "status": {"type": "object"}
+  }
+}`,
+		"restricted metadata": `{
+  "type": "object",
+  "properties": {
+    "apiVersion": {"type": "string"},
+    "kind": {"type": "string"},
+    "metadata": {"type": "object", "properties": {"name": {"type": "string", "maxLength": 3}}},
+    "spec": {"type": "object", "properties": {"size": {"type": "integer"}}}
+  }
+}`,
+	}
+	object := map[string]interface{}{
+		"apiVersion": "example.com/v1",
+		"kind":       "Example",
+		"metadata": map[string]interface{}{
+			"name":        "example",
+			"labels":      map[string]interface{}{"app": "example"},
+			"annotations": map[string]interface{}{"note": "example"},
+		},
+		"spec": map[string]interface{}{"size": int64(3), "sise": int64(3)},
+	}
+	for name, schemaJSON := range schemas {
+		t.Run(name, func(t *testing.T) {
+			s := new(spec.Schema)
+			if err := s.UnmarshalJSON([]byte(schemaJSON)); err != nil {
+				t.Fatalf("unable to parse the schema: %v", err)
+			}
+			want := []string{"spec.sise in body is an unknown field"}
+			if got := validateAgainstSchema(s, object); !reflect.DeepEqual(got, want) {
+				t.Errorf("got errors %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestValidateResourceSchemaUsesCache(t *testing.T) {
+	cacheDir, err := ioutil.TempDir("", "odoschemacache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(cacheDir)
+	defer func(dir string) { schemaCacheDir = dir }(schemaCacheDir)
+	schemaCacheDir = cacheDir
+
+	s := new(spec.Schema)
+	if err = s.UnmarshalJSON([]byte(etcdClusterSchema)); err != nil {
+		t.Fatalf("unable to parse the schema: %v", err)
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	fkClient := kclient.NewMockClientInterface(ctrl)
+	fkClient.EXPECT().GetClientConfig().Return(&rest.Config{Host: "https://cluster:6443"}).Times(2)
+	// the cluster is contacted only once, the second validation uses the cached schema
+	fkClient.EXPECT().GetRestMappingFromUnstructured(gomock.Any()).Return(&meta.RESTMapping{
+		Resource: schema.GroupVersionResource{Group: "etcd.database.coreos.com", Version: "v1beta2", Resource: "etcdclusters"},
+	}, nil).Times(1)
+	fkClient.EXPECT().GetCRDSchema("etcd.database.coreos.com", "v1beta2", "etcdclusters").Return(s, nil).Times(1)
+
+	u := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "etcd.database.coreos.com/v1beta2",
+		"kind":       "EtcdCluster",
+		"metadata":   map[string]interface{}{"name": "example"},
+		"spec":       map[string]interface{}{"size": int64(3), "sise": int64(3)},
+	}}
+	want := []string{"spec.sise in body is an unknown field"}
+	for i := 0; i < 2; i++ {
+		got, err := ValidateResourceSchema(fkClient, u)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("validation %d: got errors %q, want %q", i, got, want)
+		}
+	}
+}
+
+func TestValidateResourceSchemaDoesNotCacheForbidden(t *testing.T) {
+	cacheDir, err := ioutil.TempDir("", "odoschemacache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(cacheDir)
+	defer func(dir string) { schemaCacheDir = dir }(schemaCacheDir)
+	schemaCacheDir = cacheDir
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	fkClient := kclient.NewMockClientInterface(ctrl)
+	fkClient.EXPECT().GetClientConfig().Return(&rest.Config{Host: "https://cluster:6443"}).Times(2)
+	// the cluster is contacted on each validation, as the user may be allowed to read the definition later
+	fkClient.EXPECT().GetRestMappingFromUnstructured(gomock.Any()).Return(&meta.RESTMapping{
+		Resource: schema.GroupVersionResource{Group: "etcd.database.coreos.com", Version: "v1beta2", Resource: "etcdclusters"},
+	}, nil).Times(2)
+	forbidden := kerrors.NewForbidden(schema.GroupResource{Group: "apiextensions.k8s.io", Resource: "customresourcedefinitions"}, "etcdclusters.etcd.database.coreos.com", nil)
+	fkClient.EXPECT().GetCRDSchema("etcd.database.coreos.com", "v1beta2", "etcdclusters").Return(nil, forbidden).Times(2)
+
+	u := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "etcd.database.coreos.com/v1beta2",
+		"kind":       "EtcdCluster",
+		"metadata":   map[string]interface{}{"name": "example"},
+		"spec":       map[string]interface{}{"sise": int64(3)},
+	}}
+	for i := 0; i < 2; i++ {
+		got, err := ValidateResourceSchema(fkClient, u)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(got) != 0 {
+			t.Errorf("validation %d: got errors %q, want no error", i, got)
+		}
+	}
+}
@@ -0,0 +1,229 @@
+package storage
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/redhat-developer/odo/pkg/devfile/adapters/common"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/preference"
+	"github.com/redhat-developer/odo/pkg/sync"
+	"github.com/redhat-developer/odo/pkg/util"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog"
+)
+
+const (
+	// HelperPodLabel is the label applied to the temporary pods used to access a storage
+	// when the component is not running
+	HelperPodLabel = "odo.dev/storage-helper"
+
+	// helperImageEnvName is the name of the environment variable overriding the image of the helper pods
+	helperImageEnvName = "ODO_STORAGE_HELPER_IMAGE"
+	// defaultHelperImage is the image of the helper pods, it needs to provide tar
+	defaultHelperImage = "registry.access.redhat.com/ubi8/ubi"
+
+	helperContainerName = "helper"
+	helperMountPath     = "/data"
+)
+
+// storageTarget is the container having a storage mounted, used to copy files in and out of the storage
+type storageTarget struct {
+	compInfo  common.ComponentInfo
+	mountPath string
+	// cleanup removes the helper pod, if any
+	cleanup func()
+}
+
+// CopyTo copies the local file or directory into the remotePath directory of the given Storage
+func (k kubernetesClient) CopyTo(localPath, storageName, remotePath string) error {
+	localPath, err := util.GetAbsPath(localPath)
+	if err != nil {
+		return err
+	}
+	if _, err = os.Stat(localPath); err != nil {
+		return err
+	}
+
+	target, err := k.getStorageTarget(storageName)
+	if err != nil {
+		return err
+	}
+	defer target.cleanup()
+
+	syncClient := podSyncClient{client: k.client}
+	targetPath := path.Join(target.mountPath, path.Clean("/"+filepath.ToSlash(remotePath)))
+
+	err = syncClient.ExecCMDInContainer(target.compInfo, []string{"mkdir", "-p", targetPath}, io.Discard, io.Discard, nil, false)
+	if err != nil {
+		return errors.Wrapf(err, "unable to create directory %s in storage %s", remotePath, storageName)
+	}
+
+	var files []string
+	err = filepath.Walk(localPath, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		files = append(files, p)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// the local file or directory is copied with its name into the target directory
+	return sync.CopyFile(syncClient, filepath.Dir(localPath), target.compInfo, targetPath, files, nil, util.IndexerRet{})
+}
+
+// CopyFrom copies the file or directory at remotePath of the given Storage into the local directory localPath
+func (k kubernetesClient) CopyFrom(storageName, remotePath, localPath string) error {
+	localPath, err := util.GetAbsPath(localPath)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(localPath, os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	target, err := k.getStorageTarget(storageName)
+	if err != nil {
+		return err
+	}
+	defer target.cleanup()
+
+	sourcePath := path.Join(target.mountPath, path.Clean("/"+filepath.ToSlash(remotePath)))
+	return sync.CopyFileFromComponent(podSyncClient{client: k.client}, target.compInfo, sourcePath, localPath)
+}
+
+// getStorageTarget returns the container of the running component mounting the PVC of the given Storage,
+// or starts a helper pod mounting the PVC if the component is not running
+func (k kubernetesClient) getStorageTarget(storageName string) (storageTarget, error) {
+	pvcName, err := getPVCNameFromStorageName(k.client, storageName)
+	if err != nil {
+		return storageTarget{}, err
+	}
+
+	pod, err := k.client.GetOnePodFromSelector(fmt.Sprintf("component=%s", k.componentName))
+	if err != nil {
+		if _, ok := err.(*kclient.PodNotFoundError); !ok {
+			return storageTarget{}, err
+		}
+	} else if pod.Status.Phase == corev1.PodRunning {
+		if containerName, mountPath, found := getPVCMount(pod, pvcName); found {
+			klog.V(3).Infof("Using container %s of pod %s to access storage %s", containerName, pod.Name, storageName)
+			return storageTarget{
+				compInfo:  common.ComponentInfo{PodName: pod.Name, ContainerName: containerName},
+				mountPath: mountPath,
+				cleanup:   func() {},
+			}, nil
+		}
+	}
+
+	return k.startHelperPod(pvcName)
+}
+
+// getPVCMount returns the container of the pod and the path on which the given PVC is mounted
+func getPVCMount(pod *corev1.Pod, pvcName string) (containerName string, mountPath string, found bool) {
+	var volumeName string
+	for _, volume := range pod.Spec.Volumes {
+		if volume.PersistentVolumeClaim != nil && volume.PersistentVolumeClaim.ClaimName == pvcName {
+			volumeName = volume.Name
+			break
+		}
+	}
+	if volumeName == "" {
+		return "", "", false
+	}
+	for _, container := range pod.Spec.Containers {
+		for _, volumeMount := range container.VolumeMounts {
+			if volumeMount.Name == volumeName {
+				return container.Name, volumeMount.MountPath, true
+			}
+		}
+	}
+	return "", "", false
+}
+
+// startHelperPod starts a pod mounting the given PVC and waits for it to be running
+func (k kubernetesClient) startHelperPod(pvcName string) (storageTarget, error) {
+	podName := fmt.Sprintf("%s-%s", util.TruncateString(pvcName, 50), util.GenerateRandomString(4))
+	labels := map[string]string{
+		HelperPodLabel: podName,
+	}
+
+	pod := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   podName,
+			Labels: labels,
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy: corev1.RestartPolicyNever,
+			Containers: []corev1.Container{
+				{
+					Name:    helperContainerName,
+					Image:   util.GetEnvWithDefault(helperImageEnvName, defaultHelperImage),
+					Command: []string{"sleep", "infinity"},
+					VolumeMounts: []corev1.VolumeMount{
+						{
+							Name:      pvcName,
+							MountPath: helperMountPath,
+						},
+					},
+				},
+			},
+			Volumes: []corev1.Volume{
+				{
+					Name: pvcName,
+					VolumeSource: corev1.VolumeSource{
+						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+							ClaimName: pvcName,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	klog.V(3).Infof("Starting helper pod %s to access PVC %s", podName, pvcName)
+	_, err := k.client.CreatePod(pod)
+	if err != nil {
+		return storageTarget{}, err
+	}
+	cleanup := func() {
+		if e := k.client.DeletePod(podName); e != nil {
+			klog.V(2).Infof("Unable to delete helper pod %s: %v", podName, e)
+		}
+	}
+
+	_, err = k.client.WaitAndGetPodWithEvents(util.ConvertLabelsToSelector(labels), corev1.PodRunning, "Waiting for the storage to be accessible", time.Duration(preference.DefaultPushTimeout)*time.Second)
+	if err != nil {
+		cleanup()
+		return storageTarget{}, err
+	}
+
+	return storageTarget{
+		compInfo:  common.ComponentInfo{PodName: podName, ContainerName: helperContainerName},
+		mountPath: helperMountPath,
+		cleanup:   cleanup,
+	}, nil
+}
+
+// podSyncClient implements sync.SyncClient for any pod of the cluster
+type podSyncClient struct {
+	client kclient.ClientInterface
+}
+
+func (p podSyncClient) ExecCMDInContainer(compInfo common.ComponentInfo, cmd []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, tty bool) error {
+	return p.client.ExecCMDInContainer(compInfo.ContainerName, compInfo.PodName, cmd, stdout, stderr, stdin, tty)
+}
+
+func (p podSyncClient) ExtractProjectToComponent(compInfo common.ComponentInfo, targetPath string, stdin io.Reader) error {
+	return p.client.ExtractProjectToComponent(compInfo.ContainerName, compInfo.PodName, targetPath, stdin)
+}
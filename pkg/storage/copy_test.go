@@ -0,0 +1,72 @@
+package storage
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func Test_getPVCMount(t *testing.T) {
+	pod := &corev1.Pod{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name: "runtime",
+					VolumeMounts: []corev1.VolumeMount{
+						{Name: "odo-projects", MountPath: "/projects"},
+					},
+				},
+				{
+					Name: "db",
+					VolumeMounts: []corev1.VolumeMount{
+						{Name: "data-nodejs-app-vol", MountPath: "/var/lib/data"},
+					},
+				},
+			},
+			Volumes: []corev1.Volume{
+				{
+					Name:         "odo-projects",
+					VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+				},
+				{
+					Name: "data-nodejs-app-vol",
+					VolumeSource: corev1.VolumeSource{
+						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "data-nodejs-app"},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		pvcName       string
+		wantContainer string
+		wantPath      string
+		wantFound     bool
+	}{
+		{
+			name:          "case 1: pvc mounted in a container",
+			pvcName:       "data-nodejs-app",
+			wantContainer: "db",
+			wantPath:      "/var/lib/data",
+			wantFound:     true,
+		},
+		{
+			name:      "case 2: pvc not used by the pod",
+			pvcName:   "cache-nodejs-app",
+			wantFound: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotContainer, gotPath, gotFound := getPVCMount(pod, tt.pvcName)
+			if gotFound != tt.wantFound {
+				t.Errorf("getPVCMount() found = %v, want %v", gotFound, tt.wantFound)
+			}
+			if gotContainer != tt.wantContainer || gotPath != tt.wantPath {
+				t.Errorf("getPVCMount() = %v, %v, want %v, %v", gotContainer, gotPath, tt.wantContainer, tt.wantPath)
+			}
+		})
+	}
+}
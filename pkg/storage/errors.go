@@ -0,0 +1,16 @@
+package storage
+
+import "fmt"
+
+// VolumeExpansionNotAllowedError returns an error if the volume of a storage cannot be expanded
+type VolumeExpansionNotAllowedError struct {
+	StorageName  string
+	StorageClass string
+}
+
+func (e *VolumeExpansionNotAllowedError) Error() string {
+	if e.StorageClass == "" {
+		return fmt.Sprintf("the volume of storage %s cannot be expanded as it has no storage class", e.StorageName)
+	}
+	return fmt.Sprintf("the volume of storage %s cannot be expanded as the storage class %s doesn't allow volume expansion", e.StorageName, e.StorageClass)
+}
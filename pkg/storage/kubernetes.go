@@ -2,7 +2,6 @@ package storage
 
 import (
 	"fmt"
-	"reflect"
 	"strings"
 
 	"github.com/devfile/library/pkg/devfile/generator"
@@ -61,6 +60,12 @@ func (k kubernetesClient) Create(storage Storage) error {
 		Quantity:   quantity,
 	}
 	pvc := generator.GetPVC(pvcParams)
+	if storage.Spec.StorageClass != "" {
+		pvc.Spec.StorageClassName = &storage.Spec.StorageClass
+	}
+	if storage.Spec.AccessMode != "" {
+		pvc.Spec.AccessModes = []corev1.PersistentVolumeAccessMode{corev1.PersistentVolumeAccessMode(storage.Spec.AccessMode)}
+	}
 
 	// Create PVC
 	klog.V(2).Infof("Creating a PVC with name %v and labels %v", pvcName, labels)
@@ -71,6 +76,43 @@ func (k kubernetesClient) Create(storage Storage) error {
 	return nil
 }
 
+// Expand requests the size of the given Storage for its pvc
+// a *VolumeExpansionNotAllowedError is returned if the storage class of the pvc doesn't allow volume expansion
+func (k kubernetesClient) Expand(storage Storage) error {
+	pvcName, err := getPVCNameFromStorageName(k.client, storage.Name)
+	if err != nil {
+		return err
+	}
+
+	quantity, err := resource.ParseQuantity(storage.Spec.Size)
+	if err != nil {
+		return errors.Wrapf(err, "unable to parse size: %v", storage.Spec.Size)
+	}
+
+	pvc, err := k.client.GetPVCFromName(pvcName)
+	if err != nil {
+		return errors.Wrapf(err, "unable to get PVC %v", pvcName)
+	}
+	var storageClass string
+	if pvc.Spec.StorageClassName != nil {
+		storageClass = *pvc.Spec.StorageClassName
+	}
+	if storageClass == "" {
+		return &VolumeExpansionNotAllowedError{StorageName: storage.Name}
+	}
+
+	allowed, err := k.client.IsVolumeExpansionAllowed(storageClass)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return &VolumeExpansionNotAllowedError{StorageName: storage.Name, StorageClass: storageClass}
+	}
+
+	klog.V(2).Infof("Expanding PVC %v to %v", pvcName, quantity.String())
+	return k.client.UpdatePVCSize(pvcName, quantity)
+}
+
 // Delete deletes the pvc belonging to the given Storage
 func (k kubernetesClient) Delete(name string) error {
 	pvcName, err := getPVCNameFromStorageName(k.client, name)
@@ -149,19 +191,47 @@ func (k kubernetesClient) ListFromCluster() (StorageList, error) {
 		return StorageList{}, errors.Wrapf(err, "unable to get PVC using selector %v", storagelabels.StorageLabel)
 	}
 
+	// a PVC restored from a snapshot replaces a terminating PVC of the same storage
+	// until the next push, it is mounted where the PVC it replaces is mounted
+	claimStorageNames := make(map[string]string)
+	activeStorageNames := make(map[string]bool)
+	for _, pvc := range pvcs {
+		claimStorageNames[pvc.Name] = pvc.Labels[storagelabels.DevfileStorageLabel]
+		if pvc.DeletionTimestamp == nil {
+			activeStorageNames[pvc.Labels[storagelabels.DevfileStorageLabel]] = true
+		}
+	}
+	volumeStorageNames := make(map[string]string)
+	for _, volume := range k.deployment.Spec.Template.Spec.Volumes {
+		if volume.PersistentVolumeClaim != nil {
+			volumeStorageNames[volume.Name] = claimStorageNames[volume.PersistentVolumeClaim.ClaimName]
+		}
+	}
+
 	// to track volume mounts used by a PVC
 	validVolumeMounts := make(map[string]bool)
 
 	for _, pvc := range pvcs {
+		storageName := pvc.Labels[storagelabels.DevfileStorageLabel]
+		if pvc.DeletionTimestamp != nil && activeStorageNames[storageName] {
+			continue
+		}
 		found := false
 		for _, volumeMount := range volumeMounts {
-			if volumeMount.Name == pvc.Name+"-vol" {
+			if volumeMount.Name == pvc.Name+"-vol" || (storageName != "" && volumeStorageNames[volumeMount.Name] == storageName) {
 				// this volume mount is used by a PVC
 				validVolumeMounts[volumeMount.Name] = true
 
 				found = true
 				size := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
-				storage = append(storage, NewStorageWithContainer(pvc.Labels[storagelabels.DevfileStorageLabel], size.String(), volumeMount.Spec.Path, volumeMount.Spec.ContainerName, nil))
+				clusterStorage := NewStorageWithContainer(storageName, size.String(), volumeMount.Spec.Path, volumeMount.Spec.ContainerName, nil)
+				if pvc.Spec.StorageClassName != nil {
+					clusterStorage.Spec.StorageClass = *pvc.Spec.StorageClassName
+				}
+				if len(pvc.Spec.AccessModes) > 0 {
+					clusterStorage.Spec.AccessMode = string(pvc.Spec.AccessModes[0])
+				}
+				storage = append(storage, clusterStorage)
 			}
 		}
 		if !found {
@@ -211,7 +281,7 @@ func (k kubernetesClient) List() (StorageList, error) {
 	for _, localStore := range localStorage.Items {
 		found := false
 		for _, clusterStore := range clusterStorage.Items {
-			if reflect.DeepEqual(localStore, clusterStore) {
+			if isSameStorage(localStore, clusterStore) {
 				found = true
 			}
 		}
@@ -227,7 +297,7 @@ func (k kubernetesClient) List() (StorageList, error) {
 	for _, clusterStore := range clusterStorage.Items {
 		found := false
 		for _, localStore := range localStorage.Items {
-			if reflect.DeepEqual(localStore, clusterStore) {
+			if isSameStorage(localStore, clusterStore) {
 				found = true
 			}
 		}
@@ -23,6 +23,24 @@ func Test_kubernetesClient_ListFromCluster(t *testing.T) {
 	type fields struct {
 		generic generic
 	}
+
+	// a deployment still using a PVC which is being replaced by a PVC restored from a snapshot
+	restoredDeployment := testingutil.CreateFakeDeploymentsWithContainers("nodejs", []corev1.Container{
+		testingutil.CreateFakeContainerWithVolumeMounts("container-0", []corev1.VolumeMount{
+			{Name: "volume-0-vol", MountPath: "/data"},
+		}),
+	}, []corev1.Container{})
+	restoredDeployment.Spec.Template.Spec.Volumes = []corev1.Volume{
+		{
+			Name: "volume-0-vol",
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "volume-0"},
+			},
+		},
+	}
+	terminatingPVC := testingutil.FakePVC("volume-0", "5Gi", map[string]string{"component": "nodejs", storageLabels.DevfileStorageLabel: "volume-0"})
+	terminatingPVC.DeletionTimestamp = &metav1.Time{}
+
 	tests := []struct {
 		name                string
 		fields              fields
@@ -311,6 +329,30 @@ func Test_kubernetesClient_ListFromCluster(t *testing.T) {
 			want:    StorageList{},
 			wantErr: false,
 		},
+		{
+			name: "case 11: pvc restored from a snapshot replaces a terminating pvc",
+			fields: fields{
+				generic: generic{
+					appName:       "app",
+					componentName: "nodejs",
+				},
+			},
+			returnedDeployments: &appsv1.DeploymentList{
+				Items: []appsv1.Deployment{*restoredDeployment},
+			},
+			returnedPVCs: &corev1.PersistentVolumeClaimList{
+				Items: []corev1.PersistentVolumeClaim{
+					*terminatingPVC,
+					*testingutil.FakePVC("volume-0-abcd", "5Gi", map[string]string{"component": "nodejs", storageLabels.DevfileStorageLabel: "volume-0"}),
+				},
+			},
+			want: StorageList{
+				Items: []Storage{
+					generateStorage(NewStorage("volume-0", "5Gi", "/data", nil), "", "container-0"),
+				},
+			},
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -688,6 +730,23 @@ func Test_kubernetesClient_Create(t *testing.T) {
 				storage: NewStorageWithContainer("odo-projects-vol", "5Gi", "/data", "runtime", util.GetBoolPtr(false)),
 			},
 		},
+		{
+			name: "case 4: storage with a storage class and an access mode",
+			fields: fields{
+				generic: generic{
+					appName:       "app",
+					componentName: "nodejs",
+				},
+			},
+			args: args{
+				storage: func() Storage {
+					storage := NewStorageWithContainer("storage-0", "5Gi", "/data", "runtime", util.GetBoolPtr(false))
+					storage.Spec.StorageClass = "fast"
+					storage.Spec.AccessMode = string(corev1.ReadWriteMany)
+					return storage
+				}(),
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -735,6 +794,21 @@ func Test_kubernetesClient_Create(t *testing.T) {
 				t.Errorf("size of PVC is not matching to expected size, expected: %v, got %v", quantity, createdPVC.Spec.Resources.Requests["storage"])
 			}
 
+			if tt.args.storage.Spec.StorageClass != "" {
+				if createdPVC.Spec.StorageClassName == nil || *createdPVC.Spec.StorageClassName != tt.args.storage.Spec.StorageClass {
+					t.Errorf("storage class of the PVC is not matching to expected storage class, expected: %v, got %v", tt.args.storage.Spec.StorageClass, createdPVC.Spec.StorageClassName)
+				}
+			} else if createdPVC.Spec.StorageClassName != nil {
+				t.Errorf("storage class of the PVC should not be set, got %v", *createdPVC.Spec.StorageClassName)
+			}
+			wantAccessModes := []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}
+			if tt.args.storage.Spec.AccessMode != "" {
+				wantAccessModes = []corev1.PersistentVolumeAccessMode{corev1.PersistentVolumeAccessMode(tt.args.storage.Spec.AccessMode)}
+			}
+			if !reflect.DeepEqual(createdPVC.Spec.AccessModes, wantAccessModes) {
+				t.Errorf("access modes of the PVC are not matching to expected access modes, expected: %v, got %v", wantAccessModes, createdPVC.Spec.AccessModes)
+			}
+
 			wantedPVCName, err := generatePVCName(tt.args.storage.Name, tt.fields.generic.componentName, tt.fields.generic.appName)
 			if err != nil {
 				t.Errorf("unexpected error: %v", err)
@@ -746,6 +820,70 @@ func Test_kubernetesClient_Create(t *testing.T) {
 	}
 }
 
+func Test_kubernetesClient_Expand(t *testing.T) {
+	standardClass := "standard"
+
+	tests := []struct {
+		name         string
+		storageClass *string
+		allowed      bool
+		wantExpand   bool
+		wantErr      bool
+		wantErrType  bool
+	}{
+		{
+			name:         "case 1: storage class allows volume expansion",
+			storageClass: &standardClass,
+			allowed:      true,
+			wantExpand:   true,
+		},
+		{
+			name:         "case 2: storage class doesn't allow volume expansion",
+			storageClass: &standardClass,
+			allowed:      false,
+			wantErr:      true,
+			wantErrType:  true,
+		},
+		{
+			name:        "case 3: pvc without storage class",
+			wantErr:     true,
+			wantErrType: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pvc := testingutil.FakePVC("storage-0-nodejs-app", "5Gi", getStorageLabels("storage-0", "nodejs", "app"))
+			pvc.Spec.StorageClassName = tt.storageClass
+
+			client := kclient.NewMockClientInterface(ctrl)
+			client.EXPECT().ListPVCs(gomock.Any()).Return([]corev1.PersistentVolumeClaim{*pvc}, nil).AnyTimes()
+			client.EXPECT().GetPVCFromName(pvc.Name).Return(pvc, nil).AnyTimes()
+			client.EXPECT().IsVolumeExpansionAllowed(standardClass).Return(tt.allowed, nil).AnyTimes()
+			if tt.wantExpand {
+				client.EXPECT().UpdatePVCSize(pvc.Name, resource.MustParse("10Gi")).Return(nil).Times(1)
+			}
+
+			k := kubernetesClient{
+				generic: generic{
+					appName:       "app",
+					componentName: "nodejs",
+				},
+				client: client,
+			}
+			err := k.Expand(NewStorage("storage-0", "10Gi", "/data", nil))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Expand() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if _, ok := err.(*VolumeExpansionNotAllowedError); ok != tt.wantErrType {
+				t.Errorf("Expand() error = %v, want VolumeExpansionNotAllowedError %v", err, tt.wantErrType)
+			}
+		})
+	}
+}
+
 func Test_kubernetesClient_Delete(t *testing.T) {
 	pvcName := "pvc-0"
 	returnedPVCs := corev1.PersistentVolumeClaimList{
@@ -33,6 +33,34 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// CopyFrom mocks base method.
+func (m *MockClient) CopyFrom(storageName, remotePath, localPath string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyFrom", storageName, remotePath, localPath)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CopyFrom indicates an expected call of CopyFrom.
+func (mr *MockClientMockRecorder) CopyFrom(storageName, remotePath, localPath interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyFrom", reflect.TypeOf((*MockClient)(nil).CopyFrom), storageName, remotePath, localPath)
+}
+
+// CopyTo mocks base method.
+func (m *MockClient) CopyTo(localPath, storageName, remotePath string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyTo", localPath, storageName, remotePath)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CopyTo indicates an expected call of CopyTo.
+func (mr *MockClientMockRecorder) CopyTo(localPath, storageName, remotePath interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyTo", reflect.TypeOf((*MockClient)(nil).CopyTo), localPath, storageName, remotePath)
+}
+
 // Create mocks base method.
 func (m *MockClient) Create(arg0 Storage) error {
 	m.ctrl.T.Helper()
@@ -61,6 +89,20 @@ func (mr *MockClientMockRecorder) Delete(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockClient)(nil).Delete), arg0)
 }
 
+// Expand mocks base method.
+func (m *MockClient) Expand(arg0 Storage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Expand", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Expand indicates an expected call of Expand.
+func (mr *MockClientMockRecorder) Expand(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Expand", reflect.TypeOf((*MockClient)(nil).Expand), arg0)
+}
+
 // List mocks base method.
 func (m *MockClient) List() (StorageList, error) {
 	m.ctrl.T.Helper()
@@ -90,3 +132,31 @@ func (mr *MockClientMockRecorder) ListFromCluster() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFromCluster", reflect.TypeOf((*MockClient)(nil).ListFromCluster))
 }
+
+// Restore mocks base method.
+func (m *MockClient) Restore(storageName, snapshotName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", storageName, snapshotName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockClientMockRecorder) Restore(storageName, snapshotName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockClient)(nil).Restore), storageName, snapshotName)
+}
+
+// Snapshot mocks base method.
+func (m *MockClient) Snapshot(storageName, snapshotName, snapshotClassName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Snapshot", storageName, snapshotName, snapshotClassName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Snapshot indicates an expected call of Snapshot.
+func (mr *MockClientMockRecorder) Snapshot(storageName, snapshotName, snapshotClassName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Snapshot", reflect.TypeOf((*MockClient)(nil).Snapshot), storageName, snapshotName, snapshotClassName)
+}
@@ -0,0 +1,140 @@
+package storage
+
+import (
+	"fmt"
+
+	"github.com/devfile/library/pkg/devfile/generator"
+	"github.com/pkg/errors"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	storagelabels "github.com/redhat-developer/odo/pkg/storage/labels"
+	"github.com/redhat-developer/odo/pkg/util"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog"
+)
+
+// RestoredFromSnapshotAnnotation is the annotation added to a PVC restored from a VolumeSnapshot
+const RestoredFromSnapshotAnnotation = "odo.dev/restored-from-snapshot"
+
+// Snapshot creates a VolumeSnapshot of the PVC belonging to the given Storage
+func (k kubernetesClient) Snapshot(storageName, snapshotName, snapshotClassName string) error {
+	if k.componentName == "" || k.appName == "" {
+		return fmt.Errorf("the component name and the app name should be provided")
+	}
+
+	supported, err := k.client.IsVolumeSnapshotSupported()
+	if err != nil {
+		return err
+	}
+	if !supported {
+		return &kclient.VolumeSnapshotNotSupportedError{}
+	}
+
+	pvcName, err := getPVCNameFromStorageName(k.client, storageName)
+	if err != nil {
+		return err
+	}
+
+	labels := storagelabels.GetLabels(storageName, k.componentName, k.appName, true)
+	labels[storagelabels.DevfileStorageLabel] = storageName
+
+	_, err = k.client.CreateVolumeSnapshot(snapshotName, pvcName, snapshotClassName, labels)
+	return err
+}
+
+// Restore replaces the PVC belonging to the given Storage with a new PVC populated from the given VolumeSnapshot
+// the previous PVC is deleted and the component is re-wired to the new PVC on the next push
+func (k kubernetesClient) Restore(storageName, snapshotName string) error {
+	if k.componentName == "" || k.appName == "" {
+		return fmt.Errorf("the component name and the app name should be provided")
+	}
+
+	supported, err := k.client.IsVolumeSnapshotSupported()
+	if err != nil {
+		return err
+	}
+	if !supported {
+		return &kclient.VolumeSnapshotNotSupportedError{}
+	}
+
+	snapshot, err := k.client.GetVolumeSnapshot(snapshotName)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return fmt.Errorf("the snapshot %s does not exist", snapshotName)
+		}
+		return errors.Wrapf(err, "unable to get snapshot %s", snapshotName)
+	}
+
+	ready, _, err := unstructured.NestedBool(snapshot.Object, "status", "readyToUse")
+	if err != nil {
+		return errors.Wrapf(err, "unable to get the status of snapshot %s", snapshotName)
+	}
+	if !ready {
+		return fmt.Errorf("the snapshot %s is not ready to use yet", snapshotName)
+	}
+
+	pvcName, err := getPVCNameFromStorageName(k.client, storageName)
+	if err != nil {
+		return err
+	}
+
+	pvc, err := k.client.GetPVCFromName(pvcName)
+	if err != nil {
+		return errors.Wrapf(err, "unable to get PVC %s", pvcName)
+	}
+
+	// the restored PVC can't be smaller than the snapshot
+	quantity := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+	if restoreSize, found, _ := unstructured.NestedString(snapshot.Object, "status", "restoreSize"); found {
+		size, e := resource.ParseQuantity(restoreSize)
+		if e == nil && size.Cmp(quantity) > 0 {
+			quantity = size
+		}
+	}
+
+	// the old PVC is still mounted by the running component, so a new name is required
+	baseName, err := generatePVCName(storageName, k.componentName, k.appName)
+	if err != nil {
+		return err
+	}
+	restoredPVCName := getRestoredPVCName(baseName)
+
+	annotations := map[string]string{
+		RestoredFromSnapshotAnnotation: snapshotName,
+	}
+	objectMeta := generator.GetObjectMeta(restoredPVCName, k.client.GetCurrentNamespace(), pvc.Labels, annotations)
+
+	restoredPVC := generator.GetPVC(generator.PVCParams{
+		ObjectMeta: objectMeta,
+		Quantity:   quantity,
+	})
+	restoredPVC.Spec.AccessModes = pvc.Spec.AccessModes
+	restoredPVC.Spec.StorageClassName = pvc.Spec.StorageClassName
+	apiGroup := kclient.VolumeSnapshotGroup
+	restoredPVC.Spec.DataSource = &corev1.TypedLocalObjectReference{
+		APIGroup: &apiGroup,
+		Kind:     kclient.VolumeSnapshotKind,
+		Name:     snapshotName,
+	}
+
+	klog.V(2).Infof("Restoring storage %v from snapshot %v into PVC %v", storageName, snapshotName, restoredPVCName)
+	_, err = k.client.CreatePVC(*restoredPVC)
+	if err != nil {
+		return errors.Wrap(err, "unable to create PVC")
+	}
+
+	// the old PVC stays in a terminating state until the component stops using it
+	err = k.client.DeletePVC(pvcName)
+	if err != nil {
+		return errors.Wrapf(err, "unable to delete PVC %v", pvcName)
+	}
+	return nil
+}
+
+// getRestoredPVCName returns a new name for a PVC restored from a snapshot, made of the PVC name and a random suffix,
+// the PVC name being truncated so that the name doesn't exceed the 63 characters allowed by Kubernetes
+func getRestoredPVCName(pvcName string) string {
+	return fmt.Sprintf("%s-%s", util.TruncateString(pvcName, 58), util.GenerateRandomString(4))
+}
@@ -0,0 +1,198 @@
+package storage
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	storageLabels "github.com/redhat-developer/odo/pkg/storage/labels"
+	"github.com/redhat-developer/odo/pkg/testingutil"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func Test_kubernetesClient_Snapshot(t *testing.T) {
+	pvc := testingutil.FakePVC("storage-0-nodejs-app", "5Gi", getStorageLabels("storage-0", "nodejs", "app"))
+
+	tests := []struct {
+		name               string
+		snapshotSupported  bool
+		pvcs               []corev1.PersistentVolumeClaim
+		wantSnapshotCreate bool
+		wantErr            bool
+	}{
+		{
+			name:               "case 1: snapshot created",
+			snapshotSupported:  true,
+			pvcs:               []corev1.PersistentVolumeClaim{*pvc},
+			wantSnapshotCreate: true,
+		},
+		{
+			name:              "case 2: snapshot CRDs not installed",
+			snapshotSupported: false,
+			wantErr:           true,
+		},
+		{
+			name:              "case 3: pvc not found",
+			snapshotSupported: true,
+			wantErr:           true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			client := kclient.NewMockClientInterface(ctrl)
+			client.EXPECT().IsVolumeSnapshotSupported().Return(tt.snapshotSupported, nil)
+			client.EXPECT().ListPVCs(gomock.Any()).Return(tt.pvcs, nil).AnyTimes()
+			if tt.wantSnapshotCreate {
+				wantLabels := getStorageLabels("storage-0", "nodejs", "app")
+				wantLabels[storageLabels.DevfileStorageLabel] = "storage-0"
+				client.EXPECT().CreateVolumeSnapshot("snap-0", pvc.Name, "csi-snapclass", wantLabels).Return(&unstructured.Unstructured{}, nil)
+			}
+
+			k := kubernetesClient{
+				generic: generic{
+					appName:       "app",
+					componentName: "nodejs",
+				},
+				client: client,
+			}
+			err := k.Snapshot("storage-0", "snap-0", "csi-snapclass")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Snapshot() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_kubernetesClient_Restore(t *testing.T) {
+	pvc := testingutil.FakePVC("storage-0-nodejs-app", "5Gi", getStorageLabels("storage-0", "nodejs", "app"))
+
+	newSnapshot := func(ready bool, restoreSize string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"metadata": map[string]interface{}{"name": "snap-0"},
+			"status": map[string]interface{}{
+				"readyToUse":  ready,
+				"restoreSize": restoreSize,
+			},
+		}}
+	}
+
+	tests := []struct {
+		name        string
+		snapshot    *unstructured.Unstructured
+		snapshotErr error
+		wantSize    string
+		wantErr     bool
+	}{
+		{
+			name:     "case 1: restore with the size of the current pvc",
+			snapshot: newSnapshot(true, "1Gi"),
+			wantSize: "5Gi",
+		},
+		{
+			name:     "case 2: restore with the size of the snapshot",
+			snapshot: newSnapshot(true, "10Gi"),
+			wantSize: "10Gi",
+		},
+		{
+			name:     "case 3: snapshot not ready",
+			snapshot: newSnapshot(false, "1Gi"),
+			wantErr:  true,
+		},
+		{
+			name:        "case 4: snapshot not found",
+			snapshotErr: kerrors.NewNotFound(schema.GroupResource{Resource: kclient.VolumeSnapshotResource}, "snap-0"),
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			client := kclient.NewMockClientInterface(ctrl)
+			client.EXPECT().IsVolumeSnapshotSupported().Return(true, nil)
+			client.EXPECT().GetVolumeSnapshot("snap-0").Return(tt.snapshot, tt.snapshotErr)
+			client.EXPECT().ListPVCs(gomock.Any()).Return([]corev1.PersistentVolumeClaim{*pvc}, nil).AnyTimes()
+			client.EXPECT().GetPVCFromName(pvc.Name).Return(pvc, nil).AnyTimes()
+			client.EXPECT().GetCurrentNamespace().Return("project").AnyTimes()
+
+			var createdPVC corev1.PersistentVolumeClaim
+			if !tt.wantErr {
+				client.EXPECT().CreatePVC(gomock.Any()).DoAndReturn(func(created corev1.PersistentVolumeClaim) (*corev1.PersistentVolumeClaim, error) {
+					createdPVC = created
+					return &created, nil
+				})
+				client.EXPECT().DeletePVC(pvc.Name).Return(nil)
+			}
+
+			k := kubernetesClient{
+				generic: generic{
+					appName:       "app",
+					componentName: "nodejs",
+				},
+				client: client,
+			}
+			err := k.Restore("storage-0", "snap-0")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Restore() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if !strings.HasPrefix(createdPVC.Name, pvc.Name+"-") {
+				t.Errorf("name of the restored PVC %q should start with %q", createdPVC.Name, pvc.Name+"-")
+			}
+			if createdPVC.Labels[storageLabels.StorageLabel] != "storage-0" {
+				t.Errorf("restored PVC should have the labels of the storage, got %v", createdPVC.Labels)
+			}
+			if createdPVC.Spec.DataSource == nil || createdPVC.Spec.DataSource.Kind != kclient.VolumeSnapshotKind || createdPVC.Spec.DataSource.Name != "snap-0" {
+				t.Errorf("restored PVC should use the snapshot as data source, got %v", createdPVC.Spec.DataSource)
+			}
+			wantQuantity := resource.MustParse(tt.wantSize)
+			gotQuantity := createdPVC.Spec.Resources.Requests[corev1.ResourceStorage]
+			if gotQuantity.Cmp(wantQuantity) != 0 {
+				t.Errorf("size of restored PVC is %v, want %v", gotQuantity.String(), tt.wantSize)
+			}
+		})
+	}
+}
+
+func Test_getRestoredPVCName(t *testing.T) {
+	tests := []struct {
+		name       string
+		pvcName    string
+		wantPrefix string
+	}{
+		{
+			name:       "case 1: short name",
+			pvcName:    "storage-0-nodejs-app",
+			wantPrefix: "storage-0-nodejs-app-",
+		},
+		{
+			name:       "case 2: name truncated to fit the suffix",
+			pvcName:    strings.Repeat("a", 30) + "-" + strings.Repeat("b", 30) + "-app",
+			wantPrefix: strings.Repeat("a", 30) + "-" + strings.Repeat("b", 27) + "-",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := getRestoredPVCName(tt.pvcName)
+			if len(got) > 63 {
+				t.Errorf("name %q exceeds 63 characters", got)
+			}
+			if !strings.HasPrefix(got, tt.wantPrefix) || len(got) != len(tt.wantPrefix)+4 {
+				t.Errorf("got name %q, want %q followed by a suffix of 4 characters", got, tt.wantPrefix)
+			}
+		})
+	}
+}
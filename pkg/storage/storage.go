@@ -8,6 +8,7 @@ import (
 	"github.com/redhat-developer/odo/pkg/localConfigProvider"
 	"github.com/redhat-developer/odo/pkg/log"
 	v1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 const (
@@ -36,9 +37,14 @@ type ClientOptions struct {
 
 type Client interface {
 	Create(Storage) error
+	Expand(Storage) error
 	Delete(string) error
 	ListFromCluster() (StorageList, error)
 	List() (StorageList, error)
+	Snapshot(storageName, snapshotName, snapshotClassName string) error
+	Restore(storageName, snapshotName string) error
+	CopyTo(localPath, storageName, remotePath string) error
+	CopyFrom(storageName, remotePath, localPath string) error
 }
 
 // NewClient gets the appropriate Storage client based on the parameters
@@ -109,8 +115,9 @@ func Push(client Client, configProvider localConfigProvider.LocalConfigProvider)
 			log.Successf("Deleted storage %v from %v", storage.Name, configProvider.GetName())
 			continue
 		} else if storage.Name == val.Name {
-			if val.Spec.Size != storage.Spec.Size {
-				return nil, errors.Errorf("config mismatch for storage with the same name %s", storage.Name)
+			err = updateStorage(client, val, storage, configProvider.GetName())
+			if err != nil {
+				return nil, err
 			}
 		}
 	}
@@ -129,3 +136,36 @@ func Push(client Client, configProvider localConfigProvider.LocalConfigProvider)
 
 	return ephemeralConfigNames, nil
 }
+
+// updateStorage applies the changes of the local Storage to the Storage on the cluster
+// the volume is expanded if the size has been increased, when its storage class allows it
+func updateStorage(client Client, local, cluster Storage, componentName string) error {
+	localSize, err := resource.ParseQuantity(local.Spec.Size)
+	if err != nil {
+		return errors.Wrapf(err, "unable to parse size: %v", local.Spec.Size)
+	}
+	clusterSize, err := resource.ParseQuantity(cluster.Spec.Size)
+	if err != nil {
+		return errors.Wrapf(err, "unable to parse size: %v", cluster.Spec.Size)
+	}
+
+	switch localSize.Cmp(clusterSize) {
+	case 1:
+		err = client.Expand(local)
+		if _, ok := err.(*VolumeExpansionNotAllowedError); ok {
+			log.Warningf("Unable to change the size of storage %v to %v: %v", local.Name, local.Spec.Size, err)
+		} else if err != nil {
+			return err
+		} else {
+			log.Successf("Expanded storage %v of %v to %v", local.Name, componentName, local.Spec.Size)
+		}
+	case -1:
+		return errors.Errorf("config mismatch for storage with the same name %s, the size cannot be reduced from %s to %s", local.Name, cluster.Spec.Size, local.Spec.Size)
+	}
+
+	if (local.Spec.StorageClass != "" && local.Spec.StorageClass != cluster.Spec.StorageClass) ||
+		(local.Spec.AccessMode != "" && local.Spec.AccessMode != cluster.Spec.AccessMode) {
+		log.Warningf("The storage class and the access mode of storage %v cannot be changed once created, delete the storage and push again to apply them", local.Name)
+	}
+	return nil
+}
@@ -48,6 +48,8 @@ func TestPush(t *testing.T) {
 		returnedFromCluster StorageList
 		createdItems        []localConfigProvider.LocalStorage
 		deletedItems        []string
+		expandedItems       []string
+		expandErr           error
 		wantErr             bool
 		wantEphemeralNames  []string
 	}{
@@ -230,6 +232,45 @@ func TestPush(t *testing.T) {
 			},
 			wantEphemeralNames: []string{"ephemeral-storage-0"},
 		},
+		{
+			name: "case 12: size of a persistent storage increased",
+			returnedFromLocal: []localConfigProvider.LocalStorage{
+				{
+					Name:      "storage-1",
+					Size:      "10Gi",
+					Path:      "/path",
+					Container: "runtime-1",
+					Ephemeral: util.GetBoolPtr(false),
+				},
+			},
+			returnedFromCluster: StorageList{
+				Items: []Storage{
+					clusterStorage1,
+				},
+			},
+			expandedItems:      []string{"storage-1"},
+			wantEphemeralNames: []string{},
+		},
+		{
+			name: "case 13: size of a persistent storage increased but the storage class doesn't allow expansion",
+			returnedFromLocal: []localConfigProvider.LocalStorage{
+				{
+					Name:      "storage-1",
+					Size:      "10Gi",
+					Path:      "/path",
+					Container: "runtime-1",
+					Ephemeral: util.GetBoolPtr(false),
+				},
+			},
+			returnedFromCluster: StorageList{
+				Items: []Storage{
+					clusterStorage1,
+				},
+			},
+			expandedItems:      []string{"storage-1"},
+			expandErr:          &VolumeExpansionNotAllowedError{StorageName: "storage-1", StorageClass: "standard"},
+			wantEphemeralNames: []string{},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -254,6 +295,15 @@ func TestPush(t *testing.T) {
 				fakeStorageClient.EXPECT().Delete(tt.deletedItems[i]).Return(nil).Times(1)
 			}
 
+			for i := range tt.expandedItems {
+				fakeStorageClient.EXPECT().Expand(gomock.Any()).DoAndReturn(func(storage Storage) error {
+					if storage.Name != tt.expandedItems[i] {
+						t.Errorf("Expand() called for storage %v, want %v", storage.Name, tt.expandedItems[i])
+					}
+					return tt.expandErr
+				}).Times(1)
+			}
+
 			ephemerals, err := Push(fakeStorageClient, fakeLocalConfig)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Push() error = %v, wantErr %v", err, tt.wantErr)
@@ -34,6 +34,10 @@ type StorageSpec struct {
 	Path string `json:"path,omitempty"`
 	// indicates if storage should be ephemeral, if nil the default behaviour will be used
 	Ephemeral *bool `json:"ephemeral,omitempty"`
+	// storage class of the volume, if empty the default class of the cluster is used
+	StorageClass string `json:"storageClass,omitempty"`
+	// access mode of the volume, if empty ReadWriteOnce is used
+	AccessMode string `json:"accessMode,omitempty"`
 
 	ContainerName string `json:"containerName,omitempty"`
 }
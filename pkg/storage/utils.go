@@ -2,6 +2,7 @@ package storage
 
 import (
 	"fmt"
+	"reflect"
 
 	"github.com/pkg/errors"
 	"github.com/redhat-developer/odo/pkg/kclient"
@@ -16,15 +17,24 @@ func getPVCNameFromStorageName(client kclient.ClientInterface, storageName strin
 	labels[storagelabels.StorageLabel] = storageName
 
 	selector := util.ConvertLabelsToSelector(labels)
-	pvcs, err := client.ListPVCNames(selector)
+	pvcs, err := client.ListPVCs(selector)
 	if err != nil {
 		return "", errors.Wrapf(err, "unable to get PVC names for selector %v", selector)
 	}
-	numPVCs := len(pvcs)
+
+	// ignore the PVCs being terminated, e.g. a PVC replaced by a restored one
+	var names []string
+	for _, pvc := range pvcs {
+		if pvc.DeletionTimestamp != nil {
+			continue
+		}
+		names = append(names, pvc.Name)
+	}
+	numPVCs := len(names)
 	if numPVCs != 1 {
-		return "", fmt.Errorf("expected exactly one PVC attached to storage %v, but got %v, %v", storageName, numPVCs, pvcs)
+		return "", fmt.Errorf("expected exactly one PVC attached to storage %v, but got %v, %v", storageName, numPVCs, names)
 	}
-	return pvcs[0], nil
+	return names[0], nil
 }
 
 // generatePVCName generates a PVC name from the Devfile volume name, component name and app name
@@ -51,8 +61,23 @@ func ConvertListLocalToMachine(storageListConfig []localConfigProvider.LocalStor
 	for _, storeLocal := range storageListConfig {
 		s := NewStorage(storeLocal.Name, storeLocal.Size, storeLocal.Path, storeLocal.Ephemeral)
 		s.Spec.ContainerName = storeLocal.Container
+		s.Spec.StorageClass = storeLocal.StorageClass
+		s.Spec.AccessMode = storeLocal.AccessMode
 		storageListLocal = append(storageListLocal, s)
 	}
 
 	return NewStorageList(storageListLocal)
 }
+
+// isSameStorage returns true if the local Storage matches the Storage on the cluster
+// the storage class and the access mode are only compared when set in the local Storage,
+// as the cluster fills them with its defaults otherwise
+func isSameStorage(local, cluster Storage) bool {
+	if local.Spec.StorageClass == "" {
+		cluster.Spec.StorageClass = ""
+	}
+	if local.Spec.AccessMode == "" {
+		cluster.Spec.AccessMode = ""
+	}
+	return reflect.DeepEqual(local, cluster)
+}
@@ -2,10 +2,13 @@ package sync
 
 import (
 	taro "archive/tar"
+	"bytes"
 	"fmt"
 	"io"
 	"os"
+	"path"
 	"path/filepath"
+	"strings"
 
 	"github.com/redhat-developer/odo/pkg/devfile/adapters/common"
 	"github.com/redhat-developer/odo/pkg/log"
@@ -211,3 +214,70 @@ func linearTar(srcBase, srcFile, destBase, destFile string, tw *taro.Writer, fs
 
 	return nil
 }
+
+// CopyFileFromComponent copies the file or directory at remotePath in the container of the running Pod
+// to the localPath directory, keeping the base name of remotePath
+func CopyFileFromComponent(client SyncClient, compInfo common.ComponentInfo, remotePath string, localPath string) error {
+	remotePath = path.Clean(filepath.ToSlash(remotePath))
+	cmd := []string{"tar", "cf", "-", "-C", path.Dir(remotePath), path.Base(remotePath)}
+
+	klog.V(4).Infof("CopyFileFromComponent arguments: remotePath %s, localPath %s", remotePath, localPath)
+	reader, writer := io.Pipe()
+	// inspired from https://github.com/kubernetes/kubernetes/blob/master/pkg/kubectl/cmd/cp.go#L235
+	go func() {
+		var stderr bytes.Buffer
+		err := client.ExecCMDInContainer(compInfo, cmd, writer, &stderr, nil, false)
+		if err != nil && stderr.Len() > 0 {
+			err = fmt.Errorf("%w: %s", err, strings.TrimSpace(stderr.String()))
+		}
+		writer.CloseWithError(err)
+	}()
+
+	return untar(reader, localPath, filesystem.DefaultFs{})
+}
+
+// untar extracts the tar archive read from reader into the destPath directory
+// entries resolving outside of destPath are rejected
+func untar(reader io.Reader, destPath string, fs filesystem.Filesystem) error {
+	destPath = filepath.Clean(destPath)
+	tarReader := taro.NewReader(reader)
+	for {
+		hdr, err := tarReader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		target := filepath.Join(destPath, filepath.FromSlash(hdr.Name))
+		if target != destPath && !strings.HasPrefix(target, destPath+string(os.PathSeparator)) {
+			return fmt.Errorf("%s: illegal file path", hdr.Name)
+		}
+
+		switch hdr.Typeflag {
+		case taro.TypeDir:
+			if err := fs.MkdirAll(target, os.ModePerm); err != nil {
+				return err
+			}
+		case taro.TypeReg:
+			if err := fs.MkdirAll(filepath.Dir(target), os.ModePerm); err != nil {
+				return err
+			}
+			f, err := fs.OpenFile(target, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, os.FileMode(hdr.Mode).Perm())
+			if err != nil {
+				return err
+			}
+			if _, err := io.Copy(f, tarReader); err != nil {
+				f.Close()
+				return err
+			}
+			if err := f.Close(); err != nil {
+				return err
+			}
+		default:
+			// links and special files are not extracted
+			klog.V(4).Infof("Skipping %s of type %c", hdr.Name, hdr.Typeflag)
+		}
+	}
+}
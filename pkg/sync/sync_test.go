@@ -367,3 +367,82 @@ func Test_makeTar(t *testing.T) {
 		})
 	}
 }
+
+func Test_untar(t *testing.T) {
+	type tarEntry struct {
+		name     string
+		typeflag byte
+		content  string
+	}
+	tests := []struct {
+		name      string
+		entries   []tarEntry
+		wantFiles map[string]string
+		wantErr   bool
+	}{
+		{
+			name: "case 1: files and directories are extracted",
+			entries: []tarEntry{
+				{name: "dump", typeflag: taro.TypeDir},
+				{name: "dump/data.sql", typeflag: taro.TypeReg, content: "select 1;"},
+				{name: "dump/empty", typeflag: taro.TypeDir},
+				{name: "dump/nested/schema.sql", typeflag: taro.TypeReg, content: "create table t;"},
+			},
+			wantFiles: map[string]string{
+				filepath.Join("dump", "data.sql"):             "select 1;",
+				filepath.Join("dump", "nested", "schema.sql"): "create table t;",
+			},
+		},
+		{
+			name: "case 2: entries outside of the destination are rejected",
+			entries: []tarEntry{
+				{name: "../evil.sh", typeflag: taro.TypeReg, content: "rm -rf /"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := filesystem.NewFakeFs()
+			destPath, err := fs.TempDir("", "dest")
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			var buf bytes.Buffer
+			tw := taro.NewWriter(&buf)
+			for _, entry := range tt.entries {
+				hdr := &taro.Header{Name: entry.name, Typeflag: entry.typeflag, Mode: 0644, Size: int64(len(entry.content))}
+				if err = tw.WriteHeader(hdr); err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				if _, err = tw.Write([]byte(entry.content)); err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+			}
+			if err = tw.Close(); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			err = untar(&buf, destPath, fs)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("untar() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			for file, content := range tt.wantFiles {
+				got, err := fs.ReadFile(filepath.Join(destPath, file))
+				if err != nil {
+					t.Errorf("file %s not extracted: %v", file, err)
+					continue
+				}
+				if string(got) != content {
+					t.Errorf("content of %s is %q, want %q", file, string(got), content)
+				}
+			}
+			if _, err := fs.Stat(filepath.Join(destPath, "dump", "empty")); len(tt.wantFiles) > 0 && err != nil {
+				t.Errorf("empty directory not extracted: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package util
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/homedir"
+	"k8s.io/klog"
+)
+
+// HTTPCredentials holds the credentials and the TLS configuration used to access a remote server
+type HTTPCredentials struct {
+	// Token is sent with the bearer authentication scheme
+	Token string
+	// Username and Password are sent with the basic authentication scheme, instead of the token when Username is set
+	Username string
+	Password string
+	// TLSConfig holds the client certificate and the certificate authorities trusted to verify the server, if any
+	TLSConfig *tls.Config
+	// CABundle is the PEM-encoded bundle of the certificate authorities of TLSConfig, for the clients configured
+	// with the certificates instead of a TLS configuration, such as the git clones
+	CABundle string
+}
+
+// IsEmpty returns true when no credentials nor TLS configuration are set
+func (c HTTPCredentials) IsEmpty() bool {
+	return c.Token == "" && c.Username == "" && c.Password == "" && c.TLSConfig == nil
+}
+
+// NewTLSConfig returns the TLS configuration presenting the client certificate of certFile and keyFile, and trusting
+// the certificate authorities of caFile along with the system ones; each file is optional
+func NewTLSConfig(certFile, keyFile, caFile string) (*tls.Config, error) {
+	config := &tls.Config{}
+	if certFile != "" || keyFile != "" {
+		if certFile == "" || keyFile == "" {
+			return nil, errors.New("both the client certificate and its private key are required")
+		}
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to load the client certificate %s", certFile)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	if caFile != "" {
+		pem, err := ioutil.ReadFile(caFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to read the CA bundle %s", caFile)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, errors.Errorf("no certificate found in the CA bundle %s", caFile)
+		}
+		config.RootCAs = pool
+	}
+	return config, nil
+}
+
+// dockerConfig is the part of the docker config.json file describing the credentials of the registries
+type dockerConfig struct {
+	Auths       map[string]dockerAuth `json:"auths"`
+	CredsStore  string                `json:"credsStore"`
+	CredHelpers map[string]string     `json:"credHelpers"`
+}
+
+type dockerAuth struct {
+	Auth     string `json:"auth"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// dockerCredentialHelperPrefix is the prefix of the executables of the docker credential helpers
+const dockerCredentialHelperPrefix = "docker-credential-"
+
+// GetDockerConfigFile returns the path of the docker config.json file, in $DOCKER_CONFIG or in ~/.docker
+func GetDockerConfigFile() string {
+	dir := os.Getenv("DOCKER_CONFIG")
+	if dir == "" {
+		dir = filepath.Join(homedir.HomeDir(), ".docker")
+	}
+	return filepath.Join(dir, "config.json")
+}
+
+// GetDockerCredentials returns the username and password of the host, as stored in the docker config.json file or by
+// the credential helpers it configures, like `docker login` does; empty credentials are returned for unknown hosts
+func GetDockerCredentials(host string) (username, password string, err error) {
+	return getDockerCredentials(GetDockerConfigFile(), host)
+}
+
+func getDockerCredentials(configFile, host string) (username, password string, err error) {
+	content, err := ioutil.ReadFile(configFile)
+	if os.IsNotExist(err) {
+		return "", "", nil
+	}
+	if err != nil {
+		return "", "", err
+	}
+	var config dockerConfig
+	err = json.Unmarshal(content, &config)
+	if err != nil {
+		return "", "", errors.Wrapf(err, "unable to parse the docker config file %s", configFile)
+	}
+
+	if helper, ok := config.CredHelpers[host]; ok {
+		return getCredentialHelperCredentials(helper, host)
+	}
+	for server, auth := range config.Auths {
+		if dockerServerHost(server) != host {
+			continue
+		}
+		if auth.Auth == "" {
+			if auth.Username != "" {
+				return auth.Username, auth.Password, nil
+			}
+			// the credentials of the server are stored by the credentials store
+			break
+		}
+		decoded, err := base64.StdEncoding.DecodeString(auth.Auth)
+		if err != nil {
+			return "", "", errors.Wrapf(err, "invalid credentials of %s in the docker config file %s", server, configFile)
+		}
+		parts := strings.SplitN(string(decoded), ":", 2)
+		if len(parts) != 2 {
+			return "", "", errors.Errorf("invalid credentials of %s in the docker config file %s", server, configFile)
+		}
+		return parts[0], parts[1], nil
+	}
+	if config.CredsStore != "" {
+		return getCredentialHelperCredentials(config.CredsStore, host)
+	}
+	return "", "", nil
+}
+
+// dockerServerHost returns the host of a server of the docker config file, which can be a host or a URL
+func dockerServerHost(server string) string {
+	if strings.Contains(server, "://") {
+		if u, err := url.Parse(server); err == nil {
+			return u.Host
+		}
+	}
+	return strings.SplitN(server, "/", 2)[0]
+}
+
+// getCredentialHelperCredentials returns the credentials of the host stored by the docker credential helper,
+// empty credentials being returned when the helper has no credentials for the host
+func getCredentialHelperCredentials(helper, host string) (username, password string, err error) {
+	klog.V(4).Infof("Getting the credentials of %s from the docker credential helper %s", host, helper)
+	// #nosec G204 -- the helper is configured by the user in the docker config file
+	cmd := exec.Command(dockerCredentialHelperPrefix+helper, "get")
+	cmd.Stdin = strings.NewReader(host)
+	var stdout bytes.Buffer
+	cmd.Stdout = &stdout
+	err = cmd.Run()
+	if err != nil {
+		if strings.Contains(stdout.String(), "credentials not found") {
+			return "", "", nil
+		}
+		return "", "", errors.Wrapf(err, "unable to get the credentials of %s from the docker credential helper %s: %s", host, helper, strings.TrimSpace(stdout.String()))
+	}
+	var credentials struct {
+		Username string `json:"Username"`
+		Secret   string `json:"Secret"`
+	}
+	err = json.Unmarshal(stdout.Bytes(), &credentials)
+	if err != nil {
+		return "", "", errors.Wrapf(err, "invalid output of the docker credential helper %s", helper)
+	}
+	return credentials.Username, credentials.Secret, nil
+}
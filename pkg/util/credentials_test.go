@@ -0,0 +1,227 @@
+package util
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestGetDockerCredentials(t *testing.T) {
+	dir, err := ioutil.TempDir("", "odo-docker-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// a fake credential helper, storing the credentials of helper.example.com only
+	if runtime.GOOS != "windows" {
+		helper := "#!/bin/sh\nread host\nif [ \"$host\" = helper.example.com ]; then\n  echo '{\"ServerURL\":\"helper.example.com\",\"Username\":\"robot\",\"Secret\":\"s3cr3t\"}'\nelse\n  echo 'credentials not found in native keychain'\n  exit 1\nfi\n"
+		err = ioutil.WriteFile(filepath.Join(dir, dockerCredentialHelperPrefix+"fake"), []byte(helper), 0700) // #nosec G306
+		if err != nil {
+			t.Fatal(err)
+		}
+		path := os.Getenv("PATH")
+		os.Setenv("PATH", dir+string(os.PathListSeparator)+path)
+		defer os.Setenv("PATH", path)
+	}
+
+	configFile := filepath.Join(dir, "config.json")
+	config := `{
+  "auths": {
+    "registry.example.com": {"auth": "dXNlcjpwYXNzOndvcmQ="},
+    "https://url.example.com/v1/": {"username": "admin", "password": "secret"},
+    "store.example.com": {}
+  },
+  "credHelpers": {"helper.example.com": "fake", "other.example.com": "fake"}
+}`
+	err = ioutil.WriteFile(configFile, []byte(config), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name         string
+		host         string
+		wantUsername string
+		wantPassword string
+		needsHelper  bool
+	}{
+		{
+			name:         "encoded credentials",
+			host:         "registry.example.com",
+			wantUsername: "user",
+			wantPassword: "pass:word",
+		},
+		{
+			name:         "credentials of a server URL",
+			host:         "url.example.com",
+			wantUsername: "admin",
+			wantPassword: "secret",
+		},
+		{
+			name: "unknown host",
+			host: "unknown.example.com",
+		},
+		{
+			name: "credentials in a store which is not configured",
+			host: "store.example.com",
+		},
+		{
+			name:         "credential helper",
+			host:         "helper.example.com",
+			wantUsername: "robot",
+			wantPassword: "s3cr3t",
+			needsHelper:  true,
+		},
+		{
+			name:        "credential helper without credentials for the host",
+			host:        "other.example.com",
+			needsHelper: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.needsHelper && runtime.GOOS == "windows" {
+				t.Skip("the fake credential helper is a shell script")
+			}
+			username, password, err := getDockerCredentials(configFile, tt.host)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if username != tt.wantUsername || password != tt.wantPassword {
+				t.Errorf("got credentials %q/%q, want %q/%q", username, password, tt.wantUsername, tt.wantPassword)
+			}
+		})
+	}
+
+	// without config file, there are no credentials
+	username, password, err := getDockerCredentials(filepath.Join(dir, "missing.json"), "registry.example.com")
+	if err != nil || username != "" || password != "" {
+		t.Errorf("got credentials %q/%q and error %v, want none", username, password, err)
+	}
+}
+
+func TestHTTPGetRequestWithCredentials(t *testing.T) {
+	dir, err := ioutil.TempDir("", "odo-credentials")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// the server requires a client certificate and the basic authentication
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		username, password, ok := req.BasicAuth()
+		if !ok || username != "user" || password != "pass" || len(req.TLS.PeerCertificates) == 0 {
+			rw.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = rw.Write([]byte("OK"))
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	caFile := filepath.Join(dir, "ca.pem")
+	err = ioutil.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certFile, keyFile := writeClientCertificate(t, dir)
+
+	withClientCert, err := NewTLSConfig(certFile, keyFile, caFile)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	withoutClientCert, err := NewTLSConfig("", "", caFile)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		credentials HTTPCredentials
+		wantErr     bool
+	}{
+		{
+			name:        "client certificate and basic authentication",
+			credentials: HTTPCredentials{Username: "user", Password: "pass", TLSConfig: withClientCert},
+		},
+		{
+			name:        "wrong password",
+			credentials: HTTPCredentials{Username: "user", Password: "wrong", TLSConfig: withClientCert},
+			wantErr:     true,
+		},
+		{
+			name:        "without client certificate",
+			credentials: HTTPCredentials{Username: "user", Password: "pass", TLSConfig: withoutClientCert},
+			wantErr:     true,
+		},
+		{
+			name:        "without CA bundle",
+			credentials: HTTPCredentials{Username: "user", Password: "pass"},
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := HTTPGetRequest(HTTPRequestParams{URL: server.URL, HTTPCredentials: tt.credentials}, 0)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error: %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && string(got) != "OK" {
+				t.Errorf("got %q, want %q", got, "OK")
+			}
+		})
+	}
+
+	_, err = NewTLSConfig(certFile, "", "")
+	if err == nil {
+		t.Errorf("expected an error for a client certificate without private key")
+	}
+}
+
+// writeClientCertificate writes a self-signed client certificate and its private key into dir
+func writeClientCertificate(t *testing.T, dir string) (certFile, keyFile string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "odo"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certFile = filepath.Join(dir, "cert.pem")
+	keyFile = filepath.Join(dir, "key.pem")
+	err = ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600)
+	if err == nil {
+		err = ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return certFile, keyFile
+}
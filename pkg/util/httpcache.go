@@ -1,6 +1,8 @@
 package util
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -49,3 +51,46 @@ func cleanHttpCache(cacheDir string, cacheTime time.Duration) error {
 	}
 	return nil
 }
+
+// GetCachedResponse returns the response cached for the key in the default directory used for HTTP caching,
+// when it was cached less than cacheFor minutes ago
+func GetCachedResponse(key string, cacheFor int) ([]byte, bool) {
+	return getCachedResponse(filesystem.DefaultFs{}, key, cacheFor)
+}
+
+func getCachedResponse(fs filesystem.Filesystem, key string, cacheFor int) ([]byte, bool) {
+	if cacheFor <= 0 {
+		return nil, false
+	}
+	cacheFile := getCacheFile(key)
+	info, err := fs.Stat(cacheFile)
+	if err != nil || info.ModTime().Add(time.Duration(cacheFor)*time.Minute).Before(time.Now()) {
+		return nil, false
+	}
+	data, err := fs.ReadFile(cacheFile)
+	if err != nil {
+		klog.V(4).Infof("Unable to read cache file %s: %v", cacheFile, err)
+		return nil, false
+	}
+	klog.V(4).Infof("Cached response used for %s", key)
+	return data, true
+}
+
+// CacheResponse caches the response for the key in the default directory used for HTTP caching
+func CacheResponse(key string, data []byte) error {
+	return cacheResponse(filesystem.DefaultFs{}, key, data)
+}
+
+func cacheResponse(fs filesystem.Filesystem, key string, data []byte) error {
+	err := fs.MkdirAll(httpCacheDir, 0750)
+	if err != nil {
+		return err
+	}
+	return fs.WriteFile(getCacheFile(key), data, 0600)
+}
+
+// getCacheFile returns the path of the file caching the response for the key
+func getCacheFile(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(httpCacheDir, "odo-"+hex.EncodeToString(sum[:]))
+}
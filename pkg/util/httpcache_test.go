@@ -4,6 +4,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/devfile/library/pkg/testingutil/filesystem"
 )
@@ -40,3 +41,32 @@ func TestCleanDefaultHTTPCacheDir(t *testing.T) {
 	}
 
 }
+
+func TestCachedResponse(t *testing.T) {
+	fakeFs := filesystem.NewFakeFs()
+	const key = "registry-index:https://registry.example.com"
+
+	if _, ok := getCachedResponse(fakeFs, key, 15); ok {
+		t.Error("got a cached response before caching it")
+	}
+	err := cacheResponse(fakeFs, key, []byte("index"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data, ok := getCachedResponse(fakeFs, key, 15); !ok || string(data) != "index" {
+		t.Errorf("got cached response %q, %v, want %q", data, ok, "index")
+	}
+	if _, ok := getCachedResponse(fakeFs, key, 0); ok {
+		t.Error("got a cached response with caching disabled")
+	}
+
+	// the response is not used when it was cached too long ago
+	old := time.Now().Add(-20 * time.Minute)
+	err = fakeFs.Chtimes(getCacheFile(key), old, old)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := getCachedResponse(fakeFs, key, 15); ok {
+		t.Error("got an expired cached response")
+	}
+}
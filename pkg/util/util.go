@@ -73,8 +73,8 @@ type ResourceRequirementInfo struct {
 
 // HTTPRequestParams holds parameters of forming http request
 type HTTPRequestParams struct {
-	URL   string
-	Token string
+	URL string
+	HTTPCredentials
 }
 
 // DownloadParams holds parameters of forming file download request
@@ -772,7 +772,7 @@ func GetRemoteFilesMarkedForDeletion(delSrcRelPaths []string, remoteFolder strin
 	return rmPaths
 }
 
-// HTTPGetRequest gets resource contents given URL and token (if applicable)
+// HTTPGetRequest gets resource contents given URL and credentials (if applicable)
 // cacheFor determines how long the response should be cached (in minutes), 0 for no caching
 func HTTPGetRequest(request HTTPRequestParams, cacheFor int) ([]byte, error) {
 	// Build http request
@@ -780,16 +780,20 @@ func HTTPGetRequest(request HTTPRequestParams, cacheFor int) ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
-	if request.Token != "" {
+	if request.Username != "" {
+		req.SetBasicAuth(request.Username, request.Password)
+	} else if request.Token != "" {
 		bearer := "Bearer " + request.Token
 		req.Header.Add("Authorization", bearer)
 	}
 
+	transport := &http.Transport{
+		ResponseHeaderTimeout: ResponseHeaderTimeout,
+		TLSClientConfig:       request.TLSConfig,
+	}
 	httpClient := &http.Client{
-		Transport: &http.Transport{
-			ResponseHeaderTimeout: ResponseHeaderTimeout,
-		},
-		Timeout: HTTPRequestTimeout,
+		Transport: transport,
+		Timeout:   HTTPRequestTimeout,
 	}
 
 	klog.V(4).Infof("HTTPGetRequest: %s", req.URL.String())
@@ -812,7 +816,9 @@ func HTTPGetRequest(request HTTPRequestParams, cacheFor int) ([]byte, error) {
 		}
 
 		if !cacheError {
-			httpClient.Transport = httpcache.NewTransport(diskcache.New(httpCacheDir))
+			cacheTransport := httpcache.NewTransport(diskcache.New(httpCacheDir))
+			cacheTransport.Transport = transport
+			httpClient.Transport = cacheTransport
 			klog.V(4).Infof("Response will be cached in %s for %s", httpCacheDir, httpCacheTime)
 		} else {
 			klog.V(4).Info("Response won't be cached.")
@@ -900,7 +906,9 @@ func ConvertGitSSHRemoteToHTTPS(remote string) string {
 // GetAndExtractZip downloads a zip file from a URL with a http prefix or
 // takes an absolute path prefixed with file:// and extracts it to a destination.
 // pathToUnzip specifies the path within the zip folder to extract
-func GetAndExtractZip(zipURL string, destination string, pathToUnzip string, starterToken string) error {
+// credentials are used to download the zip file from a URL, if needed
+// checksum, when not empty, is the checksum the zip file must match before being extracted, as accepted by VerifyChecksum
+func GetAndExtractZip(zipURL string, destination string, pathToUnzip string, credentials HTTPCredentials, checksum string) error {
 	if zipURL == "" {
 		return errors.Errorf("Empty zip url: %s", zipURL)
 	}
@@ -919,8 +927,8 @@ func GetAndExtractZip(zipURL string, destination string, pathToUnzip string, sta
 
 		params := DownloadParams{
 			Request: HTTPRequestParams{
-				URL:   zipURL,
-				Token: starterToken,
+				URL:             zipURL,
+				HTTPCredentials: credentials,
 			},
 			Filepath: pathToZip,
 		}
@@ -938,6 +946,17 @@ func GetAndExtractZip(zipURL string, destination string, pathToUnzip string, sta
 		return errors.Errorf("Invalid Zip URL: %s . Should either be prefixed with file://, http:// or https://", zipURL)
 	}
 
+	if checksum != "" {
+		content, err := ioutil.ReadFile(pathToZip)
+		if err != nil {
+			return err
+		}
+		err = VerifyChecksum(content, checksum)
+		if err != nil {
+			return errors.Wrapf(err, "unable to verify the zip file %s", zipURL)
+		}
+	}
+
 	filenames, err := Unzip(pathToZip, destination, pathToUnzip)
 	if err != nil {
 		return err
@@ -1049,6 +1068,53 @@ func Unzip(src, dest, pathToUnzip string) ([]string, error) {
 	return filenames, nil
 }
 
+// Zip archives the content of the directory src in the zip file dest, under the directory rootDir, as in the
+// archives of Git repositories expected by Unzip
+func Zip(src, dest, rootDir string) error {
+	out, err := os.Create(dest)
+	if err != nil {
+		return err
+	}
+	defer out.Close() // #nosec G307
+
+	w := zip.NewWriter(out)
+	err = filepath.Walk(src, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, p)
+		if err != nil {
+			return err
+		}
+		name := path.Join(rootDir, filepath.ToSlash(rel))
+		if info.IsDir() {
+			_, err = w.Create(name + "/")
+			return err
+		}
+		header, err := zip.FileInfoHeader(info)
+		if err != nil {
+			return err
+		}
+		header.Name = name
+		header.Method = zip.Deflate
+		fw, err := w.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+		f, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		defer f.Close() // #nosec G307
+		_, err = io.Copy(fw, f)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return w.Close()
+}
+
 // DownloadFileWithCache downloads the file to the filepath given URL and token (if applicable)
 // cacheFor determines how long the response should be cached (in minutes), 0 for no caching
 func DownloadFileWithCache(params DownloadParams, cacheFor int) error {
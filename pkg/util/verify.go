@@ -0,0 +1,116 @@
+package util
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"hash"
+	"io/ioutil"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// checksumAlgorithms are the hash functions of the supported checksums, indexed by the prefix of the checksums
+var checksumAlgorithms = map[string]func() hash.Hash{
+	"sha256": sha256.New,
+	"sha512": sha512.New,
+}
+
+// VerificationError is returned when a content does not match its checksum or its signature
+type VerificationError struct {
+	Message string
+}
+
+func (e *VerificationError) Error() string {
+	return e.Message
+}
+
+// GetChecksum returns the sha256 checksum of the content, as sha256:<hex digest>
+func GetChecksum(content []byte) string {
+	digest := sha256.Sum256(content)
+	return "sha256:" + hex.EncodeToString(digest[:])
+}
+
+// VerifyChecksum checks that the content matches the checksum, expressed as <algorithm>:<hex digest> with the sha256
+// or sha512 algorithm, or as a sha256 hex digest
+func VerifyChecksum(content []byte, checksum string) error {
+	algorithm, digest := "sha256", checksum
+	if parts := strings.SplitN(checksum, ":", 2); len(parts) == 2 {
+		algorithm, digest = strings.ToLower(parts[0]), parts[1]
+	}
+	newHash, ok := checksumAlgorithms[algorithm]
+	if !ok {
+		return errors.Errorf("unsupported checksum algorithm %q", algorithm)
+	}
+	expected, err := hex.DecodeString(digest)
+	if err != nil {
+		return errors.Errorf("invalid checksum %q", checksum)
+	}
+	h := newHash()
+	_, _ = h.Write(content)
+	actual := h.Sum(nil)
+	if subtle.ConstantTimeCompare(expected, actual) != 1 {
+		return &VerificationError{Message: fmt.Sprintf("checksum mismatch: expected %s, got %s:%s", checksum, algorithm, hex.EncodeToString(actual))}
+	}
+	return nil
+}
+
+// LoadPublicKey loads the PEM-encoded ECDSA, RSA or Ed25519 public key of the file
+func LoadPublicKey(publicKeyFile string) (crypto.PublicKey, error) {
+	content, err := ioutil.ReadFile(publicKeyFile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read the public key %s", publicKeyFile)
+	}
+	block, _ := pem.Decode(content)
+	if block == nil {
+		return nil, errors.Errorf("no PEM-encoded public key found in %s", publicKeyFile)
+	}
+	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to parse the public key %s", publicKeyFile)
+	}
+	switch publicKey.(type) {
+	case *ecdsa.PublicKey, *rsa.PublicKey, ed25519.PublicKey:
+		return publicKey, nil
+	default:
+		return nil, errors.Errorf("unsupported type of public key %T in %s", publicKey, publicKeyFile)
+	}
+}
+
+// VerifySignature checks the base64-encoded signature of the content with the public key of publicKeyFile, as created by
+// `cosign sign-blob`: an ECDSA or RSA PKCS #1 v1.5 signature of the SHA-256 digest of the content, or an Ed25519 signature
+func VerifySignature(content []byte, signature string, publicKeyFile string) error {
+	publicKey, err := LoadPublicKey(publicKeyFile)
+	if err != nil {
+		return err
+	}
+	sig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(signature))
+	if err != nil {
+		return errors.Wrap(err, "invalid signature, the signature must be base64-encoded")
+	}
+
+	digest := sha256.Sum256(content)
+	valid := false
+	switch key := publicKey.(type) {
+	case *ecdsa.PublicKey:
+		valid = ecdsa.VerifyASN1(key, digest[:], sig)
+	case *rsa.PublicKey:
+		valid = rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], sig) == nil
+	case ed25519.PublicKey:
+		valid = ed25519.Verify(key, content, sig)
+	}
+	if !valid {
+		return &VerificationError{Message: fmt.Sprintf("the signature does not match the public key %s", publicKeyFile)}
+	}
+	return nil
+}
@@ -0,0 +1,180 @@
+package util
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestVerifyChecksum(t *testing.T) {
+	content := []byte("schemaVersion: 2.0.0\n")
+	sha512Digest := sha512.Sum512(content)
+	tests := []struct {
+		name     string
+		checksum string
+		wantErr  bool
+	}{
+		{
+			name:     "sha256 checksum",
+			checksum: GetChecksum(content),
+		},
+		{
+			name:     "sha256 hex digest",
+			checksum: GetChecksum(content)[len("sha256:"):],
+		},
+		{
+			name:     "sha512 checksum",
+			checksum: "sha512:" + hex.EncodeToString(sha512Digest[:]),
+		},
+		{
+			name:     "checksum of another content",
+			checksum: GetChecksum([]byte("schemaVersion: 2.2.0\n")),
+			wantErr:  true,
+		},
+		{
+			name:     "unsupported algorithm",
+			checksum: "md5:d41d8cd98f00b204e9800998ecf8427e",
+			wantErr:  true,
+		},
+		{
+			name:     "invalid digest",
+			checksum: "sha256:not-hex",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := VerifyChecksum(content, tt.checksum)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got error %v, want error: %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	dir, err := ioutil.TempDir("", "odo-signature")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	content := []byte("schemaVersion: 2.0.0\n")
+	digest := sha256.Sum256(content)
+
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecdsaSignature, err := ecdsa.SignASN1(rand.Reader, ecdsaKey, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaSignature, err := rsa.SignPKCS1v15(rand.Reader, rsaKey, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	ed25519Public, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ed25519Signature := ed25519.Sign(ed25519Key, content)
+
+	ecdsaKeyFile := writePublicKey(t, dir, "ecdsa.pub", &ecdsaKey.PublicKey)
+	rsaKeyFile := writePublicKey(t, dir, "rsa.pub", &rsaKey.PublicKey)
+	ed25519KeyFile := writePublicKey(t, dir, "ed25519.pub", ed25519Public)
+
+	tests := []struct {
+		name      string
+		content   []byte
+		signature string
+		keyFile   string
+		wantErr   bool
+	}{
+		{
+			name:      "ECDSA signature",
+			content:   content,
+			signature: base64.StdEncoding.EncodeToString(ecdsaSignature),
+			keyFile:   ecdsaKeyFile,
+		},
+		{
+			name:      "RSA signature",
+			content:   content,
+			signature: base64.StdEncoding.EncodeToString(rsaSignature),
+			keyFile:   rsaKeyFile,
+		},
+		{
+			name:      "Ed25519 signature",
+			content:   content,
+			signature: base64.StdEncoding.EncodeToString(ed25519Signature),
+			keyFile:   ed25519KeyFile,
+		},
+		{
+			name:      "signature of another content",
+			content:   []byte("schemaVersion: 2.2.0\n"),
+			signature: base64.StdEncoding.EncodeToString(ecdsaSignature),
+			keyFile:   ecdsaKeyFile,
+			wantErr:   true,
+		},
+		{
+			name:      "signature with another key",
+			content:   content,
+			signature: base64.StdEncoding.EncodeToString(ecdsaSignature),
+			keyFile:   rsaKeyFile,
+			wantErr:   true,
+		},
+		{
+			name:      "signature not base64-encoded",
+			content:   content,
+			signature: "not base64",
+			keyFile:   ecdsaKeyFile,
+			wantErr:   true,
+		},
+		{
+			name:      "missing public key",
+			content:   content,
+			signature: base64.StdEncoding.EncodeToString(ecdsaSignature),
+			keyFile:   filepath.Join(dir, "missing.pub"),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := VerifySignature(tt.content, tt.signature, tt.keyFile)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got error %v, want error: %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// writePublicKey writes the PEM-encoded public key into the file name of dir
+func writePublicKey(t *testing.T, dir, name string, publicKey crypto.PublicKey) string {
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, name)
+	err = ioutil.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
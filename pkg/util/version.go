@@ -0,0 +1,36 @@
+package util
+
+import (
+	"sort"
+
+	"github.com/blang/semver"
+)
+
+// GetLatestVersion returns the index of the most recent stable version among the given semantic versions, or of the
+// most recent version when no version is stable; the versions which can't be parsed are only selected when no version
+// can be parsed, the first one being returned; -1 is returned when versions is empty
+func GetLatestVersion(versions []string) int {
+	if len(versions) == 0 {
+		return -1
+	}
+
+	// sort the indexes of the versions from the latest to the oldest, the versions which can't be parsed being the last ones
+	sorted := make([]int, len(versions))
+	for i := range sorted {
+		sorted[i] = i
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		vi, erri := semver.ParseTolerant(versions[sorted[i]])
+		vj, errj := semver.ParseTolerant(versions[sorted[j]])
+		if erri != nil || errj != nil {
+			return erri == nil
+		}
+		return vi.GT(vj)
+	})
+	for _, i := range sorted {
+		if parsed, err := semver.ParseTolerant(versions[i]); err == nil && len(parsed.Pre) == 0 {
+			return i
+		}
+	}
+	return sorted[0]
+}
@@ -0,0 +1,43 @@
+package util
+
+import "testing"
+
+func TestGetLatestVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		versions []string
+		want     int
+	}{
+		{
+			name: "no version",
+			want: -1,
+		},
+		{
+			name:     "latest stable version",
+			versions: []string{"1.0.0", "v2.1.0", "2.0.0", "3.0.0-rc1"},
+			want:     1,
+		},
+		{
+			name:     "latest prerelease when no version is stable",
+			versions: []string{"1.0.0-alpha", "1.0.0-beta"},
+			want:     1,
+		},
+		{
+			name:     "versions which can't be parsed are ignored",
+			versions: []string{"latest", "1.0.0"},
+			want:     1,
+		},
+		{
+			name:     "first version when no version can be parsed",
+			versions: []string{"foo", "bar"},
+			want:     0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetLatestVersion(tt.versions); got != tt.want {
+				t.Errorf("got %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
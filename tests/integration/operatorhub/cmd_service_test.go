@@ -122,9 +122,9 @@ var _ = Describe("odo service command tests for OperatorHub", func() {
 					helper.Cmd("odo", "config", "set", "Memory", "300M", "-f").ShouldPass()
 				})
 
-				It("should fail for interactive mode", func() {
+				It("should fail for interactive mode without a terminal", func() {
 					stdOut := helper.Cmd("odo", "service", "create").ShouldFail().Err()
-					Expect(stdOut).To(ContainSubstring("odo doesn't support interactive mode for creating Operator backed service"))
+					Expect(stdOut).To(ContainSubstring("odo needs a terminal to create a service interactively"))
 				})
 
 				It("should define the CR output of the operator instance in dryRun mode", func() {